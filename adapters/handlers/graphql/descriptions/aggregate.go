@@ -20,6 +20,22 @@ const (
 
 const GroupBy = "Specify which properties to group by"
 
+const SubGroupBy = "Group each group further, one property path per nested level. The limit and groupSort apply to every level"
+
+const (
+	GroupSort      = "Specify the order of the groups, which also decides which groups are kept if there are more than the limit. Defaults to the largest groups first"
+	GroupSortBy    = "Sort the groups by their number of objects or by the grouped value"
+	GroupSortOrder = "Sort in ascending or descending order"
+)
+
+const (
+	GroupByDate         = "Group the dates of the groupBy property by calendar interval, the groups are sorted chronologically unless a groupSort is set"
+	GroupByDateInterval = "The calendar interval to group by, weeks start on Monday"
+	GroupByDateTimezone = "The IANA time zone the intervals start in, such as Europe/Amsterdam. Defaults to UTC"
+)
+
+const ObjectLimit = "Aggregate only the x objects closest to the search vector, required when using a near<Media> argument"
+
 const (
 	AggregatePropertyObject = "An object containing Aggregation information about this property"
 )
@@ -35,6 +51,32 @@ const (
 	AggregateMax       = "Aggregate on the maximum of numeric property values"
 	AggregateCount     = "Aggregate on the total amount of found property values"
 	AggregateGroupedBy = "Indicates the group of returned data"
+	AggregateSubGroups = "The nested groups of this group, only set when using subGroupBy"
+)
+
+const (
+	AggregateStddev                     = "Aggregate on the standard deviation of numeric property values"
+	AggregateVariance                   = "Aggregate on the variance of numeric property values"
+	AggregatePercentile                 = "Aggregate on the given percentiles of numeric property values, using the nearest-rank method"
+	AggregatePercentileValues           = "The percentiles to calculate, each between 0 and 100"
+	AggregatePercentileObj              = "An object containing a percentile and its value"
+	AggregateHistogram                  = "Aggregate numeric property values into a histogram, either by number of buckets or by interval"
+	AggregateHistogramBuckets           = "Split the range of values into this many equally sized buckets"
+	AggregateHistogramInterval          = "Split the range of values into buckets of this width"
+	AggregateHistogramObj               = "An object containing the bounds of a histogram bucket and the number of values in it"
+	AggregateHistogramFrom              = "The lower bound of the bucket (inclusive)"
+	AggregateHistogramTo                = "The upper bound of the bucket (exclusive, inclusive for the last bucket)"
+	AggregateHistogramCount             = "The number of values in the bucket"
+	AggregatePercentileResultPercentile = "The percentile"
+	AggregatePercentileResultValue      = "The value below which the percentile of values falls"
+)
+
+const (
+	AggregateDateMin    = "Aggregate on the earliest date property value"
+	AggregateDateMax    = "Aggregate on the latest date property value"
+	AggregateDateMedian = "Aggregate on the median of date property values"
+	AggregateDateMode   = "Aggregate on the most frequent date property value"
+	AggregateDateCount  = "Aggregate on the total amount of found date property values"
 )
 
 const AggregateNumericObj = "An object containing the %s of numeric properties"
@@ -25,4 +25,7 @@ const (
 	ID                   = "Concept identifier in the uuid format"
 	Beacon               = "Concept identifier in the beacon format, such as weaviate://<hostname>/<kind>/id"
 	Distance             = "Normalized Distance between the result item and the search vector. Normalized to be between 0 (identical vectors) and 1 (perfect opposite)."
+	Autocorrect          = "Set to true, if the search terms should be spell-checked and corrected before they are vectorized"
+	TargetVector         = "Name of the named vector to search on. If omitted, the main vector of the objects is used"
+	Vector               = "The vector to search with, it needs to have the same dimensions as the vectors of the objects"
 )
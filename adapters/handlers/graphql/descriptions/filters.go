@@ -70,6 +70,15 @@ const (
 	WhereValueDate                         = "Specify a Date value that the target property will be compared to"
 )
 
+const (
+	WhereValueIntArray     = "Specify a list of Integer values that the target property will be compared to using the ContainsAny or ContainsAll operator"
+	WhereValueNumberArray  = "Specify a list of Float values that the target property will be compared to using the ContainsAny or ContainsAll operator"
+	WhereValueBooleanArray = "Specify a list of Boolean values that the target property will be compared to using the ContainsAny or ContainsAll operator"
+	WhereValueStringArray  = "Specify a list of String values that the target property will be compared to using the ContainsAny or ContainsAll operator"
+	WhereValueTextArray    = "Specify a list of Text values that the target property will be compared to using the ContainsAny or ContainsAll operator"
+	WhereValueDateArray    = "Specify a list of Date values that the target property will be compared to using the ContainsAny or ContainsAll operator"
+)
+
 // Properties and Classes filter elements (used by Fetch and Introspect Where filters)
 const (
 	WhereProperties    = "Specify which properties to filter on"
@@ -135,3 +144,6 @@ const (
 	First = "Show the first x results (pagination option)"
 	After = "Show the results after the first x results (pagination option)"
 )
+
+// Multi-tenancy filter elements
+const Tenant = "Specify the tenant to query. Required for classes with multi-tenancy enabled"
@@ -43,6 +43,8 @@ const (
 	AggregatePropertySum                  = "The sum of all values for this property"
 )
 
+const AggregatePropertyApproximateDistinctCount = "The estimated number of distinct values for this property, approximated with a HyperLogLog sketch"
+
 // Network
 const (
 	NetworkMeta            = "Get meta information about Objects from a Weaviate in a network"
@@ -23,8 +23,14 @@ import (
 	"github.com/semi-technologies/weaviate/usecases/config"
 )
 
+type ModulesProvider interface {
+	AggregateArguments(class *models.Class) map[string]*graphql.ArgumentConfig
+	ExtractSearchParams(arguments map[string]interface{}) map[string]interface{}
+}
+
 // Build the Aggreate Kinds schema
-func Build(dbSchema *schema.Schema, config config.Config) (*graphql.Field, error) {
+func Build(dbSchema *schema.Schema, config config.Config,
+	modulesProvider ModulesProvider) (*graphql.Field, error) {
 	if len(dbSchema.Objects.Classes) == 0 {
 		return nil, fmt.Errorf("there are no Objects classes defined yet")
 	}
@@ -32,7 +38,8 @@ func Build(dbSchema *schema.Schema, config config.Config) (*graphql.Field, error
 	var err error
 	var localAggregateObjects *graphql.Object
 	if len(dbSchema.Objects.Classes) > 0 {
-		localAggregateObjects, err = classFields(dbSchema.Objects.Classes, config)
+		localAggregateObjects, err = classFields(dbSchema.Objects.Classes,
+			dbSchema.Objects.Aliases, config, modulesProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -48,12 +55,12 @@ func Build(dbSchema *schema.Schema, config config.Config) (*graphql.Field, error
 	return &field, nil
 }
 
-func classFields(databaseSchema []*models.Class,
-	config config.Config) (*graphql.Object, error) {
+func classFields(databaseSchema []*models.Class, aliases []*models.Alias,
+	config config.Config, modulesProvider ModulesProvider) (*graphql.Object, error) {
 	fields := graphql.Fields{}
 
 	for _, class := range databaseSchema {
-		field, err := classField(class, class.Description, config)
+		field, err := classField(class, class.Description, config, modulesProvider)
 		if err != nil {
 			return nil, err
 		}
@@ -61,6 +68,14 @@ func classFields(databaseSchema []*models.Class,
 		fields[class.Class] = field
 	}
 
+	// an alias shares the field of the class it points to, the resolver passes
+	// the alias on and leaves it to the traverser to resolve it
+	for _, alias := range aliases {
+		if field, ok := fields[alias.Class]; ok && field != nil {
+			fields[alias.Alias] = field
+		}
+	}
+
 	return graphql.NewObject(graphql.ObjectConfig{
 		Name:        "AggregateObjectsObj",
 		Fields:      fields,
@@ -69,7 +84,7 @@ func classFields(databaseSchema []*models.Class,
 }
 
 func classField(class *models.Class, description string,
-	config config.Config) (*graphql.Field, error) {
+	config config.Config, modulesProvider ModulesProvider) (*graphql.Field, error) {
 	if len(class.Properties) == 0 {
 		// if we don't have class properties, we can't build this particular class,
 		// as it would not have any fields. So we have to return (without an
@@ -79,6 +94,8 @@ func classField(class *models.Class, description string,
 
 	metaClassName := fmt.Sprintf("Aggregate%s", class.Class)
 
+	// declared upfront, so that the nested groups can refer to their own type
+	var fieldsObject *graphql.Object
 	fields := graphql.ObjectConfig{
 		Name: metaClassName,
 		Fields: (graphql.FieldsThunk)(func() graphql.Fields {
@@ -88,12 +105,13 @@ func classField(class *models.Class, description string,
 				panic(fmt.Sprintf("Failed to assemble single Local Aggregate Class field: %s", err))
 			}
 
+			fields[SubGroupsFieldName] = subGroupsField(fieldsObject)
 			return fields
 		}),
 		Description: description,
 	}
 
-	fieldsObject := graphql.NewObject(fields)
+	fieldsObject = graphql.NewObject(fields)
 	fieldsField := &graphql.Field{
 		Type:        graphql.NewList(fieldsObject),
 		Description: description,
@@ -116,8 +134,30 @@ func classField(class *models.Class, description string,
 				Description: descriptions.GroupBy,
 				Type:        graphql.NewList(graphql.String),
 			},
+			"subGroupBy": &graphql.ArgumentConfig{
+				Description: descriptions.SubGroupBy,
+				Type:        graphql.NewList(graphql.NewList(graphql.String)),
+			},
+			"groupSort":   groupSortArgument(class.Class),
+			"groupByDate": groupByDateArgument(class.Class),
+			"tenant": &graphql.ArgumentConfig{
+				Description: descriptions.Tenant,
+				Type:        graphql.String,
+			},
+			"nearVector": nearVectorArgument(class.Class),
+			"nearObject": nearObjectArgument(class.Class),
+			"objectLimit": &graphql.ArgumentConfig{
+				Description: descriptions.ObjectLimit,
+				Type:        graphql.Int,
+			},
 		},
-		Resolve: makeResolveClass(),
+		Resolve: makeResolveClass(modulesProvider),
+	}
+
+	if modulesProvider != nil {
+		for name, argument := range modulesProvider.AggregateArguments(class) {
+			fieldsField.Args[name] = argument
+		}
 	}
 
 	return fieldsField, nil
@@ -168,6 +208,21 @@ func classPropertyFields(class *models.Class) (graphql.Fields, error) {
 	return fields, nil
 }
 
+func subGroupsField(groupObject *graphql.Object) *graphql.Field {
+	return &graphql.Field{
+		Description: descriptions.AggregateSubGroups,
+		Type:        graphql.NewList(groupObject),
+		Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+			group, ok := p.Source.(aggregation.Group)
+			if !ok {
+				return nil, fmt.Errorf("subGroups: expected aggregation.Group, got %T", p.Source)
+			}
+
+			return group.Groups, nil
+		},
+	}
+}
+
 func metaObject(prefix string) *graphql.Object {
 	return graphql.NewObject(graphql.ObjectConfig{
 		Name: fmt.Sprintf("%sMetaObject", prefix),
@@ -188,6 +243,12 @@ func metaObject(prefix string) *graphql.Object {
 }
 
 func classPropertyField(dataType schema.DataType, class *models.Class, property *models.Property) (*graphql.Field, error) {
+	if elemType, ok := schema.ArrayElementDataType(dataType); ok {
+		// the elements of array props are aggregated like the values of their
+		// non-array counterpart
+		return classPropertyField(elemType, class, property)
+	}
+
 	switch dataType {
 	case schema.DataTypeString:
 		return makePropertyField(class, property, stringPropertyFields)
@@ -200,7 +261,7 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 	case schema.DataTypeBoolean:
 		return makePropertyField(class, property, booleanPropertyFields)
 	case schema.DataTypeDate:
-		return makePropertyField(class, property, nonNumericPropertyFields)
+		return makePropertyField(class, property, datePropertyFields)
 	case schema.DataTypeCRef:
 		return makePropertyField(class, property, referencePropertyFields)
 	case schema.DataTypeGeoCoordinates:
@@ -211,6 +272,9 @@ func classPropertyField(dataType schema.DataType, class *models.Class, property
 		return nil, nil
 	case schema.DataTypeBlob:
 		return makePropertyField(class, property, stringPropertyFields)
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		// nested objects can't be aggregated as a whole
+		return nil, nil
 	default:
 		return nil, fmt.Errorf(schema.ErrorNoSuchDatatype+": %s", dataType)
 	}
@@ -0,0 +1,149 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregate
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+func nearVectorArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("AggregateObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sNearVectorInpObj", prefix),
+				Fields: nearVectorFields(),
+			},
+		),
+	}
+}
+
+func nearVectorFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"vector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Vector,
+			Type:        graphql.NewNonNull(graphql.NewList(graphql.Float)),
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+		"targetVector": &graphql.InputObjectFieldConfig{
+			Description: descriptions.TargetVector,
+			Type:        graphql.String,
+		},
+	}
+}
+
+func nearObjectArgument(className string) *graphql.ArgumentConfig {
+	prefix := fmt.Sprintf("AggregateObjects%s", className)
+	return &graphql.ArgumentConfig{
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name:   fmt.Sprintf("%sNearObjectInpObj", prefix),
+				Fields: nearObjectFields(),
+			},
+		),
+	}
+}
+
+func nearObjectFields() graphql.InputObjectConfigFieldMap {
+	return graphql.InputObjectConfigFieldMap{
+		"id": &graphql.InputObjectFieldConfig{
+			Description: descriptions.ID,
+			Type:        graphql.String,
+		},
+		"beacon": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Beacon,
+			Type:        graphql.String,
+		},
+		"certainty": &graphql.InputObjectFieldConfig{
+			Description: descriptions.Certainty,
+			Type:        graphql.Float,
+		},
+	}
+}
+
+var (
+	groupSortByEnum = graphql.NewEnum(graphql.EnumConfig{
+		Name: "AggregateGroupSortByEnum",
+		Values: graphql.EnumValueConfigMap{
+			"count": &graphql.EnumValueConfig{Value: traverser.GroupSortByCount},
+			"value": &graphql.EnumValueConfig{Value: traverser.GroupSortByValue},
+		},
+	})
+
+	sortOrderEnum = graphql.NewEnum(graphql.EnumConfig{
+		Name: "AggregateSortOrderEnum",
+		Values: graphql.EnumValueConfigMap{
+			"asc":  &graphql.EnumValueConfig{Value: traverser.SortOrderAsc},
+			"desc": &graphql.EnumValueConfig{Value: traverser.SortOrderDesc},
+		},
+	})
+)
+
+func groupSortArgument(className string) *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Description: descriptions.GroupSort,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: fmt.Sprintf("AggregateObjects%sGroupSortInpObj", className),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"by": &graphql.InputObjectFieldConfig{
+						Description: descriptions.GroupSortBy,
+						Type:        graphql.NewNonNull(groupSortByEnum),
+					},
+					"order": &graphql.InputObjectFieldConfig{
+						Description:  descriptions.GroupSortOrder,
+						Type:         sortOrderEnum,
+						DefaultValue: traverser.SortOrderDesc,
+					},
+				},
+			},
+		),
+	}
+}
+
+var dateIntervalEnum = graphql.NewEnum(graphql.EnumConfig{
+	Name: "AggregateDateIntervalEnum",
+	Values: graphql.EnumValueConfigMap{
+		"day":   &graphql.EnumValueConfig{Value: traverser.DateIntervalDay},
+		"week":  &graphql.EnumValueConfig{Value: traverser.DateIntervalWeek},
+		"month": &graphql.EnumValueConfig{Value: traverser.DateIntervalMonth},
+		"year":  &graphql.EnumValueConfig{Value: traverser.DateIntervalYear},
+	},
+})
+
+func groupByDateArgument(className string) *graphql.ArgumentConfig {
+	return &graphql.ArgumentConfig{
+		Description: descriptions.GroupByDate,
+		Type: graphql.NewInputObject(
+			graphql.InputObjectConfig{
+				Name: fmt.Sprintf("AggregateObjects%sGroupByDateInpObj", className),
+				Fields: graphql.InputObjectConfigFieldMap{
+					"interval": &graphql.InputObjectFieldConfig{
+						Description: descriptions.GroupByDateInterval,
+						Type:        graphql.NewNonNull(dateIntervalEnum),
+					},
+					"timezone": &graphql.InputObjectFieldConfig{
+						Description: descriptions.GroupByDateTimezone,
+						Type:        graphql.String,
+					},
+				},
+			},
+		),
+	}
+}
@@ -64,6 +64,68 @@ func numericPropertyFields(class *models.Class, property *models.Property, prefi
 			Type:        graphql.Int,
 			Resolve:     makeResolveNumericFieldAggregator("count"),
 		},
+		"stddev": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sStddev", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateStddev,
+			Type:        graphql.Float,
+			Resolve:     makeResolveNumericFieldAggregator("stddev"),
+		},
+		"variance": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sVariance", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateVariance,
+			Type:        graphql.Float,
+			Resolve:     makeResolveNumericFieldAggregator("variance"),
+		},
+		"percentile": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sPercentile", prefix, class.Class, property.Name),
+			Description: descriptions.AggregatePercentile,
+			Type:        graphql.NewList(numericPercentile(class, property, prefix)),
+			Args: graphql.FieldConfigArgument{
+				"values": &graphql.ArgumentConfig{
+					Description: descriptions.AggregatePercentileValues,
+					Type:        graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.Float))),
+				},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				prop, ok := p.Source.(aggregation.Property)
+				if !ok {
+					return nil, fmt.Errorf("numerical: percentile: expected aggregation.Property, got %T", p.Source)
+				}
+
+				list := make([]interface{}, len(prop.Percentiles))
+				for i, percentile := range prop.Percentiles {
+					list[i] = percentile
+				}
+				return list, nil
+			},
+		},
+		"histogram": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sHistogram", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateHistogram,
+			Type:        graphql.NewList(numericHistogramBucket(class, property, prefix)),
+			Args: graphql.FieldConfigArgument{
+				"buckets": &graphql.ArgumentConfig{
+					Description: descriptions.AggregateHistogramBuckets,
+					Type:        graphql.Int,
+				},
+				"interval": &graphql.ArgumentConfig{
+					Description: descriptions.AggregateHistogramInterval,
+					Type:        graphql.Float,
+				},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				prop, ok := p.Source.(aggregation.Property)
+				if !ok {
+					return nil, fmt.Errorf("numerical: histogram: expected aggregation.Property, got %T", p.Source)
+				}
+
+				list := make([]interface{}, len(prop.Histogram))
+				for i, bucket := range prop.Histogram {
+					list[i] = bucket
+				}
+				return list, nil
+			},
+		},
 		"type": &graphql.Field{
 			Name:        fmt.Sprintf("%s%s%sType", prefix, class.Class, property.Name),
 			Description: descriptions.AggregateCount,
@@ -86,24 +148,149 @@ func numericPropertyFields(class *models.Class, property *models.Property, prefi
 	})
 }
 
-func nonNumericPropertyFields(class *models.Class,
+func numericPercentile(class *models.Class,
 	property *models.Property, prefix string) *graphql.Object {
-	getMetaPointingFields := graphql.Fields{
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%s%s%sPercentileObj", prefix, class.Class, property.Name),
+		Fields: graphql.Fields{
+			"percentile": &graphql.Field{
+				Description: descriptions.AggregatePercentileResultPercentile,
+				Type:        graphql.Float,
+				Resolve: percentileResolver(func(p aggregation.Percentile) interface{} {
+					return p.Percentile
+				}),
+			},
+			"value": &graphql.Field{
+				Description: descriptions.AggregatePercentileResultValue,
+				Type:        graphql.Float,
+				Resolve:     percentileResolver(func(p aggregation.Percentile) interface{} { return p.Value }),
+			},
+		},
+		Description: descriptions.AggregatePercentileObj,
+	})
+}
+
+func percentileResolver(extractor func(aggregation.Percentile) interface{}) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		percentile, ok := p.Source.(aggregation.Percentile)
+		if !ok {
+			return nil, fmt.Errorf("percentile: %s: expected aggregation.Percentile, but got %T",
+				p.Info.FieldName, p.Source)
+		}
+
+		return extractor(percentile), nil
+	}
+}
+
+func numericHistogramBucket(class *models.Class,
+	property *models.Property, prefix string) *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: fmt.Sprintf("%s%s%sHistogramObj", prefix, class.Class, property.Name),
+		Fields: graphql.Fields{
+			"from": &graphql.Field{
+				Description: descriptions.AggregateHistogramFrom,
+				Type:        graphql.Float,
+				Resolve:     histogramResolver(func(b aggregation.HistogramBucket) interface{} { return b.From }),
+			},
+			"to": &graphql.Field{
+				Description: descriptions.AggregateHistogramTo,
+				Type:        graphql.Float,
+				Resolve:     histogramResolver(func(b aggregation.HistogramBucket) interface{} { return b.To }),
+			},
+			"count": &graphql.Field{
+				Description: descriptions.AggregateHistogramCount,
+				Type:        graphql.Int,
+				Resolve:     histogramResolver(func(b aggregation.HistogramBucket) interface{} { return b.Count }),
+			},
+		},
+		Description: descriptions.AggregateHistogramObj,
+	})
+}
+
+func histogramResolver(extractor func(aggregation.HistogramBucket) interface{}) graphql.FieldResolveFn {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		bucket, ok := p.Source.(aggregation.HistogramBucket)
+		if !ok {
+			return nil, fmt.Errorf("histogram: %s: expected aggregation.HistogramBucket, but got %T",
+				p.Info.FieldName, p.Source)
+		}
+
+		return extractor(bucket), nil
+	}
+}
+
+func datePropertyFields(class *models.Class,
+	property *models.Property, prefix string) *graphql.Object {
+	getMetaDateFields := graphql.Fields{
 		"count": &graphql.Field{
-			Name:        fmt.Sprintf("%s%sCount", prefix, class.Class),
-			Description: descriptions.AggregateCount,
+			Name:        fmt.Sprintf("%s%s%sCount", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateDateCount,
 			Type:        graphql.Int,
-			Resolve:     makeResolveNumericFieldAggregator("count"),
+			Resolve:     makeResolveDateFieldAggregator("count"),
+		},
+		"minimum": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sMinimum", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateDateMin,
+			Type:        graphql.String,
+			Resolve:     makeResolveDateFieldAggregator("minimum"),
+		},
+		"maximum": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sMaximum", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateDateMax,
+			Type:        graphql.String,
+			Resolve:     makeResolveDateFieldAggregator("maximum"),
+		},
+		"median": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sMedian", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateDateMedian,
+			Type:        graphql.String,
+			Resolve:     makeResolveDateFieldAggregator("median"),
+		},
+		"mode": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sMode", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateDateMode,
+			Type:        graphql.String,
+			Resolve:     makeResolveDateFieldAggregator("mode"),
+		},
+		"type": &graphql.Field{
+			Name:        fmt.Sprintf("%s%s%sType", prefix, class.Class, property.Name),
+			Description: descriptions.AggregateCount,
+			Type:        graphql.String,
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				prop, ok := p.Source.(aggregation.Property)
+				if !ok {
+					return nil, fmt.Errorf("date: type: expected aggregation.Property, got %T", p.Source)
+				}
+
+				return prop.SchemaType, nil
+			},
 		},
 	}
 
 	return graphql.NewObject(graphql.ObjectConfig{
 		Name:        fmt.Sprintf("%s%s%sObj", prefix, class.Class, property.Name),
-		Fields:      getMetaPointingFields,
+		Fields:      getMetaDateFields,
 		Description: descriptions.AggregatePropertyObject,
 	})
 }
 
+func makeResolveDateFieldAggregator(aggregator string) func(p graphql.ResolveParams) (interface{}, error) {
+	return func(p graphql.ResolveParams) (interface{}, error) {
+		property, ok := p.Source.(aggregation.Property)
+		if !ok {
+			return nil, fmt.Errorf("date aggregator %s: expected aggregation.Property, got %T",
+				aggregator, p.Source)
+		}
+
+		if property.Type != aggregation.PropertyTypeDate {
+			return nil, fmt.Errorf("date aggregator %s: expected property to be of type date, got %s",
+				aggregator, property.Type)
+		}
+
+		return property.DateAggregations[aggregator], nil
+	}
+}
+
 func referencePropertyFields(class *models.Class,
 	property *models.Property, prefix string) *graphql.Object {
 	getMetaPointingFields := graphql.Fields{
@@ -254,6 +441,14 @@ func stringPropertyFields(class *models.Class,
 				return prop.SchemaType, nil
 			},
 		},
+		"approximateDistinctCount": &graphql.Field{
+			Name:        fmt.Sprintf("%s%sApproximateDistinctCount", prefix, class.Class),
+			Description: descriptions.AggregatePropertyApproximateDistinctCount,
+			Type:        graphql.Int,
+			Resolve: textResolver(func(text aggregation.Text) (interface{}, error) {
+				return text.ApproximateDistinctCount, nil
+			}),
+		},
 		"topOccurrences": &graphql.Field{
 			Name:        fmt.Sprintf("%s%sTopOccurrences", prefix, class.Class),
 			Description: descriptions.AggregatePropertyTopOccurrences,
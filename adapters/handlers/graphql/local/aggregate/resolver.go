@@ -34,6 +34,11 @@ import (
 // itself, as it just displays meta info about the overall aggregation.
 const GroupedByFieldName = "groupedBy"
 
+// SubGroupsFieldName is a special graphQL field which contains the nested
+// groups. Its selection set specifies the props to aggregate within the nested
+// groups, which are aggregated alongside the props of the top-level groups.
+const SubGroupsFieldName = "subGroups"
+
 // Resolver is a local interface that can be composed with other interfaces to
 // form the overall GraphQL API main interface. All data-base connectors that
 // want to support the Meta feature must implement this interface.
@@ -47,7 +52,7 @@ type RequestsLog interface {
 	Register(requestType string, identifier string)
 }
 
-func makeResolveClass() graphql.FieldResolveFn {
+func makeResolveClass(modulesProvider ModulesProvider) graphql.FieldResolveFn {
 	return func(p graphql.ResolveParams) (interface{}, error) {
 		className := schema.ClassName(p.Info.FieldName)
 		source, ok := p.Source.(map[string]interface{})
@@ -86,6 +91,46 @@ func makeResolveClass() graphql.FieldResolveFn {
 			return nil, fmt.Errorf("could not extract filters: %s", err)
 		}
 
+		var tenant string
+		if t, ok := p.Args["tenant"]; ok {
+			tenant = t.(string) // guaranteed by graphql
+		}
+
+		var nearVectorParams *traverser.NearVectorParams
+		if nearVector, ok := p.Args["nearVector"]; ok {
+			p := common_filters.ExtractNearVector(nearVector.(map[string]interface{}))
+			nearVectorParams = &p
+		}
+
+		var nearObjectParams *traverser.NearObjectParams
+		if nearObject, ok := p.Args["nearObject"]; ok {
+			p := common_filters.ExtractNearObject(nearObject.(map[string]interface{}))
+			nearObjectParams = &p
+		}
+
+		var moduleParams map[string]interface{}
+		if modulesProvider != nil {
+			extractedParams := modulesProvider.ExtractSearchParams(p.Args)
+			if len(extractedParams) > 0 {
+				moduleParams = extractedParams
+			}
+		}
+
+		dateGrouping := extractGroupByDate(p.Args)
+
+		subGroupBy, err := extractSubGroupBy(p.Args, p.Info.FieldName)
+		if err != nil {
+			return nil, fmt.Errorf("could not extract subGroupBy paths: %s", err)
+		}
+
+		groupSort := extractGroupSort(p.Args)
+
+		var objectLimit *int
+		if l, ok := p.Args["objectLimit"]; ok {
+			asInt := l.(int) // guaranteed by graphql
+			objectLimit = &asInt
+		}
+
 		params := &traverser.AggregateParams{
 			Filters:          filters,
 			ClassName:        className,
@@ -93,6 +138,14 @@ func makeResolveClass() graphql.FieldResolveFn {
 			GroupBy:          groupBy,
 			IncludeMetaCount: includeMeta,
 			Limit:            limit,
+			Tenant:           tenant,
+			NearVector:       nearVectorParams,
+			NearObject:       nearObjectParams,
+			ModuleParams:     moduleParams,
+			ObjectLimit:      objectLimit,
+			DateGrouping:     dateGrouping,
+			SubGroupBy:       subGroupBy,
+			GroupSort:        groupSort,
 		}
 
 		res, err := resolver.Aggregate(p.Context, principalFromContext(p.Context), params)
@@ -132,6 +185,17 @@ func extractProperties(selections *ast.SelectionSet) ([]traverser.AggregatePrope
 			continue
 		}
 
+		if name == SubGroupsFieldName {
+			subProperties, subIncludeMeta, err := extractProperties(field.SelectionSet)
+			if err != nil {
+				return nil, false, err
+			}
+
+			properties = mergeProperties(properties, subProperties)
+			includeMeta = includeMeta || subIncludeMeta
+			continue
+		}
+
 		if name == "__typename" {
 			continue
 		}
@@ -144,12 +208,50 @@ func extractProperties(selections *ast.SelectionSet) ([]traverser.AggregatePrope
 		}
 
 		property.Aggregators = aggregators
-		properties = append(properties, property)
+		properties = mergeProperties(properties, []traverser.AggregateProperty{property})
 	}
 
 	return properties, includeMeta, nil
 }
 
+// mergeProperties adds the props to the existing ones. A prop which is
+// selected on multiple groupBy levels is aggregated only once, with the
+// aggregators of all levels. If an aggregator with params, such as
+// topOccurrences, is selected on multiple levels, the params of the first one
+// are used.
+func mergeProperties(existing,
+	props []traverser.AggregateProperty) []traverser.AggregateProperty {
+outer:
+	for _, prop := range props {
+		for i := range existing {
+			if existing[i].Name != prop.Name {
+				continue
+			}
+
+			for _, agg := range prop.Aggregators {
+				if !containsAggregatorType(existing[i].Aggregators, agg.Type) {
+					existing[i].Aggregators = append(existing[i].Aggregators, agg)
+				}
+			}
+			continue outer
+		}
+
+		existing = append(existing, prop)
+	}
+
+	return existing
+}
+
+func containsAggregatorType(aggs []traverser.Aggregator, aggType string) bool {
+	for _, agg := range aggs {
+		if agg.Type == aggType {
+			return true
+		}
+	}
+
+	return false
+}
+
 func extractAggregators(selections *ast.SelectionSet) ([]traverser.Aggregator, error) {
 	if selections == nil {
 		return nil, nil
@@ -173,6 +275,22 @@ func extractAggregators(selections *ast.SelectionSet) ([]traverser.Aggregator, e
 			}
 		}
 
+		switch property.Type {
+		case traverser.PercentileType:
+			property, err = traverser.NewPercentileAggregator(
+				extractFloatListFromArgs(field.Arguments, "values"))
+			if err != nil {
+				return nil, err
+			}
+		case traverser.HistogramType:
+			property, err = traverser.NewHistogramAggregator(
+				extractIntFromArgs(field.Arguments, "buckets"),
+				extractFloatFromArgs(field.Arguments, "interval"))
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		analyses = append(analyses, property)
 	}
 
@@ -194,6 +312,60 @@ func extractGroupBy(args map[string]interface{}, rootClass string) (*filters.Pat
 	return filters.ParsePath(pathSegments, rootClass)
 }
 
+func extractSubGroupBy(args map[string]interface{}, rootClass string) ([]*filters.Path, error) {
+	subGroupBy, ok := args["subGroupBy"]
+	if !ok {
+		return nil, nil
+	}
+
+	levels := subGroupBy.([]interface{}) // guaranteed by graphql
+	out := make([]*filters.Path, len(levels))
+	for i, level := range levels {
+		pathSegments, ok := level.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("level %d must be a list, instead got: %#v", i, level)
+		}
+
+		path, err := filters.ParsePath(pathSegments, rootClass)
+		if err != nil {
+			return nil, fmt.Errorf("level %d: %s", i, err)
+		}
+		out[i] = path
+	}
+
+	return out, nil
+}
+
+func extractGroupSort(args map[string]interface{}) *traverser.GroupSort {
+	groupSort, ok := args["groupSort"]
+	if !ok {
+		return nil
+	}
+
+	asMap := groupSort.(map[string]interface{}) // guaranteed by graphql
+	return &traverser.GroupSort{
+		By:    asMap["by"].(traverser.GroupSortBy),  // guaranteed by graphql
+		Order: asMap["order"].(traverser.SortOrder), // guaranteed by graphql
+	}
+}
+
+func extractGroupByDate(args map[string]interface{}) *traverser.DateGrouping {
+	groupByDate, ok := args["groupByDate"]
+	if !ok {
+		return nil
+	}
+
+	asMap := groupByDate.(map[string]interface{}) // guaranteed by graphql
+	out := &traverser.DateGrouping{
+		Interval: asMap["interval"].(traverser.DateInterval), // guaranteed by graphql
+	}
+	if timezone, ok := asMap["timezone"]; ok {
+		out.Timezone = timezone.(string)
+	}
+
+	return out
+}
+
 func principalFromContext(ctx context.Context) *models.Principal {
 	principal := ctx.Value("principal")
 	if principal == nil {
@@ -219,8 +391,12 @@ func extractLimit(args map[string]interface{}) (*int, error) {
 }
 
 func extractLimitFromArgs(args []*ast.Argument) *int {
+	return extractIntFromArgs(args, "limit")
+}
+
+func extractIntFromArgs(args []*ast.Argument, name string) *int {
 	for _, arg := range args {
-		if arg.Name.Value != "limit" {
+		if arg.Name.Value != name {
 			continue
 		}
 
@@ -233,3 +409,45 @@ func extractLimitFromArgs(args []*ast.Argument) *int {
 
 	return nil
 }
+
+func extractFloatFromArgs(args []*ast.Argument, name string) *float64 {
+	for _, arg := range args {
+		if arg.Name.Value != name {
+			continue
+		}
+
+		v, ok := arg.Value.GetValue().(string)
+		if ok {
+			asFloat, _ := strconv.ParseFloat(v, 64)
+			return &asFloat
+		}
+	}
+
+	return nil
+}
+
+func extractFloatListFromArgs(args []*ast.Argument, name string) []float64 {
+	for _, arg := range args {
+		if arg.Name.Value != name {
+			continue
+		}
+
+		var out []float64
+		values, ok := arg.Value.GetValue().([]ast.Value)
+		if !ok {
+			// a single value is coerced into a list
+			values = []ast.Value{arg.Value}
+		}
+		for _, value := range values {
+			v, ok := value.GetValue().(string)
+			if ok {
+				asFloat, _ := strconv.ParseFloat(v, 64)
+				out = append(out, asFloat)
+			}
+		}
+
+		return out
+	}
+
+	return nil
+}
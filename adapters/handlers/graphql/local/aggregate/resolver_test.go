@@ -32,6 +32,12 @@ type testCase struct {
 	expectedWhereFilter      *filters.LocalFilter
 	expectedIncludeMetaCount bool
 	expectedLimit            *int
+	expectedNearVector       *traverser.NearVectorParams
+	expectedNearObject       *traverser.NearObjectParams
+	expectedObjectLimit      *int
+	expectedDateGrouping     *traverser.DateGrouping
+	expectedSubGroupBy       []*filters.Path
+	expectedGroupSort        *traverser.GroupSort
 }
 
 type testCases []testCase
@@ -208,6 +214,333 @@ func Test_Resolve(t *testing.T) {
 				},
 			}},
 		},
+		testCase{
+			name: "with numerical statistics",
+			query: `{ Aggregate { Car { horsepower {
+				stddev
+				variance
+				percentile(values:[50, 99.5]) { percentile value }
+				histogram(buckets:2) { from to count }
+			} } } }`,
+			expectedProps: []traverser.AggregateProperty{
+				{
+					Name: "horsepower",
+					Aggregators: []traverser.Aggregator{
+						traverser.StandardDeviationAggregator,
+						traverser.VarianceAggregator,
+						{
+							Type:        traverser.PercentileType,
+							Percentiles: &traverser.PercentileParams{Values: []float64{50, 99.5}},
+						},
+						{
+							Type:      traverser.HistogramType,
+							Histogram: &traverser.HistogramParams{Buckets: 2},
+						},
+					},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				aggregation.Group{
+					Properties: map[string]aggregation.Property{
+						"horsepower": aggregation.Property{
+							Type: aggregation.PropertyTypeNumerical,
+							NumericalAggregations: map[string]float64{
+								"stddev":   10,
+								"variance": 100,
+							},
+							Percentiles: []aggregation.Percentile{
+								{Percentile: 50, Value: 200},
+								{Percentile: 99.5, Value: 400},
+							},
+							Histogram: []aggregation.HistogramBucket{
+								{From: 100, To: 250, Count: 7},
+								{From: 250, To: 400, Count: 3},
+							},
+						},
+					},
+				},
+			},
+
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{
+							"stddev":   10.0,
+							"variance": 100.0,
+							"percentile": []interface{}{
+								map[string]interface{}{"percentile": 50.0, "value": 200.0},
+								map[string]interface{}{"percentile": 99.5, "value": 400.0},
+							},
+							"histogram": []interface{}{
+								map[string]interface{}{"from": 100.0, "to": 250.0, "count": 7},
+								map[string]interface{}{"from": 250.0, "to": 400.0, "count": 3},
+							},
+						},
+					},
+				},
+			}},
+		},
+		testCase{
+			name: "scoped to the objects closest to a vector",
+			query: `{ Aggregate { Car(nearVector:{vector:[0.1, -0.2]}, objectLimit:500) {
+				horsepower { mean } } } }`,
+			expectedProps: []traverser.AggregateProperty{
+				{
+					Name:        "horsepower",
+					Aggregators: []traverser.Aggregator{traverser.MeanAggregator},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				aggregation.Group{
+					Properties: map[string]aggregation.Property{
+						"horsepower": aggregation.Property{
+							Type: aggregation.PropertyTypeNumerical,
+							NumericalAggregations: map[string]float64{
+								"mean": 275.7773,
+							},
+						},
+					},
+				},
+			},
+
+			expectedNearVector: &traverser.NearVectorParams{
+				Vector: []float32{0.1, -0.2},
+			},
+			expectedObjectLimit: ptInt(500),
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{"mean": 275.7773},
+					},
+				},
+			}},
+		},
+		testCase{
+			name: "scoped to the objects closest to another object",
+			query: `{ Aggregate { Car(nearObject:{id:"d5a7fbae-67e9-4b96-9e8b-2d5a6b3c0e8f", certainty:0.7}, objectLimit:10) {
+				horsepower { mean } } } }`,
+			expectedProps: []traverser.AggregateProperty{
+				{
+					Name:        "horsepower",
+					Aggregators: []traverser.Aggregator{traverser.MeanAggregator},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				aggregation.Group{
+					Properties: map[string]aggregation.Property{
+						"horsepower": aggregation.Property{
+							Type: aggregation.PropertyTypeNumerical,
+							NumericalAggregations: map[string]float64{
+								"mean": 275.7773,
+							},
+						},
+					},
+				},
+			},
+
+			expectedNearObject: &traverser.NearObjectParams{
+				ID:        "d5a7fbae-67e9-4b96-9e8b-2d5a6b3c0e8f",
+				Certainty: 0.7,
+			},
+			expectedObjectLimit: ptInt(10),
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{"mean": 275.7773},
+					},
+				},
+			}},
+		},
+		testCase{
+			name: "date aggregations grouped by month",
+			query: `{ Aggregate { Car(groupBy:["startOfProduction"],
+				groupByDate:{interval:month, timezone:"Europe/Amsterdam"}) {
+				startOfProduction { count minimum maximum median mode type }
+				groupedBy { value }
+			} } }`,
+			expectedProps: []traverser.AggregateProperty{
+				{
+					Name: "startOfProduction",
+					Aggregators: []traverser.Aggregator{
+						traverser.CountAggregator,
+						traverser.MinimumAggregator,
+						traverser.MaximumAggregator,
+						traverser.MedianAggregator,
+						traverser.ModeAggregator,
+						traverser.TypeAggregator,
+					},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				aggregation.Group{
+					GroupedBy: &aggregation.GroupedBy{
+						Path:  []string{"startOfProduction"},
+						Value: "2020-03-01T00:00:00+01:00",
+					},
+					Properties: map[string]aggregation.Property{
+						"startOfProduction": aggregation.Property{
+							Type:       aggregation.PropertyTypeDate,
+							SchemaType: "date",
+							DateAggregations: map[string]interface{}{
+								"count":   2,
+								"minimum": "2020-03-02T10:00:00Z",
+								"maximum": "2020-03-20T10:00:00Z",
+								"median":  "2020-03-11T10:00:00Z",
+								"mode":    "2020-03-02T10:00:00Z",
+							},
+						},
+					},
+				},
+			},
+
+			expectedGroupBy: &filters.Path{
+				Class:    schema.ClassName("Car"),
+				Property: schema.PropertyName("startOfProduction"),
+			},
+			expectedDateGrouping: &traverser.DateGrouping{
+				Interval: traverser.DateIntervalMonth,
+				Timezone: "Europe/Amsterdam",
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"startOfProduction": map[string]interface{}{
+							"count":   2,
+							"minimum": "2020-03-02T10:00:00Z",
+							"maximum": "2020-03-20T10:00:00Z",
+							"median":  "2020-03-11T10:00:00Z",
+							"mode":    "2020-03-02T10:00:00Z",
+							"type":    "date",
+						},
+						"groupedBy": map[string]interface{}{
+							"value": "2020-03-01T00:00:00+01:00",
+						},
+					},
+				},
+			}},
+		},
+		testCase{
+			name: "nested groups sorted by value",
+			query: `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"],
+				subGroupBy:[["modelName"]], groupSort:{by:value, order:asc}, limit:2) {
+				groupedBy { value }
+				horsepower { mean }
+				subGroups {
+					groupedBy { value path }
+					meta { count }
+					horsepower { mean maximum }
+					modelName { approximateDistinctCount }
+				}
+			} } }`,
+			expectedProps: []traverser.AggregateProperty{
+				{
+					Name: "horsepower",
+					Aggregators: []traverser.Aggregator{
+						traverser.MeanAggregator,
+						traverser.MaximumAggregator,
+					},
+				},
+				{
+					Name:        "modelName",
+					Aggregators: []traverser.Aggregator{traverser.ApproximateDistinctCountAggregator},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				aggregation.Group{
+					GroupedBy: &aggregation.GroupedBy{
+						Path:  []string{"madeBy", "Manufacturer", "name"},
+						Value: "Audi",
+					},
+					Properties: map[string]aggregation.Property{
+						"horsepower": aggregation.Property{
+							Type:                  aggregation.PropertyTypeNumerical,
+							NumericalAggregations: map[string]float64{"mean": 200},
+						},
+					},
+					Groups: []aggregation.Group{
+						aggregation.Group{
+							GroupedBy: &aggregation.GroupedBy{
+								Path:  []string{"modelName"},
+								Value: "A4",
+							},
+							Count: 3,
+							Properties: map[string]aggregation.Property{
+								"horsepower": aggregation.Property{
+									Type: aggregation.PropertyTypeNumerical,
+									NumericalAggregations: map[string]float64{
+										"mean":    150,
+										"maximum": 190,
+									},
+								},
+								"modelName": aggregation.Property{
+									Type: aggregation.PropertyTypeText,
+									TextAggregation: aggregation.Text{
+										Count:                    3,
+										ApproximateDistinctCount: 1,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			expectedIncludeMetaCount: true,
+			expectedLimit:            ptInt(2),
+			expectedGroupBy:          groupCarByMadeByManufacturerName(),
+			expectedSubGroupBy: []*filters.Path{
+				{
+					Class:    schema.ClassName("Car"),
+					Property: schema.PropertyName("modelName"),
+				},
+			},
+			expectedGroupSort: &traverser.GroupSort{
+				By:    traverser.GroupSortByValue,
+				Order: traverser.SortOrderAsc,
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"groupedBy":  map[string]interface{}{"value": "Audi"},
+						"horsepower": map[string]interface{}{"mean": 200.0},
+						"subGroups": []interface{}{
+							map[string]interface{}{
+								"groupedBy": map[string]interface{}{
+									"value": "A4",
+									"path":  []interface{}{"modelName"},
+								},
+								"meta":       map[string]interface{}{"count": 3},
+								"horsepower": map[string]interface{}{"mean": 150.0, "maximum": 190.0},
+								"modelName":  map[string]interface{}{"approximateDistinctCount": 1},
+							},
+						},
+					},
+				},
+			}},
+		},
+		testCase{
+			name:                     "groups sorted by count with the default order",
+			query:                    `{ Aggregate { Car(groupBy:["madeBy", "Manufacturer", "name"], groupSort:{by:count}) { meta { count } } } }`,
+			expectedProps:            []traverser.AggregateProperty{},
+			resolverReturn:           []aggregation.Group{{Count: 7}},
+			expectedIncludeMetaCount: true,
+			expectedGroupBy:          groupCarByMadeByManufacturerName(),
+			expectedGroupSort: &traverser.GroupSort{
+				By:    traverser.GroupSortByCount,
+				Order: traverser.SortOrderDesc,
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Car"},
+				expectedValue: []interface{}{
+					map[string]interface{}{"meta": map[string]interface{}{"count": 7}},
+				},
+			}},
+		},
 		testCase{
 			name: "with props formerly contained only in Meta",
 			query: `{ Aggregate { Car { 
@@ -621,6 +954,54 @@ func Test_Resolve(t *testing.T) {
 	tests.AssertExtraction(t, "Car")
 }
 
+func Test_ResolveAlias(t *testing.T) {
+	t.Parallel()
+
+	tests := testCases{
+		testCase{
+			name: "aggregating through an alias",
+			query: `{ Aggregate { Vehicle(groupBy:["modelName"]) {
+				horsepower { mean } } } }`,
+			expectedProps: []traverser.AggregateProperty{
+				{
+					Name:        "horsepower",
+					Aggregators: []traverser.Aggregator{traverser.MeanAggregator},
+				},
+			},
+			resolverReturn: []aggregation.Group{
+				aggregation.Group{
+					Properties: map[string]aggregation.Property{
+						"horsepower": aggregation.Property{
+							Type: aggregation.PropertyTypeNumerical,
+							NumericalAggregations: map[string]float64{
+								"mean": 275.7773,
+							},
+						},
+					},
+				},
+			},
+
+			// the alias is passed on as is, it is resolved by the traverser
+			expectedGroupBy: &filters.Path{
+				Class:    schema.ClassName("Vehicle"),
+				Property: schema.PropertyName("modelName"),
+			},
+			expectedResults: []result{{
+				pathToField: []string{"Aggregate", "Vehicle"},
+				expectedValue: []interface{}{
+					map[string]interface{}{
+						"horsepower": map[string]interface{}{
+							"mean": 275.7773,
+						},
+					},
+				},
+			}},
+		},
+	}
+
+	tests.AssertExtraction(t, "Vehicle")
+}
+
 func (tests testCases) AssertExtraction(t *testing.T, className string) {
 	for _, testCase := range tests {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -633,6 +1014,12 @@ func (tests testCases) AssertExtraction(t *testing.T, className string) {
 				Filters:          testCase.expectedWhereFilter,
 				IncludeMetaCount: testCase.expectedIncludeMetaCount,
 				Limit:            testCase.expectedLimit,
+				NearVector:       testCase.expectedNearVector,
+				NearObject:       testCase.expectedNearObject,
+				ObjectLimit:      testCase.expectedObjectLimit,
+				DateGrouping:     testCase.expectedDateGrouping,
+				SubGroupBy:       testCase.expectedSubGroupBy,
+				GroupSort:        testCase.expectedGroupSort,
 			}
 
 			resolver.On("Aggregate", expectedParams).
@@ -15,7 +15,7 @@ import (
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
-// ExtractNearVector arguments, such as "vector" and "certainty"
+// ExtractNearVector arguments, such as "vector", "certainty" and "targetVector"
 func ExtractNearVector(source map[string]interface{}) traverser.NearVectorParams {
 	var args traverser.NearVectorParams
 
@@ -31,5 +31,10 @@ func ExtractNearVector(source map[string]interface{}) traverser.NearVectorParams
 		args.Certainty = certainty.(float64)
 	}
 
+	targetVector, ok := source["targetVector"]
+	if ok {
+		args.TargetVector = targetVector.(string)
+	}
+
 	return args
 }
@@ -71,6 +71,12 @@ func parseClause(args map[string]interface{}, rootClass string) (*filters.Clause
 		clause, err = parseCompareOp(args, filters.OperatorLessThanEqual, rootClass)
 	case "WithinGeoRange":
 		clause, err = parseCompareOp(args, filters.OperatorWithinGeoRange, rootClass)
+	case "ContainsAny":
+		clause, err = parseCompareOp(args, filters.OperatorContainsAny, rootClass)
+	case "ContainsAll":
+		clause, err = parseCompareOp(args, filters.OperatorContainsAll, rootClass)
+	case "IsNull":
+		clause, err = parseCompareOp(args, filters.OperatorIsNull, rootClass)
 	default:
 		err = fmt.Errorf("Unknown operator '%s' in clause %s", operator, jsonify(args))
 	}
@@ -342,6 +348,102 @@ var valueExtractors [](func(args map[string]interface{}) (*filters.Value, error)
 			Value: date,
 		}, nil
 	},
+	// Arrays
+	func(args map[string]interface{}) (*filters.Value, error) {
+		return extractArrayValue(args, "valueIntArray", schema.DataTypeInt,
+			func(elem interface{}) (interface{}, error) {
+				val, ok := elem.(int)
+				if !ok {
+					return nil, fmt.Errorf("not an int")
+				}
+				return val, nil
+			})
+	},
+	func(args map[string]interface{}) (*filters.Value, error) {
+		return extractArrayValue(args, "valueNumberArray", schema.DataTypeNumber,
+			func(elem interface{}) (interface{}, error) {
+				val, ok := elem.(float64)
+				if !ok {
+					return nil, fmt.Errorf("not a float")
+				}
+				return val, nil
+			})
+	},
+	func(args map[string]interface{}) (*filters.Value, error) {
+		return extractArrayValue(args, "valueBooleanArray", schema.DataTypeBoolean,
+			func(elem interface{}) (interface{}, error) {
+				val, ok := elem.(bool)
+				if !ok {
+					return nil, fmt.Errorf("not a boolean")
+				}
+				return val, nil
+			})
+	},
+	func(args map[string]interface{}) (*filters.Value, error) {
+		return extractArrayValue(args, "valueStringArray", schema.DataTypeString,
+			func(elem interface{}) (interface{}, error) {
+				val, ok := elem.(string)
+				if !ok {
+					return nil, fmt.Errorf("not a string")
+				}
+				return val, nil
+			})
+	},
+	func(args map[string]interface{}) (*filters.Value, error) {
+		return extractArrayValue(args, "valueTextArray", schema.DataTypeText,
+			func(elem interface{}) (interface{}, error) {
+				val, ok := elem.(string)
+				if !ok {
+					return nil, fmt.Errorf("not a string")
+				}
+				return val, nil
+			})
+	},
+	func(args map[string]interface{}) (*filters.Value, error) {
+		return extractArrayValue(args, "valueDateArray", schema.DataTypeDate,
+			func(elem interface{}) (interface{}, error) {
+				stringVal, ok := elem.(string)
+				if !ok {
+					return nil, fmt.Errorf("not a date string")
+				}
+
+				date, err := time.Parse(time.RFC3339, stringVal)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse the value '%s' as a date", stringVal)
+				}
+				return date, nil
+			})
+	},
+}
+
+// extractArrayValue extracts the list of values of one of the
+// value<Type>Array fields. The type of the resulting value is the type of the
+// elements, the value itself is a []interface{}.
+func extractArrayValue(args map[string]interface{}, field string, dt schema.DataType,
+	parseElem func(elem interface{}) (interface{}, error)) (*filters.Value, error) {
+	rawVal, ok := args[field]
+	if !ok {
+		return nil, nil
+	}
+
+	list, ok := rawVal.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("the provided %s is not a list", field)
+	}
+
+	values := make([]interface{}, len(list))
+	for i, elem := range list {
+		val, err := parseElem(elem)
+		if err != nil {
+			return nil, fmt.Errorf("element %d of the provided %s: %s", i, field, err)
+		}
+		values[i] = val
+	}
+
+	return &filters.Value{
+		Type:  dt,
+		Value: values,
+	}, nil
 }
 
 func ptFloat32(in float32) *float32 {
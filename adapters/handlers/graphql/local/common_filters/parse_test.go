@@ -107,6 +107,87 @@ func TestExtractFilterLike_ValueText(t *testing.T) {
 	resolver.AssertResolve(t, query)
 }
 
+func TestExtractFilterContainsAny(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorContainsAny,
+		On: &filters.Path{
+			Class:    schema.AssertValidClassName("SomeAction"),
+			Property: schema.AssertValidPropertyName("name"),
+		},
+		Value: &filters.Value{
+			Value: []interface{}{"green", "blue"},
+			Type:  schema.DataTypeString,
+		},
+	}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: {
+			path: ["name"],
+			operator: ContainsAny,
+			valueStringArray: ["green", "blue"],
+		}) }`
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractFilterContainsAll_ValueIntArray(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorContainsAll,
+		On: &filters.Path{
+			Class:    schema.AssertValidClassName("SomeAction"),
+			Property: schema.AssertValidPropertyName("intField"),
+		},
+		Value: &filters.Value{
+			Value: []interface{}{1, 2},
+			Type:  schema.DataTypeInt,
+		},
+	}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: {
+			path: ["intField"],
+			operator: ContainsAll,
+			valueIntArray: [1, 2],
+		}) }`
+	resolver.AssertResolve(t, query)
+}
+
+func TestExtractFilterIsNull(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+	expectedParams := &filters.LocalFilter{Root: &filters.Clause{
+		Operator: filters.OperatorIsNull,
+		On: &filters.Path{
+			Class:    schema.AssertValidClassName("SomeAction"),
+			Property: schema.AssertValidPropertyName("name"),
+		},
+		Value: &filters.Value{
+			Value: true,
+			Type:  schema.DataTypeBoolean,
+		},
+	}}
+
+	resolver.On("ReportFilters", expectedParams).
+		Return(test_helper.EmptyList(), nil).Once()
+
+	query := `{ SomeAction(where: {
+			path: ["name"],
+			operator: IsNull,
+			valueBoolean: true,
+		}) }`
+	resolver.AssertResolve(t, query)
+}
+
 func TestExtractFilterGeoLocation(t *testing.T) {
 	t.Parallel()
 
@@ -73,6 +73,14 @@ func (b *classBuilder) kinds(kindSchema *models.Schema) (*graphql.Object, error)
 		classFields[class.Class] = classField
 	}
 
+	// an alias shares the field of the class it points to, so it can be
+	// queried exactly like that class
+	for _, alias := range kindSchema.Aliases {
+		if classField, ok := classFields[alias.Class]; ok {
+			classFields[alias.Alias] = classField
+		}
+	}
+
 	classes := graphql.NewObject(graphql.ObjectConfig{
 		Name:        "GetObjectsObj",
 		Fields:      classFields,
@@ -13,6 +13,7 @@ package get
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
 	"strings"
@@ -94,6 +95,49 @@ func (b *classBuilder) primitiveField(propertyType schema.PropertyDataType,
 			Name:        property.Name,
 			Type:        graphql.String,
 		}
+	case schema.DataTypeObject:
+		obj := newNestedObject(fmt.Sprintf("%s%s", className, property.Name),
+			property.NestedProperties)
+
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        obj,
+			Resolve:     resolveNestedObject,
+		}
+	case schema.DataTypeObjectArray:
+		obj := newNestedObject(fmt.Sprintf("%s%s", className, property.Name),
+			property.NestedProperties)
+
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.NewList(obj),
+		}
+	case schema.DataTypeStringArray, schema.DataTypeTextArray, schema.DataTypeDateArray:
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.NewList(graphql.String),
+		}
+	case schema.DataTypeIntArray:
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.NewList(graphql.Int),
+		}
+	case schema.DataTypeNumberArray:
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.NewList(graphql.Float),
+		}
+	case schema.DataTypeBooleanArray:
+		return &graphql.Field{
+			Description: property.Description,
+			Name:        property.Name,
+			Type:        graphql.NewList(graphql.Boolean),
+		}
 	default:
 		panic(fmt.Sprintf("buildGetClass: unknown primitive type for %s.%s; %s",
 			className, property.Name, propertyType.AsPrimitive()))
@@ -163,6 +207,48 @@ func newPhoneNumberObject(className string, propertyName string) *graphql.Object
 	})
 }
 
+// newNestedObject builds the type of an object or object[] prop, the types
+// of deeper nested objects are prefixed with the name of their parent
+func newNestedObject(name string,
+	nestedProperties []*models.NestedProperty) *graphql.Object {
+	fields := graphql.Fields{}
+	for _, prop := range nestedProperties {
+		fields[prop.Name] = &graphql.Field{
+			Description: prop.Description,
+			Name:        prop.Name,
+			Type:        nestedFieldType(name, prop),
+		}
+	}
+
+	return graphql.NewObject(graphql.ObjectConfig{
+		Description: "A nested object",
+		Name:        fmt.Sprintf("%sNestedObj", name),
+		Fields:      fields,
+	})
+}
+
+func nestedFieldType(parentName string, prop *models.NestedProperty) graphql.Output {
+	switch schema.DataType(prop.DataType[0]) {
+	case schema.DataTypeString, schema.DataTypeText:
+		return graphql.String
+	case schema.DataTypeInt:
+		return graphql.Int
+	case schema.DataTypeNumber:
+		return graphql.Float
+	case schema.DataTypeBoolean:
+		return graphql.Boolean
+	case schema.DataTypeDate:
+		return graphql.String // String since no graphql date datatype exists
+	case schema.DataTypeObject:
+		return newNestedObject(parentName+prop.Name, prop.NestedProperties)
+	case schema.DataTypeObjectArray:
+		return graphql.NewList(newNestedObject(parentName+prop.Name, prop.NestedProperties))
+	default:
+		panic(fmt.Sprintf("buildGetClass: unknown nested type for %s.%s; %s",
+			parentName, prop.Name, prop.DataType))
+	}
+}
+
 func buildGetClassField(classObject *graphql.Object,
 	class *models.Class, modulesProvider ModulesProvider) graphql.Field {
 	field := graphql.Field{
@@ -178,6 +264,10 @@ func buildGetClassField(classObject *graphql.Object,
 			"nearObject": nearObjectArgument(class.Class),
 			"where":      whereArgument(class.Class),
 			"group":      groupArgument(class.Class),
+			"tenant": &graphql.ArgumentConfig{
+				Description: descriptions.Tenant,
+				Type:        graphql.String,
+			},
 		},
 		Resolve: newResolver(modulesProvider).makeResolveGetClass(class.Class),
 	}
@@ -230,6 +320,29 @@ func resolvePhoneNumber(p graphql.ResolveParams) (interface{}, error) {
 	}, nil
 }
 
+// resolveNestedObject makes sure the value of an object prop is a map. When
+// read from disk, nested objects with fields named like the ones of a
+// geoCoordinates or phoneNumber prop are parsed as such.
+func resolveNestedObject(p graphql.ResolveParams) (interface{}, error) {
+	field := p.Source.(map[string]interface{})[p.Info.FieldName]
+	switch field.(type) {
+	case nil, map[string]interface{}:
+		return field, nil
+	default:
+		asJSON, err := json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("marshal nested object: %v", err)
+		}
+
+		var out map[string]interface{}
+		if err := json.Unmarshal(asJSON, &out); err != nil {
+			return nil, fmt.Errorf("expected a nested object, but got: %T", field)
+		}
+
+		return out, nil
+	}
+}
+
 func whereArgument(className string) *graphql.ArgumentConfig {
 	return &graphql.ArgumentConfig{
 		Description: descriptions.GetWhere,
@@ -280,7 +393,9 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			return nil, err
 		}
 
-		filters, err := common_filters.ExtractFilters(p.Args, p.Info.FieldName)
+		// the field name differs from the class name if the class is queried
+		// through one of its aliases
+		filters, err := common_filters.ExtractFilters(p.Args, className)
 		if err != nil {
 			return nil, fmt.Errorf("could not extract filters: %s", err)
 		}
@@ -307,6 +422,11 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 
 		group := extractGroup(p.Args)
 
+		var tenant string
+		if t, ok := p.Args["tenant"]; ok {
+			tenant = t.(string) // guaranteed by graphql
+		}
+
 		params := traverser.GetParams{
 			Filters:              filters,
 			ClassName:            className,
@@ -317,6 +437,7 @@ func (r *resolver) makeResolveGetClass(className string) graphql.FieldResolveFn
 			Group:                group,
 			ModuleParams:         moduleParams,
 			AdditionalProperties: additional,
+			Tenant:               tenant,
 		}
 
 		return func() (interface{}, error) {
@@ -349,18 +470,25 @@ func principalFromContext(ctx context.Context) *models.Principal {
 	return principal.(*models.Principal)
 }
 
-func isPrimitive(selectionSet *ast.SelectionSet) bool {
+func isPrimitive(selectionSet *ast.SelectionSet, additionalCheck *additionalCheck) bool {
 	if selectionSet == nil {
 		return true
 	}
 
-	// if there is a selection set it could either be a cross-ref or a map-type
-	// field like GeoCoordinates or PhoneNumber
+	// if there is a selection set it could either be a cross-ref, a map-type
+	// field like GeoCoordinates or PhoneNumber or a nested object
 	for _, subSelection := range selectionSet.Selections {
 		if subsectionField, ok := subSelection.(*ast.Field); ok {
 			if fieldNameIsOfObjectButNonReferenceType(subsectionField.Name.Value) {
 				return true
 			}
+
+			// besides fragments, cross-refs can only select __typename and
+			// additional props, any other field must be part of a nested object
+			if subsectionField.Name.Value != "__typename" &&
+				!additionalCheck.isAdditional(subsectionField.Name.Value) {
+				return true
+			}
 		}
 	}
 
@@ -422,7 +550,7 @@ func extractProperties(selections *ast.SelectionSet,
 		name := field.Name.Value
 		property := traverser.SelectProperty{Name: name}
 
-		property.IsPrimitive = isPrimitive(field.SelectionSet)
+		property.IsPrimitive = isPrimitive(field.SelectionSet, additionalCheck)
 		if !property.IsPrimitive {
 			// We can interpret this property in different ways
 			for _, subSelection := range field.SelectionSet.Selections {
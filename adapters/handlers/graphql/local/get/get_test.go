@@ -22,6 +22,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/additional"
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -41,6 +42,31 @@ func TestSimpleFieldParamsOK(t *testing.T) {
 	resolver.AssertResolve(t, "{ Get { SomeAction { intField } } }")
 }
 
+func TestGetThroughAlias(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := traverser.GetParams{
+		ClassName:  "SomeAction",
+		Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+		Filters: &filters.LocalFilter{Root: &filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       &filters.Path{Class: "SomeAction", Property: "intField"},
+			Value:    &filters.Value{Value: 7, Type: schema.DataTypeInt},
+		}},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return([]interface{}{map[string]interface{}{"intField": 7}}, nil).Once()
+
+	query := `{ Get { SomeAlias(where: {path: ["intField"], operator: Equal, valueInt: 7}) { intField } } }`
+	result := resolver.AssertResolve(t, query)
+
+	assert.Equal(t, []interface{}{map[string]interface{}{"intField": 7}},
+		result.Get("Get", "SomeAlias").Result)
+}
+
 func TestExtractIntField(t *testing.T) {
 	t.Parallel()
 
@@ -90,6 +116,90 @@ func TestExtractGeoCoordinatesField(t *testing.T) {
 	assert.Equal(t, expectedLocation, result.Get("Get", "SomeAction").Result.([]interface{})[0])
 }
 
+func TestExtractNestedObjectFields(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := traverser.GetParams{
+		ClassName: "SomeAction",
+		Properties: []traverser.SelectProperty{
+			{Name: "address", IsPrimitive: true},
+			{Name: "previousAddresses", IsPrimitive: true},
+		},
+	}
+
+	resolverReturn := []interface{}{
+		map[string]interface{}{
+			"address": map[string]interface{}{
+				"city": "Amsterdam",
+				"location": map[string]interface{}{
+					"floor": float64(3),
+				},
+			},
+			"previousAddresses": []interface{}{
+				map[string]interface{}{"city": "Berlin"},
+				map[string]interface{}{"city": "Paris"},
+			},
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(resolverReturn, nil).Once()
+
+	query := "{ Get { SomeAction { address { city location { floor } } previousAddresses { city } } } }"
+	result := resolver.AssertResolve(t, query)
+
+	expected := map[string]interface{}{
+		"address": map[string]interface{}{
+			"city": "Amsterdam",
+			"location": map[string]interface{}{
+				"floor": 3,
+			},
+		},
+		"previousAddresses": []interface{}{
+			map[string]interface{}{"city": "Berlin"},
+			map[string]interface{}{"city": "Paris"},
+		},
+	}
+
+	assert.Equal(t, expected, result.Get("Get", "SomeAction").Result.([]interface{})[0])
+}
+
+func TestExtractArrayFields(t *testing.T) {
+	t.Parallel()
+
+	resolver := newMockResolver()
+
+	expectedParams := traverser.GetParams{
+		ClassName: "SomeAction",
+		Properties: []traverser.SelectProperty{
+			{Name: "tags", IsPrimitive: true},
+			{Name: "scores", IsPrimitive: true},
+		},
+	}
+
+	resolverReturn := []interface{}{
+		map[string]interface{}{
+			"tags":   []interface{}{"green", "blue"},
+			"scores": []interface{}{float64(1), float64(2)},
+		},
+	}
+
+	resolver.On("GetClass", expectedParams).
+		Return(resolverReturn, nil).Once()
+
+	query := "{ Get { SomeAction { tags scores } } }"
+	result := resolver.AssertResolve(t, query)
+
+	expected := map[string]interface{}{
+		"tags":   []interface{}{"green", "blue"},
+		"scores": []interface{}{1, 2},
+	}
+
+	assert.Equal(t, expected, result.Get("Get", "SomeAction").Result.([]interface{})[0])
+}
+
 func TestExtractPhoneNumberField(t *testing.T) {
 	// We need to explicitly test all cases of asking for just one sub-property
 	// at a time, because the AST-parsing uses known fields of known props to
@@ -808,6 +918,26 @@ func TestNearVectorRanker(t *testing.T) {
 
 		resolver.AssertResolve(t, query)
 	})
+
+	t.Run("for things with a target vector set", func(t *testing.T) {
+		query := `{ Get { SomeThing(nearVector: {
+							  vector: [0.123, 0.984]
+								targetVector: "title_vector"
+        			}) { intField } } }`
+
+		expectedParams := traverser.GetParams{
+			ClassName:  "SomeThing",
+			Properties: []traverser.SelectProperty{{Name: "intField", IsPrimitive: true}},
+			NearVector: &traverser.NearVectorParams{
+				Vector:       []float32{0.123, 0.984},
+				TargetVector: "title_vector",
+			},
+		}
+		resolver.On("GetClass", expectedParams).
+			Return([]interface{}{}, nil).Once()
+
+		resolver.AssertResolve(t, query)
+	})
 }
 
 func TestExtractPagination(t *testing.T) {
@@ -22,6 +22,12 @@ var SimpleSchema = CreateSimpleSchema(config.VectorizerModuleText2VecContextiona
 func CreateSimpleSchema(vectorizer string) schema.Schema {
 	return schema.Schema{
 		Objects: &models.Schema{
+			Aliases: []*models.Alias{
+				&models.Alias{
+					Alias: "SomeAlias",
+					Class: "SomeAction",
+				},
+			},
 			Classes: []*models.Class{
 				&models.Class{
 					Class:      "SomeThing",
@@ -59,6 +65,44 @@ func CreateSimpleSchema(vectorizer string) schema.Schema {
 							Name:     "phone",
 							DataType: []string{"phoneNumber"},
 						},
+						&models.Property{
+							Name:     "address",
+							DataType: []string{"object"},
+							NestedProperties: []*models.NestedProperty{
+								&models.NestedProperty{
+									Name:     "city",
+									DataType: []string{"string"},
+								},
+								&models.NestedProperty{
+									Name:     "location",
+									DataType: []string{"object"},
+									NestedProperties: []*models.NestedProperty{
+										&models.NestedProperty{
+											Name:     "floor",
+											DataType: []string{"int"},
+										},
+									},
+								},
+							},
+						},
+						&models.Property{
+							Name:     "previousAddresses",
+							DataType: []string{"object[]"},
+							NestedProperties: []*models.NestedProperty{
+								&models.NestedProperty{
+									Name:     "city",
+									DataType: []string{"string"},
+								},
+							},
+						},
+						&models.Property{
+							Name:     "tags",
+							DataType: []string{"string[]"},
+						},
+						&models.Property{
+							Name:     "scores",
+							DataType: []string{"int[]"},
+						},
 						&models.Property{
 							Name:     "hasAction",
 							DataType: []string{"SomeAction"},
@@ -77,6 +121,12 @@ func CreateSimpleSchema(vectorizer string) schema.Schema {
 // CarSchema contains a car which has every primtive field and a ref field there is
 var CarSchema = schema.Schema{
 	Objects: &models.Schema{
+		Aliases: []*models.Alias{
+			&models.Alias{
+				Alias: "Vehicle",
+				Class: "Car",
+			},
+		},
 		Classes: []*models.Class{
 			&models.Class{
 				Class: "Manufacturer",
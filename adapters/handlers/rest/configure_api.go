@@ -31,13 +31,15 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
 	modulestorage "github.com/semi-technologies/weaviate/adapters/repos/modules"
 	schemarepo "github.com/semi-technologies/weaviate/adapters/repos/schema"
-	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/moduletools"
 	"github.com/semi-technologies/weaviate/entities/search"
 	modimage "github.com/semi-technologies/weaviate/modules/img2vec-neural"
 	modqna "github.com/semi-technologies/weaviate/modules/qna-transformers"
+	modsum "github.com/semi-technologies/weaviate/modules/sum-transformers"
+	modspellcheck "github.com/semi-technologies/weaviate/modules/text-spellcheck"
 	modcontextionary "github.com/semi-technologies/weaviate/modules/text2vec-contextionary"
 	modtransformers "github.com/semi-technologies/weaviate/modules/text2vec-transformers"
+	"github.com/semi-technologies/weaviate/usecases/auth/authentication/composer"
 	"github.com/semi-technologies/weaviate/usecases/classification"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/modules"
@@ -71,6 +73,7 @@ type vectorRepo interface {
 type explorer interface {
 	GetClass(ctx context.Context, params traverser.GetParams) ([]interface{}, error)
 	Concepts(ctx context.Context, params traverser.ExploreParams) ([]search.Result, error)
+	AggregateSearchVector(ctx context.Context, params traverser.AggregateParams) ([]float32, error)
 }
 
 func configureAPI(api *operations.WeaviateAPI) http.Handler {
@@ -102,9 +105,10 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 	api.JSONConsumer = runtime.JSONConsumer()
 
-	api.OidcAuth = func(token string, scopes []string) (*models.Principal, error) {
-		return appState.OIDC.ValidateAndExtract(token, scopes)
-	}
+	// api keys are presented as bearer tokens just like oidc tokens, so both
+	// are validated through the oidc security definition
+	api.OidcAuth = composer.New(appState.ServerConfig.Config.Authentication,
+		appState.APIKey, appState.OIDC)
 
 	api.Logger = func(msg string, args ...interface{}) {
 		appState.Logger.WithField("action", "restapi_management").Infof(msg, args...)
@@ -144,7 +148,7 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 	}
 
 	schemaManager, err := schemaUC.NewManager(migrator, schemaRepo,
-		appState.Logger, appState.Authorizer, appState.ServerConfig.Config,
+		appState.Logger, appState.Authorizer, appState.Auditor, appState.ServerConfig.Config,
 		hnsw.ParseUserConfig, appState.Modules, appState.Modules)
 	if err != nil {
 		appState.Logger.
@@ -168,10 +172,10 @@ func configureAPI(api *operations.WeaviateAPI) http.Handler {
 
 	kindsManager := objects.NewManager(appState.Locks,
 		schemaManager, appState.ServerConfig, appState.Logger,
-		appState.Authorizer, appState.Modules, vectorRepo, appState.Modules)
+		appState.Authorizer, appState.Auditor, appState.Modules, vectorRepo, appState.Modules)
 	batchKindsManager := objects.NewBatchManager(vectorRepo, appState.Modules,
 		appState.Locks, schemaManager, appState.ServerConfig, appState.Logger,
-		appState.Authorizer)
+		appState.Authorizer, appState.Auditor)
 
 	kindsTraverser := traverser.NewTraverser(appState.ServerConfig, appState.Locks,
 		appState.Logger, appState.Authorizer, vectorRepo, explorer, schemaManager)
@@ -256,11 +260,13 @@ func startupRoutine(ctx context.Context) *state.State {
 		Debug("config loaded")
 
 	appState.OIDC = configureOIDC(appState)
+	appState.APIKey = configureAPIKey(appState)
 	appState.AnonymousAccess = configureAnonymousAccess(appState)
 	appState.Authorizer = configureAuthorizer(appState)
+	appState.Auditor = configureAuditor(appState)
 
 	logger.WithField("action", "startup").WithField("startup_time_left", timeTillDeadline(ctx)).
-		Debug("configured OIDC and anonymous access client")
+		Debug("configured OIDC, api key and anonymous access client")
 
 	appState.Locks = &dummyLock{}
 
@@ -306,6 +312,9 @@ func (d *dummyLock) LockSchema() (func() error, error) {
 // everything hard-coded right now, to be made dynmaic (from go plugins later)
 func registerModules(appState *state.State) error {
 	appState.Modules = modules.NewProvider()
+	if maxSize := appState.ServerConfig.Config.VectorizerCache.MaxSize; maxSize > 0 {
+		appState.Modules.SetVectorizerCache(modules.NewVectorizerCache(maxSize))
+	}
 
 	enabledModules := map[string]bool{}
 	if len(appState.ServerConfig.Config.EnableModules) > 0 {
@@ -327,6 +336,14 @@ func registerModules(appState *state.State) error {
 		appState.Modules.Register(modqna.New())
 	}
 
+	if _, ok := enabledModules["sum-transformers"]; ok {
+		appState.Modules.Register(modsum.New())
+	}
+
+	if _, ok := enabledModules["text-spellcheck"]; ok {
+		appState.Modules.Register(modspellcheck.New())
+	}
+
 	if _, ok := enabledModules["img2vec-neural"]; ok {
 		appState.Modules.Register(modimage.New())
 	}
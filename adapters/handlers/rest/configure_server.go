@@ -21,7 +21,9 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/state"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/audit"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/anonymous"
+	"github.com/semi-technologies/weaviate/usecases/auth/authentication/apikey"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/oidc"
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
 	"github.com/semi-technologies/weaviate/usecases/config"
@@ -83,6 +85,18 @@ func configureOIDC(appState *state.State) *oidc.Client {
 	return c
 }
 
+// configureAPIKey will always be called, even if api key authentication is
+// disabled. In this case the client will deny every key.
+func configureAPIKey(appState *state.State) *apikey.Client {
+	c, err := apikey.New(appState.ServerConfig.Config)
+	if err != nil {
+		appState.Logger.WithField("action", "apikey_init").WithError(err).Fatal("apikey client could not start up")
+		os.Exit(1)
+	}
+
+	return c
+}
+
 // configureAnonymousAccess will always be called, even if anonymous access is
 // disabled. In this case the middleware provided by this client will block
 // anonymous requests
@@ -94,6 +108,18 @@ func configureAuthorizer(appState *state.State) authorization.Authorizer {
 	return authorization.New(appState.ServerConfig.Config)
 }
 
+// configureAuditor will always be called, even if the audit log is disabled.
+// In this case the auditor doesn't record anything
+func configureAuditor(appState *state.State) *audit.Logger {
+	a, err := audit.New(appState.ServerConfig.Config.Audit, appState.Logger)
+	if err != nil {
+		appState.Logger.WithField("action", "audit_init").WithError(err).Fatal("audit log could not start up")
+		os.Exit(1)
+	}
+
+	return a
+}
+
 func timeTillDeadline(ctx context.Context) string {
 	dl, _ := ctx.Deadline()
 	return time.Until(dl).String()
@@ -133,6 +133,12 @@ func parseOperator(in string) (filters.Operator, error) {
 		return filters.OperatorNotEqual, nil
 	case models.WhereFilterOperatorWithinGeoRange:
 		return filters.OperatorWithinGeoRange, nil
+	case models.WhereFilterOperatorContainsAny:
+		return filters.OperatorContainsAny, nil
+	case models.WhereFilterOperatorContainsAll:
+		return filters.OperatorContainsAll, nil
+	case models.WhereFilterOperatorIsNull:
+		return filters.OperatorIsNull, nil
 	case models.WhereFilterOperatorAnd:
 		return filters.OperatorAnd, nil
 	case models.WhereFilterOperatorOr:
@@ -165,5 +171,11 @@ func allValuesNil(in *models.WhereFilter) bool {
 		in.ValueText == nil &&
 		in.ValueInt == nil &&
 		in.ValueNumber == nil &&
-		in.ValueGeoRange == nil
+		in.ValueGeoRange == nil &&
+		in.ValueBooleanArray == nil &&
+		in.ValueDateArray == nil &&
+		in.ValueStringArray == nil &&
+		in.ValueTextArray == nil &&
+		in.ValueIntArray == nil &&
+		in.ValueNumberArray == nil
 }
@@ -175,6 +175,63 @@ func Test_ExtractFlatFilters(t *testing.T) {
 					},
 				}},
 			},
+			test{
+				name: "valid string array filter",
+				input: &models.WhereFilter{
+					Operator:         "ContainsAny",
+					ValueStringArray: []string{"foo", "bar"},
+					Path:             []string{"stringField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAny,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("stringField"),
+					},
+					Value: &filters.Value{
+						Value: []interface{}{"foo", "bar"},
+						Type:  schema.DataTypeString,
+					},
+				}},
+			},
+			test{
+				name: "valid int array filter",
+				input: &models.WhereFilter{
+					Operator:      "ContainsAll",
+					ValueIntArray: []int64{1, 2},
+					Path:          []string{"intField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorContainsAll,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("intField"),
+					},
+					Value: &filters.Value{
+						Value: []interface{}{1, 2},
+						Type:  schema.DataTypeInt,
+					},
+				}},
+			},
+			test{
+				name: "valid is null filter",
+				input: &models.WhereFilter{
+					Operator:     "IsNull",
+					ValueBoolean: ptBool(true),
+					Path:         []string{"stringField"},
+				},
+				expectedFilter: &filters.LocalFilter{Root: &filters.Clause{
+					Operator: filters.OperatorIsNull,
+					On: &filters.Path{
+						Class:    schema.AssertValidClassName("Todo"),
+						Property: schema.AssertValidPropertyName("stringField"),
+					},
+					Value: &filters.Value{
+						Value: true,
+						Type:  schema.DataTypeBoolean,
+					},
+				}},
+			},
 		}
 
 		for _, test := range tests {
@@ -272,6 +329,16 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				expectedErr: fmt.Errorf("invalid where filter: " +
 					"got operator 'Equal', but no value<Type> field set"),
 			},
+			test{
+				name: "date array with invalid date",
+				input: &models.WhereFilter{
+					Operator:       "ContainsAny",
+					ValueDateArray: []string{"2021-01-01T00:00:00Z", "yesterday"},
+					Path:           []string{"dateField"},
+				},
+				expectedErr: fmt.Errorf("invalid where filter: valueDateArray: " +
+					"element 1: failed to parse the value 'yesterday' as a date"),
+			},
 			test{
 				name: "equal operator and no path set",
 				input: &models.WhereFilter{
@@ -330,6 +397,16 @@ func Test_ExtractFlatFilters(t *testing.T) {
 				input:          inputIntFilterWithOp("LessThanEqual"),
 				expectedFilter: intFilterWithOp(filters.OperatorLessThanEqual),
 			},
+			test{
+				name:           "contains any",
+				input:          inputIntFilterWithOp("ContainsAny"),
+				expectedFilter: intFilterWithOp(filters.OperatorContainsAny),
+			},
+			test{
+				name:           "contains all",
+				input:          inputIntFilterWithOp("ContainsAll"),
+				expectedFilter: intFilterWithOp(filters.OperatorContainsAll),
+			},
 		}
 
 		for _, test := range tests {
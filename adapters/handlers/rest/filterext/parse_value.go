@@ -14,6 +14,7 @@ package filterext
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -124,6 +125,88 @@ var valueExtractors = []valueExtractorFunc{
 			},
 		}, schema.DataTypeGeoCoordinates), nil
 	},
+	// int array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueIntArray == nil {
+			return nil, nil
+		}
+
+		values := make([]interface{}, len(in.ValueIntArray))
+		for i, value := range in.ValueIntArray {
+			values[i] = int(value)
+		}
+
+		return valueFilter(values, schema.DataTypeInt), nil
+	},
+	// number array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueNumberArray == nil {
+			return nil, nil
+		}
+
+		values := make([]interface{}, len(in.ValueNumberArray))
+		for i, value := range in.ValueNumberArray {
+			values[i] = value
+		}
+
+		return valueFilter(values, schema.DataTypeNumber), nil
+	},
+	// string array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueStringArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(stringsToInterfaces(in.ValueStringArray), schema.DataTypeString), nil
+	},
+	// text array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueTextArray == nil {
+			return nil, nil
+		}
+
+		return valueFilter(stringsToInterfaces(in.ValueTextArray), schema.DataTypeText), nil
+	},
+	// date array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueDateArray == nil {
+			return nil, nil
+		}
+
+		values := make([]interface{}, len(in.ValueDateArray))
+		for i, value := range in.ValueDateArray {
+			date, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return nil, fmt.Errorf("valueDateArray: element %d: "+
+					"failed to parse the value '%s' as a date", i, value)
+			}
+			values[i] = date
+		}
+
+		return valueFilter(values, schema.DataTypeDate), nil
+	},
+	// boolean array
+	func(in *models.WhereFilter) (*filters.Value, error) {
+		if in.ValueBooleanArray == nil {
+			return nil, nil
+		}
+
+		values := make([]interface{}, len(in.ValueBooleanArray))
+		for i, value := range in.ValueBooleanArray {
+			values[i] = value
+		}
+
+		return valueFilter(values, schema.DataTypeBoolean), nil
+	},
+}
+
+func stringsToInterfaces(in []string) []interface{} {
+	out := make([]interface{}, len(in))
+	for i, value := range in {
+		out[i] = value
+	}
+
+	return out
 }
 
 func valueFilter(value interface{}, dt schema.DataType) *filters.Value {
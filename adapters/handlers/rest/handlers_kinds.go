@@ -18,6 +18,7 @@ import (
 
 	middleware "github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/objects"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -44,14 +45,14 @@ type ModulesProvider interface {
 type kindsManager interface {
 	AddObject(context.Context, *models.Principal, *models.Object) (*models.Object, error)
 	ValidateObject(context.Context, *models.Principal, *models.Object) error
-	GetObject(context.Context, *models.Principal, strfmt.UUID, traverser.AdditionalProperties) (*models.Object, error)
-	GetObjects(context.Context, *models.Principal, *int64, traverser.AdditionalProperties) ([]*models.Object, error)
+	GetObject(context.Context, *models.Principal, strfmt.UUID, traverser.AdditionalProperties, string) (*models.Object, error)
+	GetObjects(context.Context, *models.Principal, *int64, traverser.AdditionalProperties, string) ([]*models.Object, error)
 	UpdateObject(context.Context, *models.Principal, strfmt.UUID, *models.Object) (*models.Object, error)
 	MergeObject(context.Context, *models.Principal, strfmt.UUID, *models.Object) error
-	DeleteObject(context.Context, *models.Principal, strfmt.UUID) error
-	AddObjectReference(context.Context, *models.Principal, strfmt.UUID, string, *models.SingleRef) error
-	UpdateObjectReferences(context.Context, *models.Principal, strfmt.UUID, string, models.MultipleRef) error
-	DeleteObjectReference(context.Context, *models.Principal, strfmt.UUID, string, *models.SingleRef) error
+	DeleteObject(context.Context, *models.Principal, strfmt.UUID, string) error
+	AddObjectReference(context.Context, *models.Principal, strfmt.UUID, string, *models.SingleRef, string) error
+	UpdateObjectReferences(context.Context, *models.Principal, strfmt.UUID, string, models.MultipleRef, string) error
+	DeleteObjectReference(context.Context, *models.Principal, strfmt.UUID, string, *models.SingleRef, string) error
 }
 
 func (h *kindHandlers) addObject(params objects.ObjectsCreateParams,
@@ -107,7 +108,8 @@ func (h *kindHandlers) getObject(params objects.ObjectsGetParams,
 			WithPayload(errPayloadFromSingleErr(err))
 	}
 
-	object, err := h.manager.GetObject(params.HTTPRequest.Context(), principal, params.ID, additional)
+	object, err := h.manager.GetObject(params.HTTPRequest.Context(), principal, params.ID, additional,
+		swag.StringValue(params.Tenant))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -139,7 +141,8 @@ func (h *kindHandlers) getObjects(params objects.ObjectsListParams,
 
 	var deprecationsRes []*models.Deprecation
 
-	list, err := h.manager.GetObjects(params.HTTPRequest.Context(), principal, params.Limit, additional)
+	list, err := h.manager.GetObjects(params.HTTPRequest.Context(), principal, params.Limit, additional,
+		swag.StringValue(params.Tenant))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -193,7 +196,8 @@ func (h *kindHandlers) updateObject(params objects.ObjectsUpdateParams,
 
 func (h *kindHandlers) deleteObject(params objects.ObjectsDeleteParams,
 	principal *models.Principal) middleware.Responder {
-	err := h.manager.DeleteObject(params.HTTPRequest.Context(), principal, params.ID)
+	err := h.manager.DeleteObject(params.HTTPRequest.Context(), principal, params.ID,
+		swag.StringValue(params.Tenant))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -231,7 +235,8 @@ func (h *kindHandlers) patchObject(params objects.ObjectsPatchParams, principal
 
 func (h *kindHandlers) addObjectReference(params objects.ObjectsReferencesCreateParams,
 	principal *models.Principal) middleware.Responder {
-	err := h.manager.AddObjectReference(params.HTTPRequest.Context(), principal, params.ID, params.PropertyName, params.Body)
+	err := h.manager.AddObjectReference(params.HTTPRequest.Context(), principal, params.ID, params.PropertyName, params.Body,
+		swag.StringValue(params.Tenant))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -251,7 +256,8 @@ func (h *kindHandlers) addObjectReference(params objects.ObjectsReferencesCreate
 
 func (h *kindHandlers) updateObjectReferences(params objects.ObjectsReferencesUpdateParams,
 	principal *models.Principal) middleware.Responder {
-	err := h.manager.UpdateObjectReferences(params.HTTPRequest.Context(), principal, params.ID, params.PropertyName, params.Body)
+	err := h.manager.UpdateObjectReferences(params.HTTPRequest.Context(), principal, params.ID, params.PropertyName, params.Body,
+		swag.StringValue(params.Tenant))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
@@ -271,7 +277,8 @@ func (h *kindHandlers) updateObjectReferences(params objects.ObjectsReferencesUp
 
 func (h *kindHandlers) deleteObjectReference(params objects.ObjectsReferencesDeleteParams,
 	principal *models.Principal) middleware.Responder {
-	err := h.manager.DeleteObjectReference(params.HTTPRequest.Context(), principal, params.ID, params.PropertyName, params.Body)
+	err := h.manager.DeleteObjectReference(params.HTTPRequest.Context(), principal, params.ID, params.PropertyName, params.Body,
+		swag.StringValue(params.Tenant))
 	if err != nil {
 		switch err.(type) {
 		case errors.Forbidden:
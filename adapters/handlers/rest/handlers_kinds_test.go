@@ -666,11 +666,11 @@ func (f *fakeManager) ValidateObject(_ context.Context, _ *models.Principal, _ *
 	panic("not implemented") // TODO: Implement
 }
 
-func (f *fakeManager) GetObject(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ traverser.AdditionalProperties) (*models.Object, error) {
+func (f *fakeManager) GetObject(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ traverser.AdditionalProperties, _ string) (*models.Object, error) {
 	return f.getObjectReturn, nil
 }
 
-func (f *fakeManager) GetObjects(_ context.Context, _ *models.Principal, _ *int64, _ traverser.AdditionalProperties) ([]*models.Object, error) {
+func (f *fakeManager) GetObjects(_ context.Context, _ *models.Principal, _ *int64, _ traverser.AdditionalProperties, _ string) ([]*models.Object, error) {
 	return f.getObjectsReturn, nil
 }
 
@@ -682,18 +682,18 @@ func (f *fakeManager) MergeObject(_ context.Context, _ *models.Principal, _ strf
 	panic("not implemented") // TODO: Implement
 }
 
-func (f *fakeManager) DeleteObject(_ context.Context, _ *models.Principal, _ strfmt.UUID) error {
+func (f *fakeManager) DeleteObject(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ string) error {
 	panic("not implemented") // TODO: Implement
 }
 
-func (f *fakeManager) AddObjectReference(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ string, _ *models.SingleRef) error {
+func (f *fakeManager) AddObjectReference(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ string, _ *models.SingleRef, _ string) error {
 	panic("not implemented") // TODO: Implement
 }
 
-func (f *fakeManager) UpdateObjectReferences(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ string, _ models.MultipleRef) error {
+func (f *fakeManager) UpdateObjectReferences(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ string, _ models.MultipleRef, _ string) error {
 	panic("not implemented") // TODO: Implement
 }
 
-func (f *fakeManager) DeleteObjectReference(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ string, _ *models.SingleRef) error {
+func (f *fakeManager) DeleteObjectReference(_ context.Context, _ *models.Principal, _ strfmt.UUID, _ string, _ *models.SingleRef, _ string) error {
 	panic("not implemented") // TODO: Implement
 }
@@ -13,6 +13,7 @@ package rest
 
 import (
 	middleware "github.com/go-openapi/runtime/middleware"
+	pkgerrors "github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations"
 	"github.com/semi-technologies/weaviate/adapters/handlers/rest/operations/schema"
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
@@ -117,6 +118,91 @@ func (s *schemaHandlers) addClassProperty(params schema.SchemaObjectsPropertiesA
 	return schema.NewSchemaObjectsPropertiesAddOK().WithPayload(params.Body)
 }
 
+func (s *schemaHandlers) updateClassProperty(params schema.SchemaObjectsPropertiesUpdateParams,
+	principal *models.Principal) middleware.Responder {
+	if params.Body.Name == "" {
+		// no rename intended, only the data type is changed
+		params.Body.Name = params.PropertyName
+	}
+
+	err := s.manager.UpdateClassProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsPropertiesUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsPropertiesUpdateOK().WithPayload(params.Body)
+}
+
+func (s *schemaHandlers) deleteClassProperty(params schema.SchemaObjectsPropertiesDeleteParams,
+	principal *models.Principal) middleware.Responder {
+	err := s.manager.DeleteClassProperty(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.PropertyName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsPropertiesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsPropertiesDeleteBadRequest().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsPropertiesDeleteOK()
+}
+
+func (s *schemaHandlers) reindex(params schema.SchemaObjectsReindexParams,
+	principal *models.Principal) middleware.Responder {
+	job, err := s.manager.Reindex(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaObjectsReindexNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsReindexForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsReindexUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsReindexCreated().WithPayload(job)
+}
+
+func (s *schemaHandlers) getReindexJob(params schema.SchemaObjectsReindexGetParams,
+	principal *models.Principal) middleware.Responder {
+	job, err := s.manager.GetReindexJob(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.ID)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaObjectsReindexGetNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaObjectsReindexGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaObjectsReindexGetInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaObjectsReindexGetOK().WithPayload(job)
+}
+
 func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 	dbSchema, err := s.manager.GetSchema(principal)
 	if err != nil {
@@ -134,6 +220,182 @@ func (s *schemaHandlers) getSchema(params schema.SchemaDumpParams, principal *mo
 	return schema.NewSchemaDumpOK().WithPayload(payload)
 }
 
+func (s *schemaHandlers) createAlias(params schema.SchemaAliasesCreateParams,
+	principal *models.Principal) middleware.Responder {
+	alias, err := s.manager.CreateAlias(params.HTTPRequest.Context(), principal,
+		params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaAliasesCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaAliasesCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaAliasesCreateOK().WithPayload(alias)
+}
+
+func (s *schemaHandlers) updateAlias(params schema.SchemaAliasesUpdateParams,
+	principal *models.Principal) middleware.Responder {
+	alias, err := s.manager.UpdateAlias(params.HTTPRequest.Context(), principal,
+		params.AliasName, params.Body)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaAliasesUpdateNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaAliasesUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaAliasesUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaAliasesUpdateOK().WithPayload(alias)
+}
+
+func (s *schemaHandlers) deleteAlias(params schema.SchemaAliasesDeleteParams,
+	principal *models.Principal) middleware.Responder {
+	err := s.manager.DeleteAlias(params.HTTPRequest.Context(), principal,
+		params.AliasName)
+	if err != nil {
+		if err == schemaUC.ErrNotFound {
+			return schema.NewSchemaAliasesDeleteNotFound()
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaAliasesDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaAliasesDeleteInternalServerError().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaAliasesDeleteOK()
+}
+
+func (s *schemaHandlers) diffSchema(params schema.SchemaDiffParams,
+	principal *models.Principal) middleware.Responder {
+	diff, err := s.manager.DiffSchema(params.HTTPRequest.Context(), principal,
+		params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaDiffForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaDiffUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaDiffOK().WithPayload(diff)
+}
+
+func (s *schemaHandlers) applySchema(params schema.SchemaApplyParams,
+	principal *models.Principal) middleware.Responder {
+	dryRun := params.DryRun != nil && *params.DryRun
+	diff, err := s.manager.ApplySchema(params.HTTPRequest.Context(), principal,
+		params.Body, dryRun, params.Version)
+	if err != nil {
+		if pkgerrors.Cause(err) == schemaUC.ErrSchemaVersionConflict {
+			return schema.NewSchemaApplyConflict().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewSchemaApplyForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewSchemaApplyUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewSchemaApplyOK().WithPayload(diff)
+}
+
+func (s *schemaHandlers) addTenants(params schema.TenantsCreateParams,
+	principal *models.Principal) middleware.Responder {
+	tenants, err := s.manager.AddTenants(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewTenantsCreateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsCreateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsCreateOK().WithPayload(tenants)
+}
+
+func (s *schemaHandlers) getTenants(params schema.TenantsGetParams,
+	principal *models.Principal) middleware.Responder {
+	tenants, err := s.manager.GetTenants(params.HTTPRequest.Context(), principal,
+		params.ClassName)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewTenantsGetForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsGetUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsGetOK().WithPayload(tenants)
+}
+
+func (s *schemaHandlers) updateTenants(params schema.TenantsUpdateParams,
+	principal *models.Principal) middleware.Responder {
+	tenants, err := s.manager.UpdateTenants(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Body)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewTenantsUpdateForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsUpdateUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsUpdateOK().WithPayload(tenants)
+}
+
+func (s *schemaHandlers) deleteTenants(params schema.TenantsDeleteParams,
+	principal *models.Principal) middleware.Responder {
+	err := s.manager.DeleteTenants(params.HTTPRequest.Context(), principal,
+		params.ClassName, params.Tenants)
+	if err != nil {
+		switch err.(type) {
+		case errors.Forbidden:
+			return schema.NewTenantsDeleteForbidden().
+				WithPayload(errPayloadFromSingleErr(err))
+		default:
+			return schema.NewTenantsDeleteUnprocessableEntity().
+				WithPayload(errPayloadFromSingleErr(err))
+		}
+	}
+
+	return schema.NewTenantsDeleteOK()
+}
+
 func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager) {
 	h := &schemaHandlers{manager}
 
@@ -143,6 +405,14 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager)
 		SchemaObjectsDeleteHandlerFunc(h.deleteClass)
 	api.SchemaSchemaObjectsPropertiesAddHandler = schema.
 		SchemaObjectsPropertiesAddHandlerFunc(h.addClassProperty)
+	api.SchemaSchemaObjectsPropertiesUpdateHandler = schema.
+		SchemaObjectsPropertiesUpdateHandlerFunc(h.updateClassProperty)
+	api.SchemaSchemaObjectsPropertiesDeleteHandler = schema.
+		SchemaObjectsPropertiesDeleteHandlerFunc(h.deleteClassProperty)
+	api.SchemaSchemaObjectsReindexHandler = schema.
+		SchemaObjectsReindexHandlerFunc(h.reindex)
+	api.SchemaSchemaObjectsReindexGetHandler = schema.
+		SchemaObjectsReindexGetHandlerFunc(h.getReindexJob)
 
 	api.SchemaSchemaObjectsUpdateHandler = schema.
 		SchemaObjectsUpdateHandlerFunc(h.updateClass)
@@ -151,4 +421,24 @@ func setupSchemaHandlers(api *operations.WeaviateAPI, manager *schemaUC.Manager)
 		SchemaObjectsGetHandlerFunc(h.getClass)
 	api.SchemaSchemaDumpHandler = schema.
 		SchemaDumpHandlerFunc(h.getSchema)
+	api.SchemaSchemaDiffHandler = schema.
+		SchemaDiffHandlerFunc(h.diffSchema)
+	api.SchemaSchemaApplyHandler = schema.
+		SchemaApplyHandlerFunc(h.applySchema)
+
+	api.SchemaSchemaAliasesCreateHandler = schema.
+		SchemaAliasesCreateHandlerFunc(h.createAlias)
+	api.SchemaSchemaAliasesUpdateHandler = schema.
+		SchemaAliasesUpdateHandlerFunc(h.updateAlias)
+	api.SchemaSchemaAliasesDeleteHandler = schema.
+		SchemaAliasesDeleteHandlerFunc(h.deleteAlias)
+
+	api.SchemaTenantsCreateHandler = schema.
+		TenantsCreateHandlerFunc(h.addTenants)
+	api.SchemaTenantsGetHandler = schema.
+		TenantsGetHandlerFunc(h.getTenants)
+	api.SchemaTenantsUpdateHandler = schema.
+		TenantsUpdateHandlerFunc(h.updateTenants)
+	api.SchemaTenantsDeleteHandler = schema.
+		TenantsDeleteHandlerFunc(h.deleteTenants)
 }
@@ -28,6 +28,7 @@ import (
 type ObjectsListURL struct {
 	Include *string
 	Limit   *int64
+	Tenant  *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -79,6 +80,14 @@ func (o *ObjectsListURL) Build() (*url.URL, error) {
 		qs.Set("limit", limitQ)
 	}
 
+	var tenantQ string
+	if o.Tenant != nil {
+		tenantQ = *o.Tenant
+	}
+	if tenantQ != "" {
+		qs.Set("tenant", tenantQ)
+	}
+
 	_result.RawQuery = qs.Encode()
 
 	return &_result, nil
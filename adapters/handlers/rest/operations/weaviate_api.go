@@ -117,6 +117,21 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		ObjectsObjectsValidateHandler: objects.ObjectsValidateHandlerFunc(func(params objects.ObjectsValidateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation objects.ObjectsValidate has not yet been implemented")
 		}),
+		SchemaSchemaAliasesCreateHandler: schema.SchemaAliasesCreateHandlerFunc(func(params schema.SchemaAliasesCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaAliasesCreate has not yet been implemented")
+		}),
+		SchemaSchemaAliasesDeleteHandler: schema.SchemaAliasesDeleteHandlerFunc(func(params schema.SchemaAliasesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaAliasesDelete has not yet been implemented")
+		}),
+		SchemaSchemaAliasesUpdateHandler: schema.SchemaAliasesUpdateHandlerFunc(func(params schema.SchemaAliasesUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaAliasesUpdate has not yet been implemented")
+		}),
+		SchemaSchemaApplyHandler: schema.SchemaApplyHandlerFunc(func(params schema.SchemaApplyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaApply has not yet been implemented")
+		}),
+		SchemaSchemaDiffHandler: schema.SchemaDiffHandlerFunc(func(params schema.SchemaDiffParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaDiff has not yet been implemented")
+		}),
 		SchemaSchemaDumpHandler: schema.SchemaDumpHandlerFunc(func(params schema.SchemaDumpParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaDump has not yet been implemented")
 		}),
@@ -132,9 +147,33 @@ func NewWeaviateAPI(spec *loads.Document) *WeaviateAPI {
 		SchemaSchemaObjectsPropertiesAddHandler: schema.SchemaObjectsPropertiesAddHandlerFunc(func(params schema.SchemaObjectsPropertiesAddParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesAdd has not yet been implemented")
 		}),
+		SchemaSchemaObjectsPropertiesDeleteHandler: schema.SchemaObjectsPropertiesDeleteHandlerFunc(func(params schema.SchemaObjectsPropertiesDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesDelete has not yet been implemented")
+		}),
+		SchemaSchemaObjectsPropertiesUpdateHandler: schema.SchemaObjectsPropertiesUpdateHandlerFunc(func(params schema.SchemaObjectsPropertiesUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsPropertiesUpdate has not yet been implemented")
+		}),
+		SchemaSchemaObjectsReindexHandler: schema.SchemaObjectsReindexHandlerFunc(func(params schema.SchemaObjectsReindexParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsReindex has not yet been implemented")
+		}),
+		SchemaSchemaObjectsReindexGetHandler: schema.SchemaObjectsReindexGetHandlerFunc(func(params schema.SchemaObjectsReindexGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.SchemaObjectsReindexGet has not yet been implemented")
+		}),
 		SchemaSchemaObjectsUpdateHandler: schema.SchemaObjectsUpdateHandlerFunc(func(params schema.SchemaObjectsUpdateParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation schema.SchemaObjectsUpdate has not yet been implemented")
 		}),
+		SchemaTenantsCreateHandler: schema.TenantsCreateHandlerFunc(func(params schema.TenantsCreateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsCreate has not yet been implemented")
+		}),
+		SchemaTenantsDeleteHandler: schema.TenantsDeleteHandlerFunc(func(params schema.TenantsDeleteParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsDelete has not yet been implemented")
+		}),
+		SchemaTenantsGetHandler: schema.TenantsGetHandlerFunc(func(params schema.TenantsGetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsGet has not yet been implemented")
+		}),
+		SchemaTenantsUpdateHandler: schema.TenantsUpdateHandlerFunc(func(params schema.TenantsUpdateParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation schema.TenantsUpdate has not yet been implemented")
+		}),
 		WeaviateRootHandler: WeaviateRootHandlerFunc(func(params WeaviateRootParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation WeaviateRoot has not yet been implemented")
 		}),
@@ -229,6 +268,16 @@ type WeaviateAPI struct {
 	ObjectsObjectsUpdateHandler objects.ObjectsUpdateHandler
 	// ObjectsObjectsValidateHandler sets the operation handler for the objects validate operation
 	ObjectsObjectsValidateHandler objects.ObjectsValidateHandler
+	// SchemaSchemaAliasesCreateHandler sets the operation handler for the schema aliases create operation
+	SchemaSchemaAliasesCreateHandler schema.SchemaAliasesCreateHandler
+	// SchemaSchemaAliasesDeleteHandler sets the operation handler for the schema aliases delete operation
+	SchemaSchemaAliasesDeleteHandler schema.SchemaAliasesDeleteHandler
+	// SchemaSchemaAliasesUpdateHandler sets the operation handler for the schema aliases update operation
+	SchemaSchemaAliasesUpdateHandler schema.SchemaAliasesUpdateHandler
+	// SchemaSchemaApplyHandler sets the operation handler for the schema apply operation
+	SchemaSchemaApplyHandler schema.SchemaApplyHandler
+	// SchemaSchemaDiffHandler sets the operation handler for the schema diff operation
+	SchemaSchemaDiffHandler schema.SchemaDiffHandler
 	// SchemaSchemaDumpHandler sets the operation handler for the schema dump operation
 	SchemaSchemaDumpHandler schema.SchemaDumpHandler
 	// SchemaSchemaObjectsCreateHandler sets the operation handler for the schema objects create operation
@@ -239,8 +288,24 @@ type WeaviateAPI struct {
 	SchemaSchemaObjectsGetHandler schema.SchemaObjectsGetHandler
 	// SchemaSchemaObjectsPropertiesAddHandler sets the operation handler for the schema objects properties add operation
 	SchemaSchemaObjectsPropertiesAddHandler schema.SchemaObjectsPropertiesAddHandler
+	// SchemaSchemaObjectsPropertiesDeleteHandler sets the operation handler for the schema objects properties delete operation
+	SchemaSchemaObjectsPropertiesDeleteHandler schema.SchemaObjectsPropertiesDeleteHandler
+	// SchemaSchemaObjectsPropertiesUpdateHandler sets the operation handler for the schema objects properties update operation
+	SchemaSchemaObjectsPropertiesUpdateHandler schema.SchemaObjectsPropertiesUpdateHandler
+	// SchemaSchemaObjectsReindexHandler sets the operation handler for the schema objects reindex operation
+	SchemaSchemaObjectsReindexHandler schema.SchemaObjectsReindexHandler
+	// SchemaSchemaObjectsReindexGetHandler sets the operation handler for the schema objects reindex get operation
+	SchemaSchemaObjectsReindexGetHandler schema.SchemaObjectsReindexGetHandler
 	// SchemaSchemaObjectsUpdateHandler sets the operation handler for the schema objects update operation
 	SchemaSchemaObjectsUpdateHandler schema.SchemaObjectsUpdateHandler
+	// SchemaTenantsCreateHandler sets the operation handler for the tenants create operation
+	SchemaTenantsCreateHandler schema.TenantsCreateHandler
+	// SchemaTenantsDeleteHandler sets the operation handler for the tenants delete operation
+	SchemaTenantsDeleteHandler schema.TenantsDeleteHandler
+	// SchemaTenantsGetHandler sets the operation handler for the tenants get operation
+	SchemaTenantsGetHandler schema.TenantsGetHandler
+	// SchemaTenantsUpdateHandler sets the operation handler for the tenants update operation
+	SchemaTenantsUpdateHandler schema.TenantsUpdateHandler
 	// WeaviateRootHandler sets the operation handler for the weaviate root operation
 	WeaviateRootHandler WeaviateRootHandler
 	// WeaviateWellknownLivenessHandler sets the operation handler for the weaviate wellknown liveness operation
@@ -374,6 +439,21 @@ func (o *WeaviateAPI) Validate() error {
 	if o.ObjectsObjectsValidateHandler == nil {
 		unregistered = append(unregistered, "objects.ObjectsValidateHandler")
 	}
+	if o.SchemaSchemaAliasesCreateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaAliasesCreateHandler")
+	}
+	if o.SchemaSchemaAliasesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaAliasesDeleteHandler")
+	}
+	if o.SchemaSchemaAliasesUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaAliasesUpdateHandler")
+	}
+	if o.SchemaSchemaApplyHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaApplyHandler")
+	}
+	if o.SchemaSchemaDiffHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaDiffHandler")
+	}
 	if o.SchemaSchemaDumpHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaDumpHandler")
 	}
@@ -389,9 +469,33 @@ func (o *WeaviateAPI) Validate() error {
 	if o.SchemaSchemaObjectsPropertiesAddHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesAddHandler")
 	}
+	if o.SchemaSchemaObjectsPropertiesDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesDeleteHandler")
+	}
+	if o.SchemaSchemaObjectsPropertiesUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsPropertiesUpdateHandler")
+	}
+	if o.SchemaSchemaObjectsReindexHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsReindexHandler")
+	}
+	if o.SchemaSchemaObjectsReindexGetHandler == nil {
+		unregistered = append(unregistered, "schema.SchemaObjectsReindexGetHandler")
+	}
 	if o.SchemaSchemaObjectsUpdateHandler == nil {
 		unregistered = append(unregistered, "schema.SchemaObjectsUpdateHandler")
 	}
+	if o.SchemaTenantsCreateHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsCreateHandler")
+	}
+	if o.SchemaTenantsDeleteHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsDeleteHandler")
+	}
+	if o.SchemaTenantsGetHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsGetHandler")
+	}
+	if o.SchemaTenantsUpdateHandler == nil {
+		unregistered = append(unregistered, "schema.TenantsUpdateHandler")
+	}
 	if o.WeaviateRootHandler == nil {
 		unregistered = append(unregistered, "WeaviateRootHandler")
 	}
@@ -573,6 +677,26 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/objects/validate"] = objects.NewObjectsValidate(o.context, o.ObjectsObjectsValidateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/aliases"] = schema.NewSchemaAliasesCreate(o.context, o.SchemaSchemaAliasesCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/aliases/{aliasName}"] = schema.NewSchemaAliasesDelete(o.context, o.SchemaSchemaAliasesDeleteHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/aliases/{aliasName}"] = schema.NewSchemaAliasesUpdate(o.context, o.SchemaSchemaAliasesUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/apply"] = schema.NewSchemaApply(o.context, o.SchemaSchemaApplyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/diff"] = schema.NewSchemaDiff(o.context, o.SchemaSchemaDiffHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -593,10 +717,42 @@ func (o *WeaviateAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/schema/{className}/properties"] = schema.NewSchemaObjectsPropertiesAdd(o.context, o.SchemaSchemaObjectsPropertiesAddHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/properties/{propertyName}"] = schema.NewSchemaObjectsPropertiesDelete(o.context, o.SchemaSchemaObjectsPropertiesDeleteHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/properties/{propertyName}"] = schema.NewSchemaObjectsPropertiesUpdate(o.context, o.SchemaSchemaObjectsPropertiesUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/reindex"] = schema.NewSchemaObjectsReindex(o.context, o.SchemaSchemaObjectsReindexHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/reindex/{id}"] = schema.NewSchemaObjectsReindexGet(o.context, o.SchemaSchemaObjectsReindexGetHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/schema/{className}"] = schema.NewSchemaObjectsUpdate(o.context, o.SchemaSchemaObjectsUpdateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/schema/{className}/tenants"] = schema.NewTenantsCreate(o.context, o.SchemaTenantsCreateHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/schema/{className}/tenants"] = schema.NewTenantsDelete(o.context, o.SchemaTenantsDeleteHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/schema/{className}/tenants"] = schema.NewTenantsGet(o.context, o.SchemaTenantsGetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/schema/{className}/tenants"] = schema.NewTenantsUpdate(o.context, o.SchemaTenantsUpdateHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
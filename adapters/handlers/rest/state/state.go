@@ -13,7 +13,9 @@ package state
 
 import (
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql"
+	"github.com/semi-technologies/weaviate/usecases/audit"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/anonymous"
+	"github.com/semi-technologies/weaviate/usecases/auth/authentication/apikey"
 	"github.com/semi-technologies/weaviate/usecases/auth/authentication/oidc"
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization"
 	"github.com/semi-technologies/weaviate/usecases/config"
@@ -28,8 +30,10 @@ import (
 // TODO: remove dependencies to anything that's not an ent or uc
 type State struct {
 	OIDC            *oidc.Client
+	APIKey          *apikey.Client
 	AnonymousAccess *anonymous.Client
 	Authorizer      authorization.Authorizer
+	Auditor         *audit.Logger
 	ServerConfig    *config.WeaviateConfig
 	Locks           locks.ConnectorSchemaLock
 	Logger          *logrus.Logger
@@ -0,0 +1,243 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregations_Dates(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "Order",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "placedAt",
+			DataType: []string{string(schema.DataTypeDate)},
+		}, {
+			Name:     "total",
+			DataType: []string{string(schema.DataTypeInt)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	t.Run("adding objects", func(t *testing.T) {
+		orders := []struct {
+			id       strfmt.UUID
+			placedAt string
+			total    int64
+		}{
+			{"6a3b1f0d-3c3a-4d0c-9a1c-0d3f5f0a1b01", "2021-01-04T09:00:00Z", 10},
+			{"6a3b1f0d-3c3a-4d0c-9a1c-0d3f5f0a1b02", "2021-01-06T12:30:00Z", 20},
+			{"6a3b1f0d-3c3a-4d0c-9a1c-0d3f5f0a1b03", "2021-01-31T23:30:00Z", 30},
+			{"6a3b1f0d-3c3a-4d0c-9a1c-0d3f5f0a1b04", "2021-02-15T08:00:00Z", 40},
+			{"6a3b1f0d-3c3a-4d0c-9a1c-0d3f5f0a1b05", "2020-12-24T18:00:00Z", 50},
+		}
+
+		for _, o := range orders {
+			obj := &models.Object{
+				ID:    o.id,
+				Class: class.Class,
+				Properties: map[string]interface{}{
+					"placedAt": mustParseTime(o.placedAt),
+					"total":    o.total,
+				},
+			}
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 0}))
+		}
+	})
+
+	dateAggregators := []traverser.Aggregator{
+		traverser.CountAggregator,
+		traverser.MinimumAggregator,
+		traverser.MaximumAggregator,
+		traverser.MedianAggregator,
+	}
+
+	t.Run("unfiltered", func(t *testing.T) {
+		res, err := repo.Aggregate(context.Background(), traverser.AggregateParams{
+			ClassName: schema.ClassName(class.Class),
+			Properties: []traverser.AggregateProperty{{
+				Name:        "placedAt",
+				Aggregators: dateAggregators,
+			}},
+		})
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+
+		prop := res.Groups[0].Properties["placedAt"]
+		assert.Equal(t, aggregation.PropertyTypeDate, prop.Type)
+		assert.Equal(t, map[string]interface{}{
+			"count":   5,
+			"minimum": "2020-12-24T18:00:00Z",
+			"maximum": "2021-02-15T08:00:00Z",
+			"median":  "2021-01-06T12:30:00Z",
+		}, prop.DateAggregations)
+	})
+
+	t.Run("filtered", func(t *testing.T) {
+		res, err := repo.Aggregate(context.Background(), traverser.AggregateParams{
+			ClassName: schema.ClassName(class.Class),
+			Filters:   buildFilter("total", 20, gt, dtInt),
+			Properties: []traverser.AggregateProperty{{
+				Name:        "placedAt",
+				Aggregators: dateAggregators,
+			}},
+		})
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+
+		assert.Equal(t, map[string]interface{}{
+			"count":   3,
+			"minimum": "2020-12-24T18:00:00Z",
+			"maximum": "2021-02-15T08:00:00Z",
+			"median":  "2021-01-31T23:30:00Z",
+		}, res.Groups[0].Properties["placedAt"].DateAggregations)
+	})
+
+	groupedTotals := func(t *testing.T, dateGrouping *traverser.DateGrouping,
+		filter *filters.LocalFilter) ([]interface{}, []float64) {
+		res, err := repo.Aggregate(context.Background(), traverser.AggregateParams{
+			ClassName: schema.ClassName(class.Class),
+			Filters:   filter,
+			GroupBy: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: schema.PropertyName("placedAt"),
+			},
+			DateGrouping: dateGrouping,
+			Properties: []traverser.AggregateProperty{{
+				Name:        "total",
+				Aggregators: []traverser.Aggregator{traverser.SumAggregator},
+			}},
+		})
+		require.Nil(t, err)
+
+		values := make([]interface{}, len(res.Groups))
+		sums := make([]float64, len(res.Groups))
+		for i, group := range res.Groups {
+			values[i] = group.GroupedBy.Value
+			sums[i] = group.Properties["total"].NumericalAggregations["sum"]
+		}
+		return values, sums
+	}
+
+	t.Run("grouped by month in UTC", func(t *testing.T) {
+		values, sums := groupedTotals(t, &traverser.DateGrouping{
+			Interval: traverser.DateIntervalMonth,
+		}, nil)
+		assert.Equal(t, []interface{}{
+			"2020-12-01T00:00:00Z",
+			"2021-01-01T00:00:00Z",
+			"2021-02-01T00:00:00Z",
+		}, values)
+		assert.Equal(t, []float64{50, 60, 40}, sums)
+	})
+
+	t.Run("grouped by month in another timezone", func(t *testing.T) {
+		// 2021-01-31T23:30:00Z is already in February in Amsterdam
+		values, sums := groupedTotals(t, &traverser.DateGrouping{
+			Interval: traverser.DateIntervalMonth,
+			Timezone: "Europe/Amsterdam",
+		}, nil)
+		assert.Equal(t, []interface{}{
+			"2020-12-01T00:00:00+01:00",
+			"2021-01-01T00:00:00+01:00",
+			"2021-02-01T00:00:00+01:00",
+		}, values)
+		assert.Equal(t, []float64{50, 30, 70}, sums)
+	})
+
+	t.Run("grouped by week", func(t *testing.T) {
+		values, sums := groupedTotals(t, &traverser.DateGrouping{
+			Interval: traverser.DateIntervalWeek,
+		}, nil)
+		assert.Equal(t, []interface{}{
+			"2020-12-21T00:00:00Z",
+			"2021-01-04T00:00:00Z",
+			"2021-01-25T00:00:00Z",
+			"2021-02-15T00:00:00Z",
+		}, values)
+		assert.Equal(t, []float64{50, 30, 30, 40}, sums)
+	})
+
+	t.Run("grouped by year with a filter", func(t *testing.T) {
+		values, sums := groupedTotals(t, &traverser.DateGrouping{
+			Interval: traverser.DateIntervalYear,
+		}, buildFilter("total", 20, gt, dtInt))
+		assert.Equal(t, []interface{}{
+			"2020-01-01T00:00:00Z",
+			"2021-01-01T00:00:00Z",
+		}, values)
+		assert.Equal(t, []float64{50, 70}, sums)
+	})
+
+	t.Run("grouping a non-date property by interval", func(t *testing.T) {
+		_, err := repo.Aggregate(context.Background(), traverser.AggregateParams{
+			ClassName: schema.ClassName(class.Class),
+			GroupBy: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: schema.PropertyName("total"),
+			},
+			DateGrouping: &traverser.DateGrouping{Interval: traverser.DateIntervalDay},
+			Properties: []traverser.AggregateProperty{{
+				Name:        "total",
+				Aggregators: []traverser.Aggregator{traverser.SumAggregator},
+			}},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "date grouping requires")
+	})
+}
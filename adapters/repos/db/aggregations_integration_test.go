@@ -64,6 +64,15 @@ func Test_Aggregations(t *testing.T) {
 	t.Run("numerical aggregations without grouping (formerly Meta)",
 		testNumericalAggregationsWithoutGrouping(repo))
 
+	t.Run("numerical statistics",
+		testNumericalStatistics(repo))
+
+	t.Run("nested groups",
+		testNestedGroups(repo))
+
+	t.Run("approximate distinct count",
+		testApproximateDistinctCount(repo))
+
 	// t.Run("clean up",
 	// 	cleanupCompanyTestSchemaAndData(repo, migrator))
 }
@@ -1391,6 +1400,227 @@ func testNumericalAggregationsWithoutGrouping(repo *DB) func(t *testing.T) {
 	}
 }
 
+func testNumericalStatistics(repo *DB) func(t *testing.T) {
+	return func(t *testing.T) {
+		percentiles, err := traverser.NewPercentileAggregator([]float64{0, 50, 90, 99})
+		require.Nil(t, err)
+		buckets, err := traverser.NewHistogramAggregator(ptInt(4), nil)
+		require.Nil(t, err)
+		interval := float64(250)
+		byInterval, err := traverser.NewHistogramAggregator(nil, &interval)
+		require.Nil(t, err)
+
+		// the prices of all companies are 10, 47, 70, 70, 150, 160, 200, 600, 800
+		// and the same results are expected from the inverted index rows
+		// (unfiltered) and from the individual objects (filtered)
+		filtersToTest := map[string]*filters.LocalFilter{
+			"unfiltered": nil,
+			"filtered":   buildFilter("price", 0, gt, dtInt),
+		}
+
+		for name, filter := range filtersToTest {
+			t.Run(name, func(t *testing.T) {
+				params := traverser.AggregateParams{
+					ClassName: schema.ClassName(companyClass.Class),
+					Filters:   filter,
+					Properties: []traverser.AggregateProperty{{
+						Name: schema.PropertyName("price"),
+						Aggregators: []traverser.Aggregator{
+							traverser.StandardDeviationAggregator,
+							traverser.VarianceAggregator,
+							percentiles,
+						},
+					}},
+				}
+
+				res, err := repo.Aggregate(context.Background(), params)
+				require.Nil(t, err)
+				require.Len(t, res.Groups, 1)
+
+				price := res.Groups[0].Properties["price"]
+				assert.InDelta(t, 259.687181, price.NumericalAggregations["stddev"], 0.0001)
+				assert.InDelta(t, 67437.432098, price.NumericalAggregations["variance"], 0.0001)
+				assert.Equal(t, []aggregation.Percentile{
+					{Percentile: 0, Value: 10},
+					{Percentile: 50, Value: 150},
+					{Percentile: 90, Value: 800},
+					{Percentile: 99, Value: 800},
+				}, price.Percentiles)
+
+				params.Properties[0].Aggregators = []traverser.Aggregator{buckets}
+				res, err = repo.Aggregate(context.Background(), params)
+				require.Nil(t, err)
+				assert.Equal(t, []aggregation.HistogramBucket{
+					{From: 10, To: 207.5, Count: 7},
+					{From: 207.5, To: 405, Count: 0},
+					{From: 405, To: 602.5, Count: 1},
+					{From: 602.5, To: 800, Count: 1},
+				}, res.Groups[0].Properties["price"].Histogram)
+
+				params.Properties[0].Aggregators = []traverser.Aggregator{byInterval}
+				res, err = repo.Aggregate(context.Background(), params)
+				require.Nil(t, err)
+				assert.Equal(t, []aggregation.HistogramBucket{
+					{From: 0, To: 250, Count: 7},
+					{From: 250, To: 500, Count: 0},
+					{From: 500, To: 750, Count: 1},
+					{From: 750, To: 1000, Count: 1},
+				}, res.Groups[0].Properties["price"].Histogram)
+			})
+		}
+	}
+}
+
+func testNestedGroups(repo *DB) func(t *testing.T) {
+	return func(t *testing.T) {
+		path := func(prop string) *filters.Path {
+			return &filters.Path{
+				Class:    schema.ClassName(companyClass.Class),
+				Property: schema.PropertyName(prop),
+			}
+		}
+
+		// groupedValues returns the values of the groups and their nested groups
+		// in order, e.g. "Food/Atlanta"
+		groupedValues := func(groups []aggregation.Group) []string {
+			var out []string
+			for _, group := range groups {
+				for _, subGroup := range group.Groups {
+					out = append(out, fmt.Sprintf("%v/%v", group.GroupedBy.Value,
+						subGroup.GroupedBy.Value))
+				}
+			}
+			return out
+		}
+
+		t.Run("by sector, then location", func(t *testing.T) {
+			params := traverser.AggregateParams{
+				ClassName:        schema.ClassName(companyClass.Class),
+				GroupBy:          path("sector"),
+				SubGroupBy:       []*filters.Path{path("location")},
+				IncludeMetaCount: true,
+				Properties: []traverser.AggregateProperty{{
+					Name:        schema.PropertyName("price"),
+					Aggregators: []traverser.Aggregator{traverser.SumAggregator},
+				}},
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+
+			// the largest groups come first, groups of the same size are
+			// ordered by value
+			assert.Equal(t, []string{
+				"Food/Atlanta",
+				"Food/Detroit",
+				"Food/Los Angeles",
+				"Food/New York",
+				"Food/San Francisco",
+				"Financials/New York",
+				"Financials/San Francisco",
+			}, groupedValues(res.Groups))
+
+			food := res.Groups[0]
+			assert.Equal(t, 6, food.Count)
+			assert.Equal(t, float64(1310), food.Properties["price"].NumericalAggregations["sum"])
+
+			atlanta := food.Groups[0]
+			assert.Equal(t, []string{"location"}, atlanta.GroupedBy.Path)
+			assert.Equal(t, 2, atlanta.Count)
+			assert.Equal(t, float64(230), atlanta.Properties["price"].NumericalAggregations["sum"])
+		})
+
+		t.Run("sorted by value with a limit on every level", func(t *testing.T) {
+			params := traverser.AggregateParams{
+				ClassName:  schema.ClassName(companyClass.Class),
+				GroupBy:    path("sector"),
+				SubGroupBy: []*filters.Path{path("location")},
+				GroupSort: &traverser.GroupSort{
+					By:    traverser.GroupSortByValue,
+					Order: traverser.SortOrderDesc,
+				},
+				Limit: ptInt(2),
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+
+			assert.Equal(t, []string{
+				"Food/San Francisco",
+				"Food/New York",
+				"Financials/San Francisco",
+				"Financials/New York",
+			}, groupedValues(res.Groups))
+		})
+
+		t.Run("the smallest groups with a filter", func(t *testing.T) {
+			params := traverser.AggregateParams{
+				ClassName:  schema.ClassName(companyClass.Class),
+				Filters:    buildFilter("price", 100, gt, dtInt),
+				GroupBy:    path("sector"),
+				SubGroupBy: []*filters.Path{path("location")},
+				GroupSort: &traverser.GroupSort{
+					By:    traverser.GroupSortByCount,
+					Order: traverser.SortOrderAsc,
+				},
+				Limit: ptInt(1),
+			}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+
+			// prices above 100 are 150 and 600 in New York for Financials and
+			// 160, 800 and 200 in Atlanta, Los Angeles and San Francisco for Food
+			assert.Equal(t, []string{"Financials/New York"}, groupedValues(res.Groups))
+			assert.Equal(t, 2, res.Groups[0].Groups[0].Count)
+		})
+	}
+}
+
+func testApproximateDistinctCount(repo *DB) func(t *testing.T) {
+	return func(t *testing.T) {
+		distinctLocations := func(t *testing.T,
+			params traverser.AggregateParams) []int {
+			params.ClassName = schema.ClassName(companyClass.Class)
+			params.Properties = []traverser.AggregateProperty{{
+				Name: schema.PropertyName("location"),
+				Aggregators: []traverser.Aggregator{
+					traverser.CountAggregator,
+					traverser.ApproximateDistinctCountAggregator,
+				},
+			}}
+
+			res, err := repo.Aggregate(context.Background(), params)
+			require.Nil(t, err)
+
+			out := make([]int, len(res.Groups))
+			for i, group := range res.Groups {
+				out[i] = group.Properties["location"].TextAggregation.ApproximateDistinctCount
+			}
+			return out
+		}
+
+		t.Run("unfiltered", func(t *testing.T) {
+			assert.Equal(t, []int{5}, distinctLocations(t, traverser.AggregateParams{}))
+		})
+
+		t.Run("filtered", func(t *testing.T) {
+			assert.Equal(t, []int{4}, distinctLocations(t, traverser.AggregateParams{
+				Filters: buildFilter("price", 100, gt, dtInt),
+			}))
+		})
+
+		t.Run("grouped", func(t *testing.T) {
+			assert.Equal(t, []int{5, 2}, distinctLocations(t, traverser.AggregateParams{
+				GroupBy: &filters.Path{
+					Class:    schema.ClassName(companyClass.Class),
+					Property: schema.PropertyName("sector"),
+				},
+			}))
+		})
+	}
+}
+
 func ptInt(in int) *int {
 	return &in
 }
@@ -0,0 +1,179 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregations_NearVector(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "Product",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "category",
+			DataType: []string{string(schema.DataTypeString)},
+		}, {
+			Name:     "price",
+			DataType: []string{string(schema.DataTypeNumber)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	t.Run("adding objects", func(t *testing.T) {
+		products := []struct {
+			id       strfmt.UUID
+			category string
+			price    float64
+			vector   []float32
+		}{
+			{"3e9b1f0d-3c3a-4d0c-9a1c-0d3f5f0a1b01", "a", 10, []float32{1, 0}},
+			{"3e9b1f0d-3c3a-4d0c-9a1c-0d3f5f0a1b02", "a", 20, []float32{0.9, 0.1}},
+			{"3e9b1f0d-3c3a-4d0c-9a1c-0d3f5f0a1b03", "a", 30, []float32{0.7, 0.3}},
+			{"3e9b1f0d-3c3a-4d0c-9a1c-0d3f5f0a1b04", "b", 40, []float32{0, 1}},
+			{"3e9b1f0d-3c3a-4d0c-9a1c-0d3f5f0a1b05", "b", 50, []float32{0.1, 0.9}},
+		}
+
+		for _, p := range products {
+			obj := &models.Object{
+				ID:    p.id,
+				Class: class.Class,
+				Properties: map[string]interface{}{
+					"category": p.category,
+					"price":    p.price,
+				},
+			}
+			require.Nil(t, repo.PutObject(context.Background(), obj, p.vector))
+		}
+	})
+
+	priceSum := func(t *testing.T, params traverser.AggregateParams) []float64 {
+		params.ClassName = schema.ClassName(class.Class)
+		params.Properties = []traverser.AggregateProperty{{
+			Name:        "price",
+			Aggregators: []traverser.Aggregator{traverser.SumAggregator},
+		}}
+		params.IncludeMetaCount = true
+
+		res, err := repo.Aggregate(context.Background(), params)
+		require.Nil(t, err)
+
+		out := make([]float64, len(res.Groups))
+		for i, group := range res.Groups {
+			out[i] = group.Properties["price"].NumericalAggregations["sum"]
+		}
+		return out
+	}
+
+	t.Run("only the closest objects are aggregated", func(t *testing.T) {
+		sums := priceSum(t, traverser.AggregateParams{
+			SearchVector: []float32{1, 0},
+			ObjectLimit:  ptInt(2),
+		})
+		assert.Equal(t, []float64{30}, sums)
+
+		sums = priceSum(t, traverser.AggregateParams{
+			SearchVector: []float32{1, 0},
+			ObjectLimit:  ptInt(3),
+		})
+		assert.Equal(t, []float64{60}, sums)
+	})
+
+	t.Run("the meta count is the number of closest objects", func(t *testing.T) {
+		res, err := repo.Aggregate(context.Background(), traverser.AggregateParams{
+			ClassName:        schema.ClassName(class.Class),
+			IncludeMetaCount: true,
+			SearchVector:     []float32{0, 1},
+			ObjectLimit:      ptInt(4),
+		})
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+		assert.Equal(t, 4, res.Groups[0].Count)
+	})
+
+	t.Run("the vector search is restricted by the where filter", func(t *testing.T) {
+		sums := priceSum(t, traverser.AggregateParams{
+			Filters:      buildFilter("category", "b", eq, dtString),
+			SearchVector: []float32{1, 0},
+			ObjectLimit:  ptInt(1),
+		})
+		assert.Equal(t, []float64{50}, sums)
+
+		sums = priceSum(t, traverser.AggregateParams{
+			Filters:      buildFilter("category", "c", eq, dtString),
+			SearchVector: []float32{1, 0},
+			ObjectLimit:  ptInt(1),
+		})
+		assert.Equal(t, []float64{0}, sums)
+	})
+
+	t.Run("only the closest objects are grouped", func(t *testing.T) {
+		res, err := repo.Aggregate(context.Background(), traverser.AggregateParams{
+			ClassName: schema.ClassName(class.Class),
+			GroupBy: &filters.Path{
+				Class:    schema.ClassName(class.Class),
+				Property: "category",
+			},
+			IncludeMetaCount: true,
+			SearchVector:     []float32{1, 0},
+			ObjectLimit:      ptInt(3),
+		})
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+		assert.Equal(t, "a", res.Groups[0].GroupedBy.Value)
+		assert.Equal(t, 3, res.Groups[0].Count)
+	})
+}
@@ -16,6 +16,7 @@ import (
 	"fmt"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
@@ -24,6 +25,11 @@ import (
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
+// VectorSearcher returns the doc IDs of the objects closest to the search
+// vector. If the allow list is set, only those doc IDs are considered.
+type VectorSearcher func(targetVector string, searchVector []float32,
+	limit int, allowList helpers.AllowList) ([]uint64, error)
+
 type Aggregator struct {
 	store            *lsmkv.Store
 	params           traverser.AggregateParams
@@ -31,12 +37,14 @@ type Aggregator struct {
 	invertedRowCache *inverted.RowCacher
 	classSearcher    inverted.ClassSearcher // to support ref-filters
 	deletedDocIDs    inverted.DeletedDocIDChecker
+	vectorSearch     VectorSearcher // to support near<Media> params
 }
 
 func New(store *lsmkv.Store, params traverser.AggregateParams,
 	getSchema schemaUC.SchemaGetter, cache *inverted.RowCacher,
 	classSearcher inverted.ClassSearcher,
-	deletedDocIDs inverted.DeletedDocIDChecker) *Aggregator {
+	deletedDocIDs inverted.DeletedDocIDChecker,
+	vectorSearch VectorSearcher) *Aggregator {
 	return &Aggregator{
 		store:            store,
 		params:           params,
@@ -44,6 +52,7 @@ func New(store *lsmkv.Store, params traverser.AggregateParams,
 		invertedRowCache: cache,
 		classSearcher:    classSearcher,
 		deletedDocIDs:    deletedDocIDs,
+		vectorSearch:     vectorSearch,
 	}
 }
 
@@ -52,13 +61,75 @@ func (a *Aggregator) Do(ctx context.Context) (*aggregation.Result, error) {
 		return newGroupedAggregator(a).Do(ctx)
 	}
 
-	if a.params.Filters != nil {
+	if a.isFiltered() {
 		return newFilteredAggregator(a).Do(ctx)
 	}
 
 	return newUnfilteredAggregator(a).Do(ctx)
 }
 
+// isFiltered is true if only a subset of all objects is aggregated, either
+// because of a where filter or because of a search vector
+func (a *Aggregator) isFiltered() bool {
+	return a.params.Filters != nil || a.params.SearchVector != nil
+}
+
+// docIDs returns the doc IDs of the objects to aggregate. These are the
+// objects matching the where filter, of which only the objectLimit objects
+// closest to the search vector are kept if one is set.
+func (a *Aggregator) docIDs(ctx context.Context) (helpers.AllowList, error) {
+	var allowList helpers.AllowList
+	if a.params.Filters != nil {
+		s := a.getSchema.GetSchemaSkipAuth()
+		ids, err := inverted.NewSearcher(a.store, s, a.invertedRowCache, nil,
+			a.classSearcher, a.deletedDocIDs).
+			DocIDs(ctx, a.params.Filters, traverser.AdditionalProperties{},
+				a.params.ClassName)
+		if err != nil {
+			return nil, errors.Wrap(err, "retrieve doc IDs from searcher")
+		}
+
+		allowList = ids
+	}
+
+	if a.params.SearchVector == nil {
+		return allowList, nil
+	}
+
+	if allowList != nil && len(allowList) == 0 {
+		// nothing matches the filter, an empty allow list would not restrict
+		// the vector search
+		return allowList, nil
+	}
+
+	if a.vectorSearch == nil {
+		return nil, fmt.Errorf("vector search is not supported")
+	}
+
+	// the traverser requires an object limit whenever there is a search vector
+	if a.params.ObjectLimit == nil {
+		return nil, fmt.Errorf("vector search requires an object limit")
+	}
+	limit := *a.params.ObjectLimit
+
+	targetVector := ""
+	if a.params.NearVector != nil {
+		targetVector = a.params.NearVector.TargetVector
+	}
+
+	ids, err := a.vectorSearch(targetVector, a.params.SearchVector, limit, allowList)
+	if err != nil {
+		return nil, errors.Wrap(err, "vector search")
+	}
+
+	out := helpers.AllowList{}
+	for _, id := range ids {
+		out.Insert(id)
+	}
+
+	return out, nil
+}
+
 func (a *Aggregator) aggTypeOfProperty(
 	name schema.PropertyName) (aggregation.PropertyType, schema.DataType, error) {
 	s := a.getSchema.GetSchemaSkipAuth()
@@ -72,17 +143,28 @@ func (a *Aggregator) aggTypeOfProperty(
 	}
 
 	dt := schema.DataType(schemaProp.DataType[0])
-	switch dt {
+	elemType := dt
+	if arrayElemType, ok := schema.ArrayElementDataType(dt); ok {
+		// the elements of array props are aggregated as if they were the values
+		// of individual objects
+		elemType = arrayElemType
+	}
+
+	switch elemType {
 	case schema.DataTypeInt, schema.DataTypeNumber:
 		return aggregation.PropertyTypeNumerical, dt, nil
 	case schema.DataTypeBoolean:
 		return aggregation.PropertyTypeBoolean, dt, nil
 	case schema.DataTypeText, schema.DataTypeString:
 		return aggregation.PropertyTypeText, dt, nil
+	case schema.DataTypeDate:
+		return aggregation.PropertyTypeDate, dt, nil
 	case schema.DataTypeGeoCoordinates:
 		return "", "", fmt.Errorf("dataType geoCoordinates can't be aggregated")
 	case schema.DataTypePhoneNumber:
 		return "", "", fmt.Errorf("dataType phoneNumber can't be aggregated")
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		return "", "", fmt.Errorf("dataType %s can't be aggregated", dt)
 	default:
 		return "", "", fmt.Errorf("unrecoginzed dataType %v", schemaProp.DataType[0])
 	}
@@ -0,0 +1,67 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregator
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// dates are aggregated by a numericalAggregator on their unix timestamp in
+// microseconds, the results are converted back to dates
+
+func addDateAggregations(prop *aggregation.Property,
+	aggs []traverser.Aggregator, agg *numericalAggregator) {
+	if prop.DateAggregations == nil {
+		prop.DateAggregations = map[string]interface{}{}
+	}
+
+	for _, aProp := range aggs {
+		switch aProp {
+		case traverser.CountAggregator:
+			prop.DateAggregations[aProp.String()] = int(agg.Count())
+		case traverser.MinimumAggregator:
+			prop.DateAggregations[aProp.String()] = formatDateAgg(agg, agg.Min())
+		case traverser.MaximumAggregator:
+			prop.DateAggregations[aProp.String()] = formatDateAgg(agg, agg.Max())
+		case traverser.MedianAggregator:
+			prop.DateAggregations[aProp.String()] = formatDateAgg(agg, agg.Median())
+		case traverser.ModeAggregator:
+			prop.DateAggregations[aProp.String()] = formatDateAgg(agg, agg.Mode())
+		default:
+			continue
+		}
+	}
+}
+
+// formatDateAgg formats the microseconds as RFC3339 date, there is no
+// date if there are no values
+func formatDateAgg(agg *numericalAggregator, micros float64) interface{} {
+	if agg.count == 0 {
+		return nil
+	}
+
+	return time.Unix(0, int64(micros)*int64(time.Microsecond)).UTC().
+		Format(time.RFC3339Nano)
+}
+
+func parseDateValue(value string) (float64, error) {
+	asTime, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return 0, errors.Wrapf(err, "parse date %q", value)
+	}
+
+	return float64(asTime.UnixNano() / int64(time.Microsecond)), nil
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregator
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+)
+
+// dateBucketer maps the dates of a groupBy prop to the start of the calendar
+// interval they fall into, so that all dates within the same interval form a
+// single group
+type dateBucketer struct {
+	interval traverser.DateInterval
+	location *time.Location
+}
+
+func newDateBucketer(a *Aggregator) (*dateBucketer, error) {
+	s := a.getSchema.GetSchemaSkipAuth()
+	prop, err := s.GetProperty(a.params.ClassName, a.params.GroupBy.Property)
+	if err != nil {
+		return nil, errors.Wrapf(err, "groupBy property %s", a.params.GroupBy.Property)
+	}
+
+	dt := schema.DataType(prop.DataType[0])
+	if dt != schema.DataTypeDate && dt != schema.DataTypeDateArray {
+		return nil, fmt.Errorf("date grouping requires a groupBy property of type "+
+			"date, but %s is of type %s", prop.Name, dt)
+	}
+
+	location, err := time.LoadLocation(a.params.DateGrouping.Timezone)
+	if err != nil {
+		return nil, errors.Wrap(err, "date grouping")
+	}
+
+	return &dateBucketer{
+		interval: a.params.DateGrouping.Interval,
+		location: location,
+	}, nil
+}
+
+// bucket returns the start of the interval as RFC3339 date in the time zone
+// of the bucketer, ok is false if the value is not a date
+func (b *dateBucketer) bucket(value interface{}) (bucket interface{}, ok bool) {
+	asString, ok := value.(string)
+	if !ok {
+		return nil, false
+	}
+
+	asTime, err := time.Parse(time.RFC3339Nano, asString)
+	if err != nil {
+		return nil, false
+	}
+
+	asTime = asTime.In(b.location)
+	year, month, day := asTime.Date()
+
+	var start time.Time
+	switch b.interval {
+	case traverser.DateIntervalWeek:
+		// weeks start on Monday (ISO 8601)
+		daysSinceMonday := (int(asTime.Weekday()) + 6) % 7
+		start = time.Date(year, month, day-daysSinceMonday, 0, 0, 0, 0, b.location)
+	case traverser.DateIntervalMonth:
+		start = time.Date(year, month, 1, 0, 0, 0, 0, b.location)
+	case traverser.DateIntervalYear:
+		start = time.Date(year, time.January, 1, 0, 0, 0, 0, b.location)
+	default:
+		start = time.Date(year, month, day, 0, 0, 0, 0, b.location)
+	}
+
+	return start.Format(time.RFC3339), true
+}
+
+// compare orders the interval starts chronologically
+func (b *dateBucketer) compare(a, other interface{}) int {
+	start := func(value interface{}) time.Time {
+		asString, _ := value.(string)
+		asTime, _ := time.Parse(time.RFC3339, asString)
+		return asTime
+	}
+
+	startA, startB := start(a), start(other)
+	switch {
+	case startA.Before(startB):
+		return -1
+	case startA.After(startB):
+		return 1
+	default:
+		return 0
+	}
+}
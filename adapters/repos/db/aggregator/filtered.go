@@ -17,7 +17,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/docid"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
-	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/schema"
@@ -38,13 +37,9 @@ func (fa *filteredAggregator) Do(ctx context.Context) (*aggregation.Result, erro
 	// without grouping there is always exactly one group
 	out.Groups = make([]aggregation.Group, 1)
 
-	s := fa.getSchema.GetSchemaSkipAuth()
-	ids, err := inverted.NewSearcher(fa.store, s, fa.invertedRowCache, nil,
-		fa.Aggregator.classSearcher, fa.deletedDocIDs).
-		DocIDs(ctx, fa.params.Filters, traverser.AdditionalProperties{},
-			fa.params.ClassName)
+	ids, err := fa.docIDs(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "retrieve doc IDs from searcher")
+		return nil, err
 	}
 
 	if fa.params.IncludeMetaCount {
@@ -107,6 +102,14 @@ func (fa *filteredAggregator) analyzeObject(ctx context.Context,
 }
 
 func (fa *filteredAggregator) addPropValue(prop propAgg, value interface{}) {
+	if list, ok := value.([]interface{}); ok {
+		// array props, every element counts as a value
+		for _, elem := range list {
+			fa.addPropValue(prop, elem)
+		}
+		return
+	}
+
 	switch prop.aggType {
 	case aggregation.PropertyTypeBoolean:
 		asBool, ok := value.(bool)
@@ -126,6 +129,16 @@ func (fa *filteredAggregator) addPropValue(prop propAgg, value interface{}) {
 			return
 		}
 		prop.textAgg.AddText(asString)
+	case aggregation.PropertyTypeDate:
+		asString, ok := value.(string)
+		if !ok {
+			return
+		}
+		asMicros, err := parseDateValue(asString)
+		if err != nil {
+			return
+		}
+		prop.numericalAgg.AddFloat64(asMicros)
 	default:
 	}
 }
@@ -155,11 +168,10 @@ type propAggs map[string]propAgg
 func (pa *propAgg) initAggregator() {
 	switch pa.aggType {
 	case aggregation.PropertyTypeText:
-		limit := extractLimitFromTopOccs(pa.specifiedAggregators)
-		pa.textAgg = newTextAggregator(limit)
+		pa.textAgg = newTextAggregator(pa.specifiedAggregators)
 	case aggregation.PropertyTypeBoolean:
 		pa.boolAgg = newBoolAggregator()
-	case aggregation.PropertyTypeNumerical:
+	case aggregation.PropertyTypeNumerical, aggregation.PropertyTypeDate:
 		pa.numericalAgg = newNumericalAggregator()
 	default:
 	}
@@ -188,6 +200,12 @@ func (pa propAggs) results() (map[string]aggregation.Property, error) {
 				prop.numericalAgg)
 			out[prop.name.String()] = aggProp
 
+		case aggregation.PropertyTypeDate:
+			prop.numericalAgg.buildPairsFromCounts()
+			addDateAggregations(&aggProp, prop.specifiedAggregators,
+				prop.numericalAgg)
+			out[prop.name.String()] = aggProp
+
 		default:
 		}
 	}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
 )
 
 // groupedAggregator performs aggregation in groups. This is a two step
@@ -38,17 +39,44 @@ func (ga *groupedAggregator) Do(ctx context.Context) (*aggregation.Result, error
 		return nil, errors.Wrap(err, "identify groups")
 	}
 
-	out.Groups = make([]aggregation.Group, len(groups))
+	out.Groups, err = ga.aggregateGroups(ctx, groups, ga.params.SubGroupBy)
+	if err != nil {
+		return nil, err
+	}
+
+	return &out, nil
+}
+
+// aggregateGroups performs the aggregation for each group. If there are
+// further groupBy levels, each group is grouped again by the next level.
+func (ga *groupedAggregator) aggregateGroups(ctx context.Context, groups []group,
+	subGroupBy []*filters.Path) ([]aggregation.Group, error) {
+	out := make([]aggregation.Group, len(groups))
 	for i, g := range groups {
 		res, err := ga.aggregateGroup(ctx, g.res, g.docIDs)
 		if err != nil {
 			return nil, errors.Wrapf(err, "aggregate group %d (%v)", i,
 				g.res.GroupedBy.Value)
 		}
-		out.Groups[i] = res
+
+		if len(subGroupBy) > 0 {
+			subGroups, err := newGrouper(ga.Aggregator, subGroupBy[0], ga.groupLimit()).
+				DoForIDs(ctx, g.docIDs)
+			if err != nil {
+				return nil, errors.Wrapf(err, "identify sub groups of group %d (%v)", i,
+					g.res.GroupedBy.Value)
+			}
+
+			res.Groups, err = ga.aggregateGroups(ctx, subGroups, subGroupBy[1:])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		out[i] = res
 	}
 
-	return &out, nil
+	return out, nil
 }
 
 // group is a helper construct that contains the final aggregation.Group which
@@ -61,11 +89,15 @@ type group struct {
 }
 
 func (ga *groupedAggregator) identifyGroups(ctx context.Context) ([]group, error) {
-	limit := 100 // reasonable default in case we get none
+	return newGrouper(ga.Aggregator, ga.params.GroupBy, ga.groupLimit()).Do(ctx)
+}
+
+// groupLimit is the maximum number of groups on each level
+func (ga *groupedAggregator) groupLimit() int {
 	if ga.params.Limit != nil {
-		limit = *ga.params.Limit
+		return *ga.params.Limit
 	}
-	return newGrouper(ga.Aggregator, limit).Do(ctx)
+	return 100 // reasonable default in case we get none
 }
 
 func (ga *groupedAggregator) aggregateGroup(ctx context.Context,
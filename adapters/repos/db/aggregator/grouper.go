@@ -14,14 +14,16 @@ package aggregator
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/docid"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
-	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	bolt "go.etcd.io/bbolt"
 )
@@ -31,29 +33,51 @@ import (
 // additionally performs an aggregation for each group.
 type grouper struct {
 	*Aggregator
-	values    map[interface{}][]uint64 // map[value]docIDs
-	topGroups []group
-	limit     int
+	path        *filters.Path
+	values      map[interface{}][]uint64 // map[value]docIDs
+	limit       int
+	dateBuckets *dateBucketer // only set when grouping dates by interval
 }
 
-func newGrouper(a *Aggregator, limit int) *grouper {
+func newGrouper(a *Aggregator, path *filters.Path, limit int) *grouper {
 	return &grouper{
 		Aggregator: a,
+		path:       path,
 		values:     map[interface{}][]uint64{},
 		limit:      limit,
 	}
 }
 
+// Do identifies the groups of all objects to aggregate, it is used for the
+// top-level groupBy
 func (g *grouper) Do(ctx context.Context) ([]group, error) {
-	if len(g.params.GroupBy.Slice()) > 1 {
+	if len(g.path.Slice()) > 1 {
 		return nil, fmt.Errorf("grouping by cross-refs not supported")
 	}
 
-	if g.params.Filters == nil {
+	if g.params.DateGrouping != nil {
+		dateBuckets, err := newDateBucketer(g.Aggregator)
+		if err != nil {
+			return nil, err
+		}
+		g.dateBuckets = dateBuckets
+	}
+
+	if !g.isFiltered() {
 		return g.groupAll(ctx)
-	} else {
-		return g.groupFiltered(ctx)
 	}
+
+	return g.groupFiltered(ctx)
+}
+
+// DoForIDs identifies the groups within the given objects, it is used for the
+// nested levels of a subGroupBy
+func (g *grouper) DoForIDs(ctx context.Context, ids []uint64) ([]group, error) {
+	if len(g.path.Slice()) > 1 {
+		return nil, fmt.Errorf("grouping by cross-refs not supported")
+	}
+
+	return g.groupIDs(ids)
 }
 
 func (g *grouper) groupAll(ctx context.Context) ([]group, error) {
@@ -64,27 +88,27 @@ func (g *grouper) groupAll(ctx context.Context) ([]group, error) {
 		return nil, errors.Wrap(err, "group all (unfiltered)")
 	}
 
-	return g.aggregateAndSelect()
+	return g.aggregateAndSelect(), nil
 }
 
 func (g *grouper) groupFiltered(ctx context.Context) ([]group, error) {
-	s := g.getSchema.GetSchemaSkipAuth()
-	ids, err := inverted.NewSearcher(g.store, s, g.invertedRowCache, nil,
-		g.classSearcher, g.deletedDocIDs).
-		DocIDs(ctx, g.params.Filters, traverser.AdditionalProperties{},
-			g.params.ClassName)
+	ids, err := g.docIDs(ctx)
 	if err != nil {
-		return nil, errors.Wrap(err, "retrieve doc IDs from searcher")
+		return nil, err
 	}
 
-	if err := docid.ScanObjectsLSM(g.store, flattenAllowList(ids),
+	return g.groupIDs(flattenAllowList(ids))
+}
+
+func (g *grouper) groupIDs(ids []uint64) ([]group, error) {
+	if err := docid.ScanObjectsLSM(g.store, ids,
 		func(obj *storobj.Object) (bool, error) {
 			return true, g.addElement(obj)
 		}); err != nil {
 		return nil, err
 	}
 
-	return g.aggregateAndSelect()
+	return g.aggregateAndSelect(), nil
 }
 
 func (g *grouper) addElement(obj *storobj.Object) error {
@@ -93,23 +117,58 @@ func (g *grouper) addElement(obj *storobj.Object) error {
 		return nil
 	}
 
-	item, ok := s.(map[string]interface{})[g.params.GroupBy.Property.String()]
+	item, ok := s.(map[string]interface{})[g.path.Property.String()]
 	if !ok {
 		return nil
 	}
 
-	ids := g.values[item]
-	ids = append(ids, obj.DocID())
-	g.values[item] = ids
+	if list, ok := item.([]interface{}); ok {
+		// objects with an array prop are part of the group of each element
+		seen := map[interface{}]struct{}{}
+		for _, elem := range list {
+			value, ok := g.groupValue(elem)
+			if !ok {
+				continue
+			}
+			if _, ok := seen[value]; ok {
+				continue
+			}
+			seen[value] = struct{}{}
+			g.addToGroup(value, obj.DocID())
+		}
+		return nil
+	}
+
+	if value, ok := g.groupValue(item); ok {
+		g.addToGroup(value, obj.DocID())
+	}
 	return nil
 }
 
-func (g *grouper) aggregateAndSelect() ([]group, error) {
+// groupValue is the value identifying the group of the item, which is the item
+// itself unless dates are grouped by interval
+func (g *grouper) groupValue(item interface{}) (interface{}, bool) {
+	if g.dateBuckets == nil {
+		return item, true
+	}
+
+	return g.dateBuckets.bucket(item)
+}
+
+func (g *grouper) addToGroup(value interface{}, docID uint64) {
+	ids := g.values[value]
+	ids = append(ids, docID)
+	g.values[value] = ids
+}
+
+// aggregateAndSelect sorts the groups and keeps the first n
+func (g *grouper) aggregateAndSelect() []group {
+	groups := make([]group, 0, len(g.values))
 	for value, ids := range g.values {
-		g.insertOrdered(group{
+		groups = append(groups, group{
 			res: aggregation.Group{
 				GroupedBy: &aggregation.GroupedBy{
-					Path:  g.params.GroupBy.Slice(),
+					Path:  g.path.Slice(),
 					Value: value,
 				},
 				Count: len(ids),
@@ -118,39 +177,92 @@ func (g *grouper) aggregateAndSelect() ([]group, error) {
 		})
 	}
 
-	return g.topGroups, nil
+	sort.Slice(groups, func(i, j int) bool {
+		return g.less(groups[i].res, groups[j].res)
+	})
+
+	if len(groups) > g.limit {
+		groups = groups[:g.limit]
+	}
+
+	return groups
 }
 
-func (g *grouper) insertOrdered(elem group) {
-	if len(g.topGroups) == 0 {
-		g.topGroups = []group{elem}
-		return
+// less orders the groups as specified by the groupSort param. Without one,
+// the largest groups come first, unless dates are grouped by interval, in
+// which case the groups are in chronological order. Groups of the same size
+// are in ascending order of their value, so the selected groups don't depend
+// on the map iteration order.
+func (g *grouper) less(a, b aggregation.Group) bool {
+	sortBy, order := traverser.GroupSortByCount, traverser.SortOrderDesc
+	if g.dateBuckets != nil {
+		sortBy, order = traverser.GroupSortByValue, traverser.SortOrderAsc
+	}
+	if g.params.GroupSort != nil {
+		sortBy, order = g.params.GroupSort.By, g.params.GroupSort.Order
 	}
 
-	added := false
-	for i, existing := range g.topGroups {
-		if existing.res.Count > elem.res.Count {
-			continue
+	valueCmp := g.compareValues(a.GroupedBy.Value, b.GroupedBy.Value)
+
+	cmp := valueCmp
+	if sortBy == traverser.GroupSortByCount {
+		cmp = compareInts(a.Count, b.Count)
+		if cmp == 0 {
+			return valueCmp < 0
 		}
+	}
 
-		// we have found the first one that's smaller so me must insert before i
-		g.topGroups = append(
-			g.topGroups[:i], append(
-				[]group{elem},
-				g.topGroups[i:]...,
-			)...,
-		)
+	if order == traverser.SortOrderDesc {
+		return cmp > 0
+	}
+	return cmp < 0
+}
 
-		added = true
-		break
+func (g *grouper) compareValues(a, b interface{}) int {
+	if g.dateBuckets != nil {
+		return g.dateBuckets.compare(a, b)
+	}
+
+	switch typedA := a.(type) {
+	case float64:
+		if typedB, ok := b.(float64); ok {
+			return compareFloats(typedA, typedB)
+		}
+	case string:
+		if typedB, ok := b.(string); ok {
+			return strings.Compare(typedA, typedB)
+		}
+	case bool:
+		if typedB, ok := b.(bool); ok && typedA != typedB {
+			if typedA {
+				return 1
+			}
+			return -1
+		}
 	}
 
-	if len(g.topGroups) > g.limit {
-		g.topGroups = g.topGroups[:len(g.topGroups)-1]
+	return strings.Compare(fmt.Sprint(a), fmt.Sprint(b))
+}
+
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
 	}
+}
 
-	if !added && len(g.topGroups) < g.limit {
-		g.topGroups = append(g.topGroups, elem)
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
 	}
 }
 
@@ -0,0 +1,50 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregator
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHyperLogLog(t *testing.T) {
+	t.Run("without values", func(t *testing.T) {
+		assert.Equal(t, uint64(0), newHyperLogLog().Estimate())
+	})
+
+	t.Run("duplicates are only counted once", func(t *testing.T) {
+		hll := newHyperLogLog()
+		for i := 0; i < 1000; i++ {
+			hll.Add([]byte(fmt.Sprintf("user-%d", i%10)))
+		}
+
+		assert.Equal(t, uint64(10), hll.Estimate())
+	})
+
+	for _, distinct := range []int{1000, 50000, 500000} {
+		t.Run(fmt.Sprintf("with %d distinct values", distinct), func(t *testing.T) {
+			hll := newHyperLogLog()
+			for i := 0; i < distinct; i++ {
+				hll.Add([]byte(fmt.Sprintf("user-%d", i)))
+			}
+
+			// the standard error is about 0.8%, so 3% is well outside of what
+			// could be expected
+			relativeError := math.Abs(float64(hll.Estimate())-float64(distinct)) /
+				float64(distinct)
+			assert.Less(t, relativeError, 0.03)
+		})
+	}
+}
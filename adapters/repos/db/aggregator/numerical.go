@@ -14,6 +14,7 @@ package aggregator
 import (
 	"math"
 	"sort"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
@@ -43,9 +44,18 @@ func addNumericalAggregations(prop *aggregation.Property,
 			prop.NumericalAggregations[aProp.String()] = agg.Sum()
 		case traverser.CountAggregator:
 			prop.NumericalAggregations[aProp.String()] = agg.Count()
+		case traverser.StandardDeviationAggregator:
+			prop.NumericalAggregations[aProp.String()] = agg.StandardDeviation()
+		case traverser.VarianceAggregator:
+			prop.NumericalAggregations[aProp.String()] = agg.Variance()
 
 		default:
-			continue
+			switch {
+			case aProp.Type == traverser.PercentileType && aProp.Percentiles != nil:
+				prop.Percentiles = agg.Percentiles(aProp.Percentiles.Values)
+			case aProp.Type == traverser.HistogramType && aProp.Histogram != nil:
+				prop.Histogram = agg.Histogram(*aProp.Histogram)
+			}
 		}
 	}
 }
@@ -53,7 +63,7 @@ func addNumericalAggregations(prop *aggregation.Property,
 func newNumericalAggregator() *numericalAggregator {
 	return &numericalAggregator{
 		min:          math.MaxFloat64,
-		max:          math.SmallestNonzeroFloat64,
+		max:          -math.MaxFloat64,
 		valueCounter: map[float64]uint64{},
 	}
 }
@@ -65,6 +75,8 @@ type numericalAggregator struct {
 	sum          float64
 	maxCount     uint64
 	mode         float64
+	runningMean  float64            // for the variance, see addToVariance()
+	m2           float64            // sum of squared differences from the running mean
 	pairs        []floatCountPair   // for row-based median calculation
 	valueCounter map[float64]uint64 // for individual median calculation
 }
@@ -77,6 +89,7 @@ type floatCountPair struct {
 func (a *numericalAggregator) AddFloat64(value float64) error {
 	a.count++
 	a.sum += value
+	a.addToVariance(value, 1)
 	if value < a.min {
 		a.min = value
 	}
@@ -92,6 +105,15 @@ func (a *numericalAggregator) AddFloat64(value float64) error {
 	return nil
 }
 
+// addToVariance updates the running mean and the sum of squared differences
+// from it using Welford's algorithm, which is numerically more stable than
+// summing up the squares. It must be called after the count was increased.
+func (a *numericalAggregator) addToVariance(value float64, count uint64) {
+	delta := value - a.runningMean
+	a.runningMean += delta * float64(count) / float64(a.count)
+	a.m2 += delta * (value - a.runningMean) * float64(count)
+}
+
 // turns the value counter into a sorted list, as well as identifying the mode
 func (a *numericalAggregator) buildPairsFromCounts() {
 	for value, count := range a.valueCounter {
@@ -114,60 +136,57 @@ func (a *numericalAggregator) AddFloat64Row(number []byte,
 		return errors.Wrap(err, "read float64")
 	}
 
-	if count == 0 {
-		// skip
-		return nil
-	}
-
-	a.count += count
-	a.sum += numberParsed * float64(count)
-	if numberParsed < a.min {
-		a.min = numberParsed
-	}
-	if numberParsed > a.max {
-		a.max = numberParsed
-	}
+	a.addRow(numberParsed, count)
+	return nil
+}
 
-	if count > a.maxCount {
-		a.maxCount = count
-		a.mode = numberParsed
+func (a *numericalAggregator) AddInt64Row(number []byte, count uint64) error {
+	numberParsed, err := inverted.ParseLexicographicallySortableInt64(number)
+	if err != nil {
+		return errors.Wrap(err, "read int64")
 	}
 
-	a.pairs = append(a.pairs, floatCountPair{value: numberParsed, count: count})
-
+	a.addRow(float64(numberParsed), count)
 	return nil
 }
 
-func (a *numericalAggregator) AddInt64Row(number []byte, count uint64) error {
+// AddDateRow adds a row of a date prop, which is indexed as unix nanoseconds.
+// The value is added in microseconds, as a float64 can represent those exactly
+// for all realistic dates, but not nanoseconds.
+func (a *numericalAggregator) AddDateRow(number []byte, count uint64) error {
 	numberParsed, err := inverted.ParseLexicographicallySortableInt64(number)
 	if err != nil {
 		return errors.Wrap(err, "read int64")
 	}
 
-	asFloat := float64(numberParsed)
+	a.addRow(float64(numberParsed/int64(time.Microsecond)), count)
+	return nil
+}
 
+// addRow adds a value which occurs count times. Rows are read from the
+// inverted index in order, so the pairs are sorted without further ado.
+func (a *numericalAggregator) addRow(value float64, count uint64) {
 	if count == 0 {
 		// skip
-		return nil
+		return
 	}
 
 	a.count += count
-	a.sum += asFloat * float64(count)
-	if asFloat < a.min {
-		a.min = asFloat
+	a.sum += value * float64(count)
+	a.addToVariance(value, count)
+	if value < a.min {
+		a.min = value
 	}
-	if asFloat > a.max {
-		a.max = asFloat
+	if value > a.max {
+		a.max = value
 	}
 
 	if count > a.maxCount {
 		a.maxCount = count
-		a.mode = asFloat
+		a.mode = value
 	}
 
-	a.pairs = append(a.pairs, floatCountPair{value: asFloat, count: count})
-
-	return nil
+	a.pairs = append(a.pairs, floatCountPair{value: value, count: count})
 }
 
 func (a *numericalAggregator) Mean() float64 {
@@ -222,3 +241,144 @@ func (a *numericalAggregator) Median() float64 {
 
 	return median
 }
+
+// Variance is the population variance of all values
+func (a *numericalAggregator) Variance() float64 {
+	if a.count == 0 {
+		return 0
+	}
+	return a.m2 / float64(a.count)
+}
+
+func (a *numericalAggregator) StandardDeviation() float64 {
+	return math.Sqrt(a.Variance())
+}
+
+// Percentiles uses the nearest-rank method, so every percentile is an actual
+// value. Like Median() it requires a call of buildPairsFromCounts() if it was
+// built using individual objects
+func (a *numericalAggregator) Percentiles(percentiles []float64) []aggregation.Percentile {
+	out := make([]aggregation.Percentile, len(percentiles))
+	for i, percentile := range percentiles {
+		out[i] = aggregation.Percentile{
+			Percentile: percentile,
+			Value:      a.percentile(percentile),
+		}
+	}
+
+	return out
+}
+
+func (a *numericalAggregator) percentile(percentile float64) float64 {
+	if a.count == 0 {
+		return 0
+	}
+
+	rank := uint64(math.Ceil(percentile / 100 * float64(a.count)))
+	if rank == 0 {
+		rank = 1
+	}
+
+	for _, pair := range a.pairs {
+		if rank <= pair.count {
+			return pair.value
+		}
+		rank -= pair.count
+	}
+
+	return a.max
+}
+
+// Histogram counts the values per bucket, the buckets span from the minimum to
+// the maximum value. Like Median() it requires a call of
+// buildPairsFromCounts() if it was built using individual objects
+func (a *numericalAggregator) Histogram(
+	params traverser.HistogramParams) []aggregation.HistogramBucket {
+	if a.count == 0 {
+		return []aggregation.HistogramBucket{}
+	}
+
+	var from, width float64
+	var n int
+	if params.Interval > 0 {
+		from, width, n = intervalBuckets(a.min, a.max, params.Interval)
+	} else {
+		from, width, n = a.min, (a.max-a.min)/float64(params.Buckets), params.Buckets
+		if width == 0 {
+			// all values are identical, so there is only a single bucket
+			n = 1
+		}
+	}
+
+	buckets := make([]aggregation.HistogramBucket, n)
+	for i := range buckets {
+		buckets[i].From = clampFloat64(from + float64(i)*width)
+		buckets[i].To = clampFloat64(from + float64(i+1)*width)
+	}
+	if params.Interval == 0 {
+		// prevent rounding errors from excluding the maximum
+		buckets[n-1].To = a.max
+	}
+
+	for _, pair := range a.pairs {
+		i := n - 1
+		if width > 0 {
+			// divided first, as the distance may overflow for extreme values
+			i = int(math.Floor(pair.value/width - from/width))
+		}
+		if i >= n {
+			i = n - 1
+		}
+		if i < 0 {
+			i = 0
+		}
+		buckets[i].Count += int(pair.count)
+	}
+
+	return buckets
+}
+
+// intervalBuckets aligns the buckets to multiples of the interval. If this
+// leads to more than traverser.MaxHistogramBuckets, the interval is widened to a
+// multiple of itself. The bucket count is computed in float64, as it would
+// overflow an int for intervals which are tiny compared to the values.
+func intervalBuckets(min, max, interval float64) (float64, float64, int) {
+	count := func(width float64) float64 {
+		return math.Floor(max/width) - math.Floor(min/width) + 1
+	}
+
+	width := interval
+	n := count(width)
+	switch {
+	case n <= traverser.MaxHistogramBuckets:
+		return math.Floor(min/width) * width, width, int(n)
+	case math.IsNaN(n) || math.IsInf(n, 0):
+		// the values can not be expressed in multiples of the interval at all,
+		// so the range is split evenly instead
+		if max == min {
+			return min, interval, 1
+		}
+		// divided first, as the range itself may overflow
+		width = max/(traverser.MaxHistogramBuckets-2) -
+			min/(traverser.MaxHistogramBuckets-2)
+	default:
+		width = interval * math.Ceil(n/traverser.MaxHistogramBuckets)
+	}
+
+	for count(width) > traverser.MaxHistogramBuckets {
+		next := width + interval
+		if next == width {
+			// the interval is too small to change the width when added
+			next = width * (1 + 1.0/traverser.MaxHistogramBuckets)
+		}
+		width = next
+	}
+
+	return math.Floor(min/width) * width, width, int(count(width))
+}
+
+// clampFloat64 keeps bucket bounds finite when they are extended past the
+// largest representable values
+func clampFloat64(v float64) float64 {
+	return math.Max(-math.MaxFloat64, math.Min(math.MaxFloat64, v))
+}
@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package aggregator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNumericalAggregator_MinMax(t *testing.T) {
+	t.Run("with only negative values", func(t *testing.T) {
+		agg := newNumericalAggregator()
+		for _, value := range []float64{-3, -7, -1.5} {
+			require.Nil(t, agg.AddFloat64(value))
+		}
+
+		assert.Equal(t, -7.0, agg.Min())
+		assert.Equal(t, -1.5, agg.Max())
+	})
+
+	t.Run("with only positive values", func(t *testing.T) {
+		agg := newNumericalAggregator()
+		for _, value := range []float64{3, 7, 1.5} {
+			require.Nil(t, agg.AddFloat64(value))
+		}
+
+		assert.Equal(t, 1.5, agg.Min())
+		assert.Equal(t, 7.0, agg.Max())
+	})
+}
+
+func TestNumericalAggregator_HistogramInterval(t *testing.T) {
+	tests := []struct {
+		name     string
+		values   []float64
+		interval float64
+	}{
+		{name: "regular interval", values: []float64{1, 5, 9}, interval: 2},
+		{name: "too many buckets", values: []float64{0, 1e6}, interval: 1},
+		{name: "interval tiny compared to the values", values: []float64{1e10, 2e10}, interval: 1e-10},
+		{name: "smallest interval", values: []float64{-1e10, 1e10}, interval: 1e-300},
+		{name: "smallest interval with identical values", values: []float64{1e10, 1e10}, interval: 1e-300},
+		{name: "huge values", values: []float64{-math.MaxFloat64, math.MaxFloat64}, interval: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			agg := newNumericalAggregator()
+			for _, value := range test.values {
+				require.Nil(t, agg.AddFloat64(value))
+			}
+			agg.buildPairsFromCounts()
+
+			buckets := agg.Histogram(traverser.HistogramParams{Interval: test.interval})
+			require.NotEmpty(t, buckets)
+			assert.LessOrEqual(t, len(buckets), traverser.MaxHistogramBuckets)
+
+			total := 0
+			for _, bucket := range buckets {
+				assert.False(t, math.IsNaN(bucket.From) || math.IsInf(bucket.From, 0))
+				assert.False(t, math.IsNaN(bucket.To) || math.IsInf(bucket.To, 0))
+				total += bucket.Count
+			}
+			assert.Equal(t, len(test.values), total)
+		})
+	}
+}
@@ -32,23 +32,58 @@ func extractLimitFromTopOccs(aggs []traverser.Aggregator) int {
 	return 5
 }
 
-func newTextAggregator(limit int) *textAggregator {
-	return &textAggregator{itemCounter: map[string]int{}, max: limit}
+func newTextAggregator(aggs []traverser.Aggregator) *textAggregator {
+	agg := &textAggregator{max: extractLimitFromTopOccs(aggs)}
+
+	for _, spec := range aggs {
+		switch spec.Type {
+		case traverser.TopOccurrencesType:
+			agg.itemCounter = map[string]int{}
+		case traverser.ApproximateDistinctCountAggregator.Type:
+			agg.distinct = newHyperLogLog()
+		}
+	}
+
+	return agg
 }
 
 type textAggregator struct {
 	max   int
 	count uint64
 
+	// only set if the top occurrences are requested, as it holds every
+	// distinct value
 	itemCounter map[string]int
 
+	// only set if the approximate distinct count is requested
+	distinct *hyperLogLog
+
 	// always keep sorted, so we can cut off the last elem, when it grows larger
 	// than max
 	topPairs []aggregation.TextOccurrence
 }
 
 func (a *Aggregator) parseAndAddTextRow(agg *textAggregator,
-	v []byte, propName schema.PropertyName) error {
+	v []byte, propName schema.PropertyName, dt schema.DataType) error {
+	if schema.IsArrayDataType(dt) {
+		items, ok, err := storobj.ParseAndExtractTextArrayProp(v, propName.String())
+		if err != nil {
+			return errors.Wrap(err, "parse and extract prop")
+		}
+
+		if !ok {
+			return nil
+		}
+
+		for _, item := range items {
+			if err := agg.AddText(item); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
 	item, ok, err := storobj.ParseAndExtractTextProp(v, propName.String())
 	if err != nil {
 		return errors.Wrap(err, "parse and extract prop")
@@ -64,9 +99,14 @@ func (a *Aggregator) parseAndAddTextRow(agg *textAggregator,
 func (a *textAggregator) AddText(value string) error {
 	a.count++
 
-	itemCount := a.itemCounter[value]
-	itemCount++
-	a.itemCounter[value] = itemCount
+	if a.itemCounter != nil {
+		a.itemCounter[value]++
+	}
+
+	if a.distinct != nil {
+		a.distinct.Add([]byte(value))
+	}
+
 	return nil
 }
 
@@ -135,5 +175,8 @@ func (a *textAggregator) Res() aggregation.Text {
 	})
 
 	out.Count = int(a.count)
+	if a.distinct != nil {
+		out.ApproximateDistinctCount = int(a.distinct.Estimate())
+	}
 	return out
 }
@@ -120,15 +120,17 @@ func (ua unfilteredAggregator) property(ctx context.Context,
 
 	switch aggType {
 	case aggregation.PropertyTypeNumerical:
-		if dt == schema.DataTypeNumber {
+		if dt == schema.DataTypeNumber || dt == schema.DataTypeNumberArray {
 			return ua.floatProperty(ctx, prop)
 		} else {
 			return ua.intProperty(ctx, prop)
 		}
 	case aggregation.PropertyTypeBoolean:
 		return ua.boolProperty(ctx, prop)
+	case aggregation.PropertyTypeDate:
+		return ua.dateProperty(ctx, prop)
 	case aggregation.PropertyTypeText:
-		return ua.textProperty(ctx, prop)
+		return ua.textProperty(ctx, prop, dt)
 	case aggregation.PropertyTypeReference:
 		// ignore, as this is handled outside the repo in the uc
 		return nil, nil
@@ -18,6 +18,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
@@ -117,6 +118,39 @@ func (ua unfilteredAggregator) intProperty(ctx context.Context,
 	return &out, nil
 }
 
+func (ua unfilteredAggregator) dateProperty(ctx context.Context,
+	prop traverser.AggregateProperty) (*aggregation.Property, error) {
+	out := aggregation.Property{
+		Type:             aggregation.PropertyTypeDate,
+		DateAggregations: map[string]interface{}{},
+	}
+
+	b := ua.store.Bucket(helpers.BucketFromPropNameLSM(prop.Name.String()))
+	if b == nil {
+		return nil, errors.Errorf("could not find bucket for prop %s", prop.Name)
+	}
+
+	agg := newNumericalAggregator()
+
+	c := b.SetCursor() // dates are indexed like ints, so it's always a Set
+	defer c.Close()
+
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if len(k) != 8 {
+			return nil, fmt.Errorf("unexpected key length on inverted index, "+
+				"expected 8: got %d", len(k))
+		}
+
+		if err := agg.AddDateRow(k, uint64(len(v))); err != nil {
+			return nil, err
+		}
+	}
+
+	addDateAggregations(&out, prop.Aggregators, agg)
+
+	return &out, nil
+}
+
 func (ua unfilteredAggregator) parseAndAddFloatRow(agg *numericalAggregator, k []byte,
 	v [][]byte) error {
 	if len(k) != 8 {
@@ -150,20 +184,18 @@ func (ua unfilteredAggregator) parseAndAddIntRow(agg *numericalAggregator, k []b
 }
 
 func (ua unfilteredAggregator) textProperty(ctx context.Context,
-	prop traverser.AggregateProperty) (*aggregation.Property, error) {
+	prop traverser.AggregateProperty, dt schema.DataType) (*aggregation.Property, error) {
 	out := aggregation.Property{
 		Type:            aggregation.PropertyTypeText,
 		TextAggregation: aggregation.Text{},
 	}
 
-	limit := extractLimitFromTopOccs(prop.Aggregators)
-
 	b := ua.store.Bucket(helpers.ObjectsBucketLSM)
 	if b == nil {
 		return nil, errors.Errorf("could not find bucket for prop %s", prop.Name)
 	}
 
-	agg := newTextAggregator(limit)
+	agg := newTextAggregator(prop.Aggregators)
 
 	// we're looking at the whole object, so this is neither a Set, nor a Map, but
 	// a Replace strategy
@@ -171,7 +203,7 @@ func (ua unfilteredAggregator) textProperty(ctx context.Context,
 	defer c.Close()
 
 	for k, v := c.First(); k != nil; k, v = c.Next() {
-		if err := ua.parseAndAddTextRow(agg, v, prop.Name); err != nil {
+		if err := ua.parseAndAddTextRow(agg, v, prop.Name, dt); err != nil {
 			return nil, err
 		}
 	}
@@ -46,13 +46,13 @@ func (d *DB) PutObject(ctx context.Context, obj *models.Object,
 }
 
 func (d *DB) DeleteObject(ctx context.Context, className string,
-	id strfmt.UUID) error {
+	id strfmt.UUID, tenant string) error {
 	idx := d.GetIndex(schema.ClassName(className))
 	if idx == nil {
 		return fmt.Errorf("delete from non-existing index for %s", className)
 	}
 
-	err := idx.deleteObject(ctx, id)
+	err := idx.deleteObject(ctx, id, tenant)
 	if err != nil {
 		return errors.Wrapf(err, "delete from index %s", idx.ID())
 	}
@@ -62,7 +62,8 @@ func (d *DB) DeleteObject(ctx context.Context, className string,
 
 func (d *DB) MultiGet(ctx context.Context,
 	query []multi.Identifier,
-	additional traverser.AdditionalProperties) ([]search.Result, error) {
+	additional traverser.AdditionalProperties,
+	tenant string) ([]search.Result, error) {
 	byIndex := map[string][]multi.Identifier{}
 
 	for i, q := range query {
@@ -82,7 +83,16 @@ func (d *DB) MultiGet(ctx context.Context,
 
 	out := make(search.Results, len(query))
 	for indexID, queries := range byIndex {
-		indexRes, err := d.indices[indexID].multiObjectByID(ctx, queries)
+		index := d.indices[indexID]
+
+		// references can point from a tenant's object to a class without
+		// multi-tenancy, which is then queried without the tenant
+		indexTenant := tenant
+		if !index.Config.MultiTenancy {
+			indexTenant = ""
+		}
+
+		indexRes, err := index.multiObjectByID(ctx, queries, indexTenant)
 		if err != nil {
 			return nil, errors.Wrapf(err, "index %q", indexID)
 		}
@@ -102,12 +112,17 @@ func (d *DB) MultiGet(ctx context.Context,
 // ObjectByID checks every index of the particular kind for the ID
 func (d *DB) ObjectByID(ctx context.Context, id strfmt.UUID,
 	props traverser.SelectProperties,
-	additional traverser.AdditionalProperties) (*search.Result, error) {
+	additional traverser.AdditionalProperties,
+	tenant string) (*search.Result, error) {
 	var result *search.Result
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
 	for _, index := range d.indices {
-		res, err := index.objectByID(ctx, id, props, additional)
+		if !index.servesTenant(tenant) {
+			continue
+		}
+
+		res, err := index.objectByID(ctx, id, props, additional, tenant)
 		if err != nil {
 			return nil, errors.Wrapf(err, "search index %s", index.ID())
 		}
@@ -122,12 +137,13 @@ func (d *DB) ObjectByID(ctx context.Context, id strfmt.UUID,
 		return nil, nil
 	}
 
-	return d.enrichRefsForSingle(ctx, result, props, additional)
+	return d.enrichRefsForSingle(ctx, result, props, additional, tenant)
 }
 
 func (d *DB) enrichRefsForSingle(ctx context.Context, obj *search.Result,
-	props traverser.SelectProperties, additional traverser.AdditionalProperties) (*search.Result, error) {
-	res, err := refcache.NewResolver(refcache.NewCacher(d, d.logger)).
+	props traverser.SelectProperties, additional traverser.AdditionalProperties,
+	tenant string) (*search.Result, error) {
+	res, err := refcache.NewResolver(refcache.NewCacher(d, d.logger, tenant)).
 		Do(ctx, []search.Result{*obj}, props, additional)
 	if err != nil {
 		return nil, errors.Wrap(err, "resolve cross-refs")
@@ -136,11 +152,16 @@ func (d *DB) enrichRefsForSingle(ctx context.Context, obj *search.Result,
 	return &res[0], nil
 }
 
-func (d *DB) Exists(ctx context.Context, id strfmt.UUID) (bool, error) {
+func (d *DB) Exists(ctx context.Context, id strfmt.UUID,
+	tenant string) (bool, error) {
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
 	for _, index := range d.indices {
-		ok, err := index.exists(ctx, id)
+		if !index.servesTenant(tenant) {
+			continue
+		}
+
+		ok, err := index.exists(ctx, id, tenant)
 		if err != nil {
 			return false, errors.Wrapf(err, "search index %s", index.ID())
 		}
@@ -154,7 +175,7 @@ func (d *DB) Exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 
 func (d *DB) AddReference(ctx context.Context,
 	className string, source strfmt.UUID, propName string,
-	ref *models.SingleRef) error {
+	ref *models.SingleRef, tenant string) error {
 	target, err := crossref.ParseSingleRef(ref)
 	if err != nil {
 		return err
@@ -168,9 +189,11 @@ func (d *DB) AddReference(ctx context.Context,
 			objects.BatchReference{
 				From: crossref.NewSource(schema.ClassName(className),
 					schema.PropertyName(propName), source),
-				To: target,
+				To:     target,
+				Tenant: tenant,
 			},
 		},
+		Tenant: tenant,
 	})
 }
 
@@ -0,0 +1,197 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_Analyzers(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	inverted := invertedConfig()
+	inverted.Analyzer = &models.AnalyzerConfig{
+		Stemmer:      models.AnalyzerConfigStemmerEnglish,
+		ASCIIFolding: true,
+		Stopwords: &models.StopwordConfig{
+			Preset: models.StopwordConfigPresetEnglish,
+		},
+	}
+	inverted.PropertyAnalyzers = map[string]models.AnalyzerConfig{
+		"titleDe": {
+			Stemmer: models.AnalyzerConfigStemmerGerman,
+			Stopwords: &models.StopwordConfig{
+				Preset: models.StopwordConfigPresetGerman,
+			},
+		},
+	}
+	class := &models.Class{
+		Class:               "ArticleWithAnalyzers",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: inverted,
+		Properties: []*models.Property{{
+			Name:     "title",
+			DataType: []string{string(schema.DataTypeText)},
+		}, {
+			Name:     "titleDe",
+			DataType: []string{string(schema.DataTypeText)},
+		}, {
+			Name:         "code",
+			DataType:     []string{string(schema.DataTypeText)},
+			Tokenization: models.PropertyTokenizationField,
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	firstID := strfmt.UUID("1c4a5e2b-8f3d-4b6a-9c7e-0d1f2a3b4c5d")
+	secondID := strfmt.UUID("9e8d7c6b-5a4f-4e3d-8c2b-1a0f9e8d7c6b")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objects := []*models.Object{{
+			ID:    firstID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title":   "The runners are running through Zürich",
+				"titleDe": "Die Häuser am See",
+				"code":    "Running",
+			},
+		}, {
+			ID:    secondID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title":   "A walk in the park",
+				"titleDe": "Ein Haus im Wald",
+				"code":    "Walking",
+			},
+		}}
+
+		for _, obj := range objects {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	search := func(t *testing.T, filter *filters.LocalFilter) ([]strfmt.UUID, error) {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids, nil
+	}
+
+	type testCase struct {
+		name        string
+		filter      *filters.LocalFilter
+		expectedIDs []strfmt.UUID
+		expectedErr bool
+	}
+
+	tests := []testCase{
+		{
+			name:        "stemmed terms match other forms of the word",
+			filter:      buildFilter("title", "run", eq, dtText),
+			expectedIDs: []strfmt.UUID{firstID},
+		},
+		{
+			name:        "stopwords are removed from filter values",
+			filter:      buildFilter("title", "the running of the runners", eq, dtText),
+			expectedIDs: []strfmt.UUID{firstID},
+		},
+		{
+			name:        "filter value with only stopwords",
+			filter:      buildFilter("title", "the", eq, dtText),
+			expectedErr: true,
+		},
+		{
+			name:        "accents are folded",
+			filter:      buildFilter("title", "zurich", eq, dtText),
+			expectedIDs: []strfmt.UUID{firstID},
+		},
+		{
+			name:        "like with wildcards",
+			filter:      buildFilter("title", "wal*", like, dtText),
+			expectedIDs: []strfmt.UUID{secondID},
+		},
+		{
+			name:        "property analyzer",
+			filter:      buildFilter("titleDe", "haus", eq, dtText),
+			expectedIDs: []strfmt.UUID{firstID, secondID},
+		},
+		{
+			name:        "field tokenization is not analyzed",
+			filter:      buildFilter("code", "Running", eq, dtText),
+			expectedIDs: []strfmt.UUID{firstID},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ids, err := search(t, test.filter)
+			if test.expectedErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.ElementsMatch(t, test.expectedIDs, ids)
+		})
+	}
+}
@@ -0,0 +1,195 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_ArrayProps(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "ArticleWithTags",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "title",
+			DataType: []string{string(schema.DataTypeString)},
+		}, {
+			Name:     "tags",
+			DataType: []string{string(schema.DataTypeStringArray)},
+		}, {
+			Name:     "scores",
+			DataType: []string{string(schema.DataTypeIntArray)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	firstID := strfmt.UUID("0e3b7a0c-5d42-4a4f-8a3c-39e8e1f4d3a1")
+	secondID := strfmt.UUID("7c3d1c7e-2f5d-4a55-b3bf-1e2f0c9b8a72")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objects := []*models.Object{{
+			ID:    firstID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title":  "first",
+				"tags":   []interface{}{"green", "blue"},
+				"scores": []interface{}{int64(1), int64(5)},
+			},
+		}, {
+			ID:    secondID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title":  "second",
+				"tags":   []interface{}{"green", "red"},
+				"scores": []interface{}{int64(3)},
+			},
+		}}
+
+		for _, obj := range objects {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	t.Run("array props are returned when getting by id", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), firstID,
+			traverser.SelectProperties{}, traverser.AdditionalProperties{}, "")
+		require.Nil(t, err)
+
+		expectedSchema := map[string]interface{}{
+			"title":  "first",
+			"tags":   []interface{}{"green", "blue"},
+			"scores": []interface{}{float64(1), float64(5)},
+			"id":     firstID,
+		}
+		assert.Equal(t, expectedSchema, res.Schema)
+	})
+
+	search := func(t *testing.T, filter *filters.LocalFilter) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids
+	}
+
+	t.Run("filtering on array props", func(t *testing.T) {
+		t.Run("equal matches any element", func(t *testing.T) {
+			ids := search(t, buildFilter("tags", "red", eq, dtString))
+			assert.ElementsMatch(t, []strfmt.UUID{secondID}, ids)
+		})
+
+		t.Run("contains any", func(t *testing.T) {
+			ids := search(t, buildFilter("tags", []interface{}{"blue", "red"},
+				containsAny, dtString))
+			assert.ElementsMatch(t, []strfmt.UUID{firstID, secondID}, ids)
+		})
+
+		t.Run("contains all", func(t *testing.T) {
+			ids := search(t, buildFilter("tags", []interface{}{"green", "blue"},
+				containsAll, dtString))
+			assert.ElementsMatch(t, []strfmt.UUID{firstID}, ids)
+		})
+
+		t.Run("range on int elements", func(t *testing.T) {
+			ids := search(t, buildFilter("scores", 4, gt, dtInt))
+			assert.ElementsMatch(t, []strfmt.UUID{firstID}, ids)
+		})
+
+		t.Run("list value with a non-contains operator", func(t *testing.T) {
+			_, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+				ClassName:  class.Class,
+				Pagination: &filters.Pagination{Limit: 10},
+				Filters:    buildFilter("tags", []interface{}{"green"}, eq, dtString),
+			})
+			assert.NotNil(t, err)
+		})
+	})
+
+	t.Run("aggregating array props", func(t *testing.T) {
+		params := traverser.AggregateParams{
+			ClassName: schema.ClassName(class.Class),
+			Properties: []traverser.AggregateProperty{{
+				Name:        schema.PropertyName("scores"),
+				Aggregators: []traverser.Aggregator{traverser.SumAggregator},
+			}, {
+				Name: schema.PropertyName("tags"),
+				Aggregators: []traverser.Aggregator{
+					traverser.CountAggregator,
+					traverser.NewTopOccurrencesAggregator(ptInt(1)),
+				},
+			}},
+		}
+
+		res, err := repo.Aggregate(context.Background(), params)
+		require.Nil(t, err)
+		require.Len(t, res.Groups, 1)
+
+		assert.Equal(t, float64(9),
+			res.Groups[0].Properties["scores"].NumericalAggregations["sum"])
+		assert.Equal(t, aggregation.Text{
+			Count: 4,
+			Items: []aggregation.TextOccurrence{{Value: "green", Occurs: 2}},
+		}, res.Groups[0].Properties["tags"].TextAggregation)
+	})
+}
@@ -101,7 +101,7 @@ func TestDeleteJourney(t *testing.T) {
 						Value: value,
 					},
 				},
-			}, traverser.AdditionalProperties{})
+			}, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		return extractPropValues(res, "name")
 	}
@@ -130,7 +130,7 @@ func TestDeleteJourney(t *testing.T) {
 		func(t *testing.T) {
 			id := updateTestData()[0].ID
 
-			err := repo.DeleteObject(context.Background(), "UpdateTestClass", id)
+			err := repo.DeleteObject(context.Background(), "UpdateTestClass", id, "")
 			require.Nil(t, err)
 		})
 
@@ -172,7 +172,7 @@ func TestDeleteJourney(t *testing.T) {
 		func(t *testing.T) {
 			id := updateTestData()[1].ID
 
-			err := repo.DeleteObject(context.Background(), "UpdateTestClass", id)
+			err := repo.DeleteObject(context.Background(), "UpdateTestClass", id, "")
 			require.Nil(t, err)
 		})
 
@@ -226,7 +226,7 @@ func TestDeleteJourney(t *testing.T) {
 
 		id := updateTestData()[2].ID
 
-		err = repo.DeleteObject(context.Background(), "UpdateTestClass", id)
+		err = repo.DeleteObject(context.Background(), "UpdateTestClass", id, "")
 		require.Nil(t, err)
 
 		index := repo.GetIndex("UpdateTestClass")
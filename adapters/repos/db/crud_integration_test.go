@@ -107,7 +107,7 @@ func TestCRUD(t *testing.T) {
 	thingID := strfmt.UUID("a0b55b05-bc5b-4cc9-b646-1452d1390a62")
 
 	t.Run("validating that the thing doesn't exist prior", func(t *testing.T) {
-		ok, err := repo.Exists(context.Background(), thingID)
+		ok, err := repo.Exists(context.Background(), thingID, "")
 		require.Nil(t, err)
 		assert.False(t, ok)
 	})
@@ -159,7 +159,7 @@ func TestCRUD(t *testing.T) {
 	})
 
 	t.Run("validating that the thing exists now", func(t *testing.T) {
-		ok, err := repo.Exists(context.Background(), thingID)
+		ok, err := repo.Exists(context.Background(), thingID, "")
 		require.Nil(t, err)
 		assert.True(t, ok)
 	})
@@ -245,7 +245,7 @@ func TestCRUD(t *testing.T) {
 		}
 
 		res, err := repo.ObjectByID(context.Background(), thingID, nil,
-			traverser.AdditionalProperties{})
+			traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 
 		assert.Equal(t, expected, res.ObjectWithVector(false))
@@ -525,7 +525,7 @@ func TestCRUD(t *testing.T) {
 
 	t.Run("searching all things", func(t *testing.T) {
 		// as the test suits grow we might have to extend the limit
-		res, err := repo.ObjectSearch(context.Background(), 100, nil, traverser.AdditionalProperties{})
+		res, err := repo.ObjectSearch(context.Background(), 100, nil, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 
 		item, ok := findID(res, thingID)
@@ -542,7 +542,7 @@ func TestCRUD(t *testing.T) {
 
 	t.Run("searching all things with Vector additional props", func(t *testing.T) {
 		// as the test suits grow we might have to extend the limit
-		res, err := repo.ObjectSearch(context.Background(), 100, nil, traverser.AdditionalProperties{Vector: true})
+		res, err := repo.ObjectSearch(context.Background(), 100, nil, traverser.AdditionalProperties{Vector: true}, "")
 		require.Nil(t, err)
 
 		item, ok := findID(res, thingID)
@@ -565,7 +565,7 @@ func TestCRUD(t *testing.T) {
 				"interpretation": true,
 			},
 		}
-		res, err := repo.ObjectSearch(context.Background(), 100, nil, params)
+		res, err := repo.ObjectSearch(context.Background(), 100, nil, params, "")
 		require.Nil(t, err)
 
 		item, ok := findID(res, thingID)
@@ -597,7 +597,7 @@ func TestCRUD(t *testing.T) {
 	})
 
 	t.Run("searching a thing by ID", func(t *testing.T) {
-		item, err := repo.ObjectByID(context.Background(), thingID, traverser.SelectProperties{}, traverser.AdditionalProperties{})
+		item, err := repo.ObjectByID(context.Background(), thingID, traverser.SelectProperties{}, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		require.NotNil(t, item, "must have a result")
 
@@ -620,7 +620,7 @@ func TestCRUD(t *testing.T) {
 				ClassName: "TheBestThingClass",
 			},
 		}
-		res, err := repo.MultiGet(context.Background(), query, traverser.AdditionalProperties{})
+		res, err := repo.MultiGet(context.Background(), query, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		require.Len(t, res, 2, "length must match even with nil-items")
 
@@ -636,7 +636,7 @@ func TestCRUD(t *testing.T) {
 	})
 
 	t.Run("searching an action by ID without meta", func(t *testing.T) {
-		item, err := repo.ObjectByID(context.Background(), actionID, traverser.SelectProperties{}, traverser.AdditionalProperties{})
+		item, err := repo.ObjectByID(context.Background(), actionID, traverser.SelectProperties{}, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		require.NotNil(t, item, "must have a result")
 
@@ -656,7 +656,7 @@ func TestCRUD(t *testing.T) {
 
 	t.Run("searching an action by ID with Classification and Vector additional properties", func(t *testing.T) {
 		item, err := repo.ObjectByID(context.Background(), actionID, traverser.SelectProperties{},
-			traverser.AdditionalProperties{Classification: true, Vector: true, RefMeta: true})
+			traverser.AdditionalProperties{Classification: true, Vector: true, RefMeta: true}, "")
 		require.Nil(t, err)
 		require.NotNil(t, item, "must have a result")
 
@@ -696,7 +696,7 @@ func TestCRUD(t *testing.T) {
 	})
 
 	t.Run("searching an action by ID with only Vector additional property", func(t *testing.T) {
-		item, err := repo.ObjectByID(context.Background(), actionID, traverser.SelectProperties{}, traverser.AdditionalProperties{Vector: true})
+		item, err := repo.ObjectByID(context.Background(), actionID, traverser.SelectProperties{}, traverser.AdditionalProperties{Vector: true}, "")
 		require.Nil(t, err)
 		require.NotNil(t, item, "must have a result")
 
@@ -708,7 +708,7 @@ func TestCRUD(t *testing.T) {
 	})
 
 	t.Run("searching all actions", func(t *testing.T) {
-		res, err := repo.ObjectSearch(context.Background(), 10, nil, traverser.AdditionalProperties{})
+		res, err := repo.ObjectSearch(context.Background(), 10, nil, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 
 		item, ok := findID(res, actionID)
@@ -770,21 +770,21 @@ func TestCRUD(t *testing.T) {
 
 	t.Run("deleting a thing again", func(t *testing.T) {
 		err := repo.DeleteObject(context.Background(),
-			"TheBestThingClass", thingID)
+			"TheBestThingClass", thingID, "")
 
 		assert.Nil(t, err)
 	})
 
 	t.Run("deleting a action again", func(t *testing.T) {
 		err := repo.DeleteObject(context.Background(),
-			"TheBestActionClass", actionID)
+			"TheBestActionClass", actionID, "")
 
 		assert.Nil(t, err)
 	})
 
 	t.Run("trying to delete from a non-existing class", func(t *testing.T) {
 		err := repo.DeleteObject(context.Background(),
-			"WrongClass", thingID)
+			"WrongClass", thingID, "")
 
 		assert.Equal(t, fmt.Errorf(
 			"delete from non-existing index for WrongClass"), err)
@@ -838,14 +838,14 @@ func TestCRUD(t *testing.T) {
 
 	t.Run("trying to get the deleted thing by ID", func(t *testing.T) {
 		item, err := repo.ObjectByID(context.Background(), thingID,
-			traverser.SelectProperties{}, traverser.AdditionalProperties{})
+			traverser.SelectProperties{}, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		require.Nil(t, item, "must not have a result")
 	})
 
 	t.Run("trying to get the deleted action by ID", func(t *testing.T) {
 		item, err := repo.ObjectByID(context.Background(), actionID,
-			traverser.SelectProperties{}, traverser.AdditionalProperties{})
+			traverser.SelectProperties{}, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		require.Nil(t, item, "must not have a result")
 	})
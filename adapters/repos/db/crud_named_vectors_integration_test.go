@@ -0,0 +1,162 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_NamedVectors(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "ArticleWithNamedVectors",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		NamedVectors:        []string{"title_vector", "body_vector"},
+		Properties: []*models.Property{{
+			Name:     "title",
+			DataType: []string{string(schema.DataTypeString)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	firstID := strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")
+	secondID := strfmt.UUID("1e0bb8a8-d4c4-4b94-8c1f-2ac5e04c1b34")
+
+	t.Run("adding objects with named vectors", func(t *testing.T) {
+		first := &models.Object{
+			ID:    firstID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title": "first",
+			},
+			Vectors: models.C11yVectors{
+				"title_vector": {1, 0, 0},
+				"body_vector":  {0, 0, 1},
+			},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), first, []float32{1, 0}))
+
+		second := &models.Object{
+			ID:    secondID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title": "second",
+			},
+			Vectors: models.C11yVectors{
+				"title_vector": {0, 0, 1},
+			},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), second, []float32{1, 0}))
+	})
+
+	t.Run("named vectors are returned when getting by id", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), firstID,
+			traverser.SelectProperties{}, traverser.AdditionalProperties{}, "")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, models.C11yVectors{
+			"title_vector": {1, 0, 0},
+			"body_vector":  {0, 0, 1},
+		}, res.Vectors)
+	})
+
+	vectorSearch := func(targetVector string, vector []float32) []search.Result {
+		res, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			ClassName:    class.Class,
+			SearchVector: vector,
+			NearVector: &traverser.NearVectorParams{
+				Vector:       vector,
+				TargetVector: targetVector,
+			},
+			Pagination: &filters.Pagination{Limit: 10},
+		})
+		require.Nil(t, err)
+		return res
+	}
+
+	t.Run("searching the title vector", func(t *testing.T) {
+		res := vectorSearch("title_vector", []float32{0, 0, 1})
+		require.Len(t, res, 2)
+		assert.Equal(t, secondID, res[0].ID)
+		assert.Equal(t, firstID, res[1].ID)
+	})
+
+	t.Run("searching the body vector only finds objects which have one", func(t *testing.T) {
+		res := vectorSearch("body_vector", []float32{0, 0, 1})
+		require.Len(t, res, 1)
+		assert.Equal(t, firstID, res[0].ID)
+	})
+
+	t.Run("searching a non-existing named vector", func(t *testing.T) {
+		_, err := repo.VectorClassSearch(context.Background(), traverser.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{0, 0, 1},
+			NearVector: &traverser.NearVectorParams{
+				Vector:       []float32{0, 0, 1},
+				TargetVector: "summary_vector",
+			},
+			Pagination: &filters.Pagination{Limit: 10},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "no named vector \"summary_vector\"")
+	})
+
+	t.Run("deleting an object removes it from the named vector indices", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, firstID, ""))
+
+		res := vectorSearch("body_vector", []float32{0, 0, 1})
+		assert.Len(t, res, 0)
+	})
+}
@@ -0,0 +1,206 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_NestedObjects(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "PersonWithAddresses",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: []string{string(schema.DataTypeString)},
+		}, {
+			Name:     "address",
+			DataType: []string{string(schema.DataTypeObject)},
+			NestedProperties: []*models.NestedProperty{{
+				Name:     "city",
+				DataType: []string{string(schema.DataTypeString)},
+			}, {
+				Name:     "location",
+				DataType: []string{string(schema.DataTypeObject)},
+				NestedProperties: []*models.NestedProperty{{
+					Name:     "floor",
+					DataType: []string{string(schema.DataTypeInt)},
+				}},
+			}},
+		}, {
+			Name:     "previousAddresses",
+			DataType: []string{string(schema.DataTypeObjectArray)},
+			NestedProperties: []*models.NestedProperty{{
+				Name:     "city",
+				DataType: []string{string(schema.DataTypeString)},
+			}},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	aliceID := strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")
+	bobID := strfmt.UUID("5f9b1c8e-3c0a-4cde-9ec2-4d0b5e9b2f21")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objects := []*models.Object{{
+			ID:    aliceID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name": "alice",
+				"address": map[string]interface{}{
+					"city": "Amsterdam",
+					"location": map[string]interface{}{
+						"floor": int64(3),
+					},
+				},
+				"previousAddresses": []interface{}{
+					map[string]interface{}{"city": "Berlin"},
+					map[string]interface{}{"city": "Paris"},
+				},
+			},
+		}, {
+			ID:    bobID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name": "bob",
+				"address": map[string]interface{}{
+					"city": "Berlin",
+					"location": map[string]interface{}{
+						"floor": int64(1),
+					},
+				},
+			},
+		}}
+
+		for _, obj := range objects {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	t.Run("nested objects are returned when getting by id", func(t *testing.T) {
+		res, err := repo.ObjectByID(context.Background(), aliceID,
+			traverser.SelectProperties{}, traverser.AdditionalProperties{}, "")
+		require.Nil(t, err)
+
+		expectedSchema := map[string]interface{}{
+			"name": "alice",
+			"address": map[string]interface{}{
+				"city": "Amsterdam",
+				"location": map[string]interface{}{
+					"floor": float64(3),
+				},
+			},
+			"previousAddresses": []interface{}{
+				map[string]interface{}{"city": "Berlin"},
+				map[string]interface{}{"city": "Paris"},
+			},
+			"id": aliceID,
+		}
+		assert.Equal(t, expectedSchema, res.Schema)
+	})
+
+	search := func(t *testing.T, filter *filters.LocalFilter) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids
+	}
+
+	t.Run("filtering on nested props", func(t *testing.T) {
+		t.Run("on a nested object", func(t *testing.T) {
+			ids := search(t, buildFilter("address.city", "Berlin", eq, dtString))
+			assert.ElementsMatch(t, []strfmt.UUID{bobID}, ids)
+		})
+
+		t.Run("on a deeply nested object", func(t *testing.T) {
+			ids := search(t, buildFilter("address.location.floor", 2, gt, dtInt))
+			assert.ElementsMatch(t, []strfmt.UUID{aliceID}, ids)
+		})
+
+		t.Run("on any of a list of nested objects", func(t *testing.T) {
+			ids := search(t, buildFilter("previousAddresses.city", "Paris", eq, dtString))
+			assert.ElementsMatch(t, []strfmt.UUID{aliceID}, ids)
+		})
+	})
+
+	t.Run("updating the nested object", func(t *testing.T) {
+		obj := &models.Object{
+			ID:    bobID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name": "bob",
+				"address": map[string]interface{}{
+					"city": "Rome",
+				},
+			},
+		}
+		require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+
+		ids := search(t, buildFilter("address.city", "Berlin", eq, dtString))
+		assert.Len(t, ids, 0)
+
+		ids = search(t, buildFilter("address.city", "Rome", eq, dtString))
+		assert.ElementsMatch(t, []strfmt.UUID{bobID}, ids)
+	})
+}
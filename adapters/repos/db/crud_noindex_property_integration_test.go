@@ -95,7 +95,7 @@ func TestCRUD_NoIndexProp(t *testing.T) {
 
 	t.Run("all props are present when getting by id", func(t *testing.T) {
 		res, err := repo.ObjectByID(context.Background(), thingID,
-			traverser.SelectProperties{}, traverser.AdditionalProperties{})
+			traverser.SelectProperties{}, traverser.AdditionalProperties{}, "")
 		expectedSchema := map[string]interface{}{
 			"stringProp":       "some value",
 			"hiddenStringProp": "some hidden value",
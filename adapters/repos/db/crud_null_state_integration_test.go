@@ -0,0 +1,224 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_NullState(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	nullStateConfig := invertedConfig()
+	nullStateConfig.IndexNullState = true
+	class := &models.Class{
+		Class:               "PersonWithNullState",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: nullStateConfig,
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: []string{string(schema.DataTypeString)},
+		}, {
+			Name:     "age",
+			DataType: []string{string(schema.DataTypeInt)},
+		}, {
+			Name:     "nicknames",
+			DataType: []string{string(schema.DataTypeStringArray)},
+		}},
+	}
+	classWithoutNullState := &models.Class{
+		Class:               "PersonWithoutNullState",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: []string{string(schema.DataTypeString)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+	ctx := context.Background()
+
+	t.Run("creating the classes", func(t *testing.T) {
+		require.Nil(t, migrator.AddClass(ctx, class))
+		require.Nil(t, migrator.AddClass(ctx, classWithoutNullState))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class, classWithoutNullState},
+			},
+		}
+	})
+
+	completeID := strfmt.UUID("3f2b6c1a-9d4e-4b7a-8c5d-1e2f3a4b5c6d")
+	partialID := strfmt.UUID("4a3c7d2b-0e5f-4c8b-9d6e-2f3a4b5c6d7e")
+	emptyID := strfmt.UUID("5b4d8e3c-1f6a-4d9c-8e7f-3a4b5c6d7e8f")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objects := []*models.Object{{
+			ID:    completeID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name":      "Jane",
+				"age":       int64(42),
+				"nicknames": []string{"JJ"},
+			},
+		}, {
+			ID:    partialID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name":      "John",
+				"nicknames": []string{},
+			},
+		}, {
+			ID:    emptyID,
+			Class: class.Class,
+		}}
+
+		for _, obj := range objects {
+			require.Nil(t, repo.PutObject(ctx, obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	search := func(className string, filter *filters.LocalFilter) ([]strfmt.UUID, error) {
+		res, err := repo.ClassSearch(ctx, traverser.GetParams{
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids, nil
+	}
+
+	isNull := filters.OperatorIsNull
+
+	type testCase struct {
+		name        string
+		filter      *filters.LocalFilter
+		expectedIDs []strfmt.UUID
+	}
+
+	tests := []testCase{
+		{
+			name:        "prop which is set",
+			filter:      buildFilter("name", false, isNull, dtBool),
+			expectedIDs: []strfmt.UUID{completeID, partialID},
+		},
+		{
+			name:        "prop which is not set",
+			filter:      buildFilter("name", true, isNull, dtBool),
+			expectedIDs: []strfmt.UUID{emptyID},
+		},
+		{
+			name:        "prop which is missing in some objects",
+			filter:      buildFilter("age", true, isNull, dtBool),
+			expectedIDs: []strfmt.UUID{partialID, emptyID},
+		},
+		{
+			name:        "empty lists are null",
+			filter:      buildFilter("nicknames", true, isNull, dtBool),
+			expectedIDs: []strfmt.UUID{partialID, emptyID},
+		},
+		{
+			name: "combined with other filters",
+			filter: compoundFilter(filters.OperatorAnd,
+				buildFilter("name", "John", eq, dtString),
+				buildFilter("age", true, isNull, dtBool)),
+			expectedIDs: []strfmt.UUID{partialID},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ids, err := search(class.Class, test.filter)
+			require.Nil(t, err)
+			assert.ElementsMatch(t, test.expectedIDs, ids)
+		})
+	}
+
+	t.Run("updating an object", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:    partialID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name": "John",
+				"age":  int64(17),
+			},
+		}, []float32{1, 3, 5, 0.4}))
+
+		ids, err := search(class.Class, buildFilter("age", true, isNull, dtBool))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{emptyID}, ids)
+	})
+
+	t.Run("adding a property to existing objects", func(t *testing.T) {
+		prop := &models.Property{
+			Name:     "email",
+			DataType: []string{string(schema.DataTypeString)},
+		}
+		require.Nil(t, migrator.AddProperty(ctx, class.Class, prop))
+		class.Properties = append(class.Properties, prop)
+
+		assert.Eventually(t, func() bool {
+			ids, err := search(class.Class, buildFilter("email", true, isNull, dtBool))
+			return err == nil && len(ids) == 3
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("without a boolean value", func(t *testing.T) {
+		_, err := search(class.Class, buildFilter("name", "Jane", isNull, dtString))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("class without indexNullState", func(t *testing.T) {
+		_, err := search(classWithoutNullState.Class,
+			buildFilter("name", true, isNull, dtBool))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "indexNullState")
+	})
+}
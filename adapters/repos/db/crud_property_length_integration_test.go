@@ -0,0 +1,246 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_PropertyLength(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	propertyLengthConfig := invertedConfig()
+	propertyLengthConfig.IndexPropertyLength = true
+	class := &models.Class{
+		Class:               "ArticleWithPropertyLength",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: propertyLengthConfig,
+		Properties: []*models.Property{{
+			Name:     "title",
+			DataType: []string{string(schema.DataTypeText)},
+		}, {
+			Name:     "tags",
+			DataType: []string{string(schema.DataTypeStringArray)},
+		}, {
+			Name:     "wordCount",
+			DataType: []string{string(schema.DataTypeInt)},
+		}},
+	}
+	classWithoutPropertyLength := &models.Class{
+		Class:               "ArticleWithoutPropertyLength",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "title",
+			DataType: []string{string(schema.DataTypeText)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+	ctx := context.Background()
+
+	t.Run("creating the classes", func(t *testing.T) {
+		require.Nil(t, migrator.AddClass(ctx, class))
+		require.Nil(t, migrator.AddClass(ctx, classWithoutPropertyLength))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class, classWithoutPropertyLength},
+			},
+		}
+	})
+
+	shortID := strfmt.UUID("6c5e9f4d-2a7b-4e0d-9f8a-4b5c6d7e8f9a")
+	longID := strfmt.UUID("7d6f0a5e-3b8c-4f1e-8a9b-5c6d7e8f9a0b")
+	emptyID := strfmt.UUID("8e7a1b6f-4c9d-4a2f-9b0c-6d7e8f9a0b1c")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objects := []*models.Object{{
+			ID:    shortID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title":     "Süß",
+				"tags":      []string{"food"},
+				"wordCount": int64(300),
+			},
+		}, {
+			ID:    longID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title": "A considerably longer title",
+				"tags":  []string{"news", "politics", "europe", "economy"},
+			},
+		}, {
+			ID:    emptyID,
+			Class: class.Class,
+		}}
+
+		for _, obj := range objects {
+			require.Nil(t, repo.PutObject(ctx, obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	search := func(className string, filter *filters.LocalFilter) ([]strfmt.UUID, error) {
+		res, err := repo.ClassSearch(ctx, traverser.GetParams{
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids, nil
+	}
+
+	type testCase struct {
+		name        string
+		filter      *filters.LocalFilter
+		expectedIDs []strfmt.UUID
+	}
+
+	tests := []testCase{
+		{
+			name:        "length of a text equal to",
+			filter:      buildFilter("len(title)", 3, eq, dtInt),
+			expectedIDs: []strfmt.UUID{shortID},
+		},
+		{
+			name:        "length of a text greater than",
+			filter:      buildFilter("len(title)", 10, filters.OperatorGreaterThan, dtInt),
+			expectedIDs: []strfmt.UUID{longID},
+		},
+		{
+			name:        "length of a text less than",
+			filter:      buildFilter("len(title)", 10, filters.OperatorLessThan, dtInt),
+			expectedIDs: []strfmt.UUID{shortID, emptyID},
+		},
+		{
+			name:        "length of an array greater than",
+			filter:      buildFilter("len(tags)", 3, filters.OperatorGreaterThan, dtInt),
+			expectedIDs: []strfmt.UUID{longID},
+		},
+		{
+			name:        "length of an array not equal to",
+			filter:      buildFilter("len(tags)", 1, filters.OperatorNotEqual, dtInt),
+			expectedIDs: []strfmt.UUID{longID, emptyID},
+		},
+		{
+			name:        "unset props have a length of 0",
+			filter:      buildFilter("len(tags)", 0, eq, dtInt),
+			expectedIDs: []strfmt.UUID{emptyID},
+		},
+		{
+			name: "combined with other filters",
+			filter: compoundFilter(filters.OperatorAnd,
+				buildFilter("len(tags)", 1, filters.OperatorGreaterThanEqual, dtInt),
+				buildFilter("wordCount", 100, filters.OperatorGreaterThan, dtInt)),
+			expectedIDs: []strfmt.UUID{shortID},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ids, err := search(class.Class, test.filter)
+			require.Nil(t, err)
+			assert.ElementsMatch(t, test.expectedIDs, ids)
+		})
+	}
+
+	t.Run("updating an object", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:    shortID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title": "Now a considerably longer title",
+			},
+		}, []float32{1, 3, 5, 0.4}))
+
+		ids, err := search(class.Class,
+			buildFilter("len(title)", 10, filters.OperatorGreaterThan, dtInt))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{shortID, longID}, ids)
+
+		ids, err = search(class.Class, buildFilter("len(tags)", 0, eq, dtInt))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{shortID, emptyID}, ids)
+	})
+
+	t.Run("adding a property to existing objects", func(t *testing.T) {
+		prop := &models.Property{
+			Name:     "summary",
+			DataType: []string{string(schema.DataTypeText)},
+		}
+		require.Nil(t, migrator.AddProperty(ctx, class.Class, prop))
+		class.Properties = append(class.Properties, prop)
+
+		assert.Eventually(t, func() bool {
+			ids, err := search(class.Class, buildFilter("len(summary)", 0, eq, dtInt))
+			return err == nil && len(ids) == 3
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("on a prop without a length", func(t *testing.T) {
+		_, err := search(class.Class, buildFilter("len(wordCount)", 0, eq, dtInt))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with an unsupported operator", func(t *testing.T) {
+		_, err := search(class.Class, buildFilter("len(title)", 3, like, dtInt))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("without an int value", func(t *testing.T) {
+		_, err := search(class.Class, buildFilter("len(title)", "3", eq, dtString))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("class without indexPropertyLength", func(t *testing.T) {
+		_, err := search(classWithoutPropertyLength.Class,
+			buildFilter("len(title)", 3, eq, dtInt))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "indexPropertyLength")
+	})
+}
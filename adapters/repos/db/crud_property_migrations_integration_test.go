@@ -0,0 +1,191 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_PropertyMigrations(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "PropertyMigrationClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: []string{string(schema.DataTypeString)},
+		}, {
+			Name:     "count",
+			DataType: []string{string(schema.DataTypeInt)},
+		}, {
+			Name:     "obsolete",
+			DataType: []string{string(schema.DataTypeString)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+	ctx := context.Background()
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t, migrator.AddClass(ctx, class))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	ids := []strfmt.UUID{
+		"9f119c4f-80da-4ae5-bfd1-e4b63054125f",
+		"a2b5bd1c-6b86-4f38-a5a2-d6a9bd0d0b3f",
+		"c5ce6b9e-1c8a-4f3a-8a4b-6b1b7ab5cc2e",
+	}
+
+	t.Run("adding objects", func(t *testing.T) {
+		for i, id := range ids {
+			err := repo.PutObject(ctx, &models.Object{
+				ID:    id,
+				Class: class.Class,
+				Properties: map[string]interface{}{
+					"name":     fmt.Sprintf("Object %d", i),
+					"count":    int64(i),
+					"obsolete": "some value",
+				},
+			}, []float32{1, 2, float32(i)})
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("deleting a property", func(t *testing.T) {
+		require.Nil(t, migrator.DropProperty(ctx, class.Class, "obsolete"))
+		class.Properties = class.Properties[:2]
+
+		shard := repo.GetIndex(schema.ClassName(class.Class)).Shards["single"]
+		assert.Nil(t, shard.store.Bucket(helpers.BucketFromPropNameLSM("obsolete")))
+		assert.Nil(t, shard.store.Bucket(helpers.HashBucketFromPropNameLSM("obsolete")))
+	})
+
+	t.Run("values of the deleted property are stripped", func(t *testing.T) {
+		for _, id := range ids {
+			assert.Eventually(t, func() bool {
+				res, err := repo.ObjectByID(ctx, id, traverser.SelectProperties{},
+					traverser.AdditionalProperties{}, "")
+				require.Nil(t, err)
+				require.NotNil(t, res)
+
+				_, ok := res.Schema.(map[string]interface{})["obsolete"]
+				return !ok
+			}, 5*time.Second, 10*time.Millisecond)
+		}
+	})
+
+	migrate := func(t *testing.T, propName string, dataType schema.DataType) {
+		prop, err := schema.GetPropertyByName(class, propName)
+		require.Nil(t, err)
+		prop.DataType = []string{string(dataType)}
+
+		require.Nil(t, migrator.UpdatePropertyDataType(ctx, class.Class, propName,
+			prop.DataType))
+	}
+
+	search := func(filter *filters.LocalFilter) func() ([]strfmt.UUID, error) {
+		return func() ([]strfmt.UUID, error) {
+			res, err := repo.ClassSearch(ctx, traverser.GetParams{
+				ClassName:  class.Class,
+				Pagination: &filters.Pagination{Limit: 10},
+				Filters:    filter,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			out := make([]strfmt.UUID, len(res))
+			for i := range res {
+				out[i] = res[i].ID
+			}
+			return out, nil
+		}
+	}
+
+	t.Run("migrating int to number", func(t *testing.T) {
+		migrate(t, "count", schema.DataTypeNumber)
+
+		assert.Eventually(t, func() bool {
+			res, err := search(buildFilter("count", 2.0, eq, dtNumber))()
+			return err == nil && len(res) == 1 && res[0] == ids[2]
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("migrating string to text", func(t *testing.T) {
+		migrate(t, "name", schema.DataTypeText)
+
+		assert.Eventually(t, func() bool {
+			res, err := search(buildFilter("name", "object", eq, dtText))()
+			return err == nil && len(res) == 3
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+
+	t.Run("new writes are indexed with the new data type", func(t *testing.T) {
+		id := strfmt.UUID("d6c6c1b0-3a2c-4a55-a4a4-1e3dd9d6b6a1")
+		err := repo.PutObject(ctx, &models.Object{
+			ID:    id,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name":  "Another Object",
+				"count": 7.5,
+			},
+		}, []float32{1, 2, 3})
+		require.Nil(t, err)
+
+		res, err := search(buildFilter("count", 7.5, eq, dtNumber))()
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{id}, res)
+	})
+
+	t.Run("rejecting an unexpected data type", func(t *testing.T) {
+		err := migrator.UpdatePropertyDataType(ctx, class.Class, "count",
+			[]string{string(schema.DataTypeText)})
+		assert.NotNil(t, err)
+	})
+}
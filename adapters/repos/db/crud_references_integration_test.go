@@ -255,7 +255,7 @@ func TestNestedReferences(t *testing.T) {
 		}
 
 		res, err := repo.ObjectByID(context.Background(), "4ef47fb0-3cf5-44fc-b378-9e217dff13ac",
-			fullyNestedSelectProperties(), traverser.AdditionalProperties{})
+			fullyNestedSelectProperties(), traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		assert.Equal(t, expectedSchema, res.Schema)
 	})
@@ -289,14 +289,14 @@ func TestNestedReferences(t *testing.T) {
 		}
 
 		res, err := repo.ObjectByID(context.Background(), "4ef47fb0-3cf5-44fc-b378-9e217dff13ac",
-			partiallyNestedSelectProperties(), traverser.AdditionalProperties{})
+			partiallyNestedSelectProperties(), traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		assert.Equal(t, expectedSchema, res.Schema)
 	})
 
 	t.Run("resolving without any refs", func(t *testing.T) {
 		res, err := repo.ObjectByID(context.Background(), "4ef47fb0-3cf5-44fc-b378-9e217dff13ac",
-			traverser.SelectProperties{}, traverser.AdditionalProperties{})
+			traverser.SelectProperties{}, traverser.AdditionalProperties{}, "")
 
 		expectedSchema := map[string]interface{}{
 			"id": strfmt.UUID("4ef47fb0-3cf5-44fc-b378-9e217dff13ac"),
@@ -510,13 +510,13 @@ func Test_AddingReferenceOneByOne(t *testing.T) {
 		err := repo.AddReference(context.Background(),
 			"AddingReferencesTestSource", sourceID, "toTarget", &models.SingleRef{
 				Beacon: strfmt.URI(fmt.Sprintf("weaviate://localhost/%s", targetID)),
-			})
+			}, "")
 		assert.Nil(t, err)
 	})
 
 	t.Run("check reference was added", func(t *testing.T) {
 		source, err := repo.ObjectByID(context.Background(), sourceID, nil,
-			traverser.AdditionalProperties{})
+			traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		require.NotNil(t, source)
 		require.NotNil(t, source.Object())
@@ -542,13 +542,13 @@ func Test_AddingReferenceOneByOne(t *testing.T) {
 		err := repo.AddReference(context.Background(),
 			"AddingReferencesTestSource", sourceID, "toTarget", &models.SingleRef{
 				Beacon: strfmt.URI(fmt.Sprintf("weaviate://localhost/%s", target2ID)),
-			})
+			}, "")
 		assert.Nil(t, err)
 	})
 
 	t.Run("check both references are now present", func(t *testing.T) {
 		source, err := repo.ObjectByID(context.Background(), sourceID, nil,
-			traverser.AdditionalProperties{})
+			traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		require.NotNil(t, source)
 		require.NotNil(t, source.Object())
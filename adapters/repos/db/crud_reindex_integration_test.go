@@ -0,0 +1,271 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/schema/migrate"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_Reindex(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	indexInverted := false
+	class := &models.Class{
+		Class:               "ReindexClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: []string{string(schema.DataTypeString)},
+		}, {
+			Name:          "count",
+			DataType:      []string{string(schema.DataTypeInt)},
+			IndexInverted: &indexInverted,
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	defer repo.Shutdown(context.Background())
+	migrator := NewMigrator(repo, logger)
+	ctx := context.Background()
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t, migrator.AddClass(ctx, class))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	objectCount := 250
+	ids := make([]strfmt.UUID, objectCount)
+
+	t.Run("adding objects", func(t *testing.T) {
+		for i := range ids {
+			ids[i] = strfmt.UUID(uuid.New().String())
+			err := repo.PutObject(ctx, &models.Object{
+				ID:    ids[i],
+				Class: class.Class,
+				Properties: map[string]interface{}{
+					"name":  fmt.Sprintf("object-%d", i),
+					"count": int64(i % 10),
+				},
+			}, []float32{1, 2, float32(i)})
+			require.Nil(t, err)
+		}
+	})
+
+	t.Run("updating some objects", func(t *testing.T) {
+		// updates assign new doc ids, the reindex job must only consider the
+		// current version of each object
+		for i := 0; i < 10; i++ {
+			err := repo.PutObject(ctx, &models.Object{
+				ID:    ids[i],
+				Class: class.Class,
+				Properties: map[string]interface{}{
+					"name":  fmt.Sprintf("object-%d", i),
+					"count": int64(100),
+				},
+			}, []float32{1, 2, float32(i)})
+			require.Nil(t, err)
+		}
+	})
+
+	search := func(filter *filters.LocalFilter) ([]strfmt.UUID, error) {
+		res, err := repo.ClassSearch(ctx, traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 1000},
+			Filters:    filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		out := make([]strfmt.UUID, len(res))
+		for i := range res {
+			out[i] = res[i].ID
+		}
+		return out, nil
+	}
+
+	reindex := func(t *testing.T, params migrate.ReindexParams,
+		apply func()) {
+		require.Nil(t, migrator.Reindex(ctx, class.Class, params, func() error {
+			apply()
+			return nil
+		}))
+	}
+
+	t.Run("filtering on the unindexed property fails", func(t *testing.T) {
+		_, err := search(buildFilter("count", 3, eq, dtInt))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("building the inverted index of the property", func(t *testing.T) {
+		indexed := true
+		prop := *class.Properties[1]
+		prop.IndexInverted = &indexed
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		concurrentID := strfmt.UUID("a8e2c1d0-6f4b-4c2e-9d1a-3b5e7f9a1c2d")
+		go func() {
+			defer wg.Done()
+			// a concurrent write while the job is running must end up in the
+			// rebuilt index
+			err := repo.PutObject(ctx, &models.Object{
+				ID:    concurrentID,
+				Class: class.Class,
+				Properties: map[string]interface{}{
+					"name":  "concurrent",
+					"count": int64(3),
+				},
+			}, []float32{1, 2, 3})
+			assert.Nil(t, err)
+		}()
+
+		reindex(t, migrate.ReindexParams{
+			Properties: []*models.Property{&prop},
+		}, func() {
+			class.Properties[1].IndexInverted = &indexed
+		})
+		wg.Wait()
+
+		res, err := search(buildFilter("count", 3, eq, dtInt))
+		require.Nil(t, err)
+		// one of the matching objects was updated, the concurrent write adds one
+		assert.Len(t, res, objectCount/10)
+		assert.Contains(t, res, concurrentID)
+
+		res, err = search(buildFilter("count", 100, eq, dtInt))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, ids[:10], res)
+
+		res, err = search(buildFilter("count", 0, eq, dtInt))
+		require.Nil(t, err)
+		assert.Len(t, res, objectCount/10-1)
+	})
+
+	t.Run("writes after the job use the rebuilt index", func(t *testing.T) {
+		id := strfmt.UUID("c3d4e5f6-0a1b-4c2d-8e3f-4a5b6c7d8e9f")
+		err := repo.PutObject(ctx, &models.Object{
+			ID:    id,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"name":  "after",
+				"count": int64(42),
+			},
+		}, []float32{1, 2, 3})
+		require.Nil(t, err)
+
+		res, err := search(buildFilter("count", 42, eq, dtInt))
+		require.Nil(t, err)
+		assert.Equal(t, []strfmt.UUID{id}, res)
+	})
+
+	t.Run("removing the inverted index of a property", func(t *testing.T) {
+		disabled := false
+		prop := *class.Properties[0]
+		prop.IndexInverted = &disabled
+
+		reindex(t, migrate.ReindexParams{
+			Properties: []*models.Property{&prop},
+		}, func() {
+			class.Properties[0].IndexInverted = &disabled
+		})
+
+		shard := repo.GetIndex(schema.ClassName(class.Class)).Shards["single"]
+		assert.Nil(t, shard.store.Bucket(helpers.BucketFromPropNameLSM("name")))
+		assert.Nil(t, shard.store.Bucket(helpers.HashBucketFromPropNameLSM("name")))
+	})
+
+	t.Run("rebuilding the vector index with a new config", func(t *testing.T) {
+		vectorIndexConfig := hnsw.NewDefaultUserConfig()
+		vectorIndexConfig.MaxConnections = 8
+		vectorIndexConfig.EFConstruction = 64
+
+		reindex(t, migrate.ReindexParams{
+			VectorIndexConfig: vectorIndexConfig,
+		}, func() {
+			class.VectorIndexConfig = vectorIndexConfig
+		})
+
+		res, err := repo.VectorClassSearch(ctx, traverser.GetParams{
+			ClassName:    class.Class,
+			SearchVector: []float32{1, 2, 17},
+			Pagination:   &filters.Pagination{Limit: 1},
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, ids[17], res[0].ID)
+
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		assert.Equal(t, vectorIndexConfig, idx.vectorIndexUserConfig)
+	})
+
+	t.Run("a second job on the same class is rejected", func(t *testing.T) {
+		committing := make(chan struct{})
+		blocked := make(chan struct{})
+		done := make(chan error)
+		go func() {
+			done <- migrator.Reindex(ctx, class.Class, migrate.ReindexParams{
+				VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+			}, func() error {
+				close(committing)
+				<-blocked
+				return nil
+			})
+		}()
+
+		<-committing
+		err := migrator.Reindex(ctx, class.Class, migrate.ReindexParams{
+			VectorIndexConfig: hnsw.NewDefaultUserConfig(),
+		}, func() error { return nil })
+		assert.NotNil(t, err)
+
+		close(blocked)
+		assert.Nil(t, <-done)
+	})
+}
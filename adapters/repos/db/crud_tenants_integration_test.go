@@ -0,0 +1,220 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_MultiTenancy(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "MultiTenantClass",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		MultiTenancyConfig:  &models.MultiTenancyConfig{Enabled: true},
+		Properties: []*models.Property{{
+			Name:     "name",
+			DataType: []string{string(schema.DataTypeString)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+	ctx := context.Background()
+
+	t.Run("creating the class and tenants", func(t *testing.T) {
+		require.Nil(t, migrator.AddClass(ctx, class))
+
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+
+		require.Nil(t, migrator.NewTenants(ctx, class.Class, []*models.Tenant{
+			{Name: "tenantA", ActivityStatus: models.TenantActivityStatusACTIVE},
+			{Name: "tenantB", ActivityStatus: models.TenantActivityStatusACTIVE},
+		}))
+	})
+
+	idA := strfmt.UUID("9f119c4f-80da-4ae5-bfd1-e4b63054125f")
+	idB := strfmt.UUID("a2b5bd1c-6b86-4f38-a5a2-d6a9bd0d0b3f")
+
+	t.Run("adding objects to each tenant", func(t *testing.T) {
+		err := repo.PutObject(ctx, &models.Object{
+			ID:         idA,
+			Class:      class.Class,
+			Tenant:     "tenantA",
+			Properties: map[string]interface{}{"name": "object of tenant a"},
+		}, []float32{1, 2, 3})
+		require.Nil(t, err)
+
+		err = repo.PutObject(ctx, &models.Object{
+			ID:         idB,
+			Class:      class.Class,
+			Tenant:     "tenantB",
+			Properties: map[string]interface{}{"name": "object of tenant b"},
+		}, []float32{3, 2, 1})
+		require.Nil(t, err)
+	})
+
+	t.Run("adding an object without a tenant", func(t *testing.T) {
+		err := repo.PutObject(ctx, &models.Object{
+			ID:    strfmt.UUID("c5ce6b9e-1c8a-4f3a-8a4b-6b1b7ab5cc2e"),
+			Class: class.Class,
+		}, []float32{1, 1, 1})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("objects are only visible to their own tenant", func(t *testing.T) {
+		res, err := repo.ObjectByID(ctx, idA, traverser.SelectProperties{},
+			traverser.AdditionalProperties{}, "tenantA")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "tenantA", res.Tenant)
+
+		res, err = repo.ObjectByID(ctx, idA, traverser.SelectProperties{},
+			traverser.AdditionalProperties{}, "tenantB")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
+		exists, err := repo.Exists(ctx, idB, "tenantA")
+		require.Nil(t, err)
+		assert.False(t, exists)
+
+		exists, err = repo.Exists(ctx, idB, "tenantB")
+		require.Nil(t, err)
+		assert.True(t, exists)
+	})
+
+	t.Run("class search is scoped to the tenant", func(t *testing.T) {
+		res, err := repo.ClassSearch(ctx, traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Tenant:     "tenantB",
+		})
+		require.Nil(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, idB, res[0].ID)
+
+		_, err = repo.ClassSearch(ctx, traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Tenant:     "tenantC",
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("deactivating a tenant unloads its shard", func(t *testing.T) {
+		require.Nil(t, migrator.UpdateTenants(ctx, class.Class, []*models.Tenant{
+			{Name: "tenantA", ActivityStatus: models.TenantActivityStatusINACTIVE},
+		}))
+
+		res, err := repo.ObjectByID(ctx, idA, traverser.SelectProperties{},
+			traverser.AdditionalProperties{}, "tenantA")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
+		_, err = repo.ClassSearch(ctx, traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Tenant:     "tenantA",
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `tenant "tenantA" is not active`)
+	})
+
+	t.Run("reactivating a tenant restores its data", func(t *testing.T) {
+		require.Nil(t, migrator.UpdateTenants(ctx, class.Class, []*models.Tenant{
+			{Name: "tenantA", ActivityStatus: models.TenantActivityStatusACTIVE},
+		}))
+
+		res, err := repo.ObjectByID(ctx, idA, traverser.SelectProperties{},
+			traverser.AdditionalProperties{}, "tenantA")
+		require.Nil(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, idA, res.ID)
+	})
+
+	t.Run("unloading a tenant waits for running requests", func(t *testing.T) {
+		idx := repo.GetIndex(schema.ClassName(class.Class))
+		require.NotNil(t, idx)
+		_, release, err := idx.shardForTenant("tenantB")
+		require.Nil(t, err)
+
+		done := make(chan error)
+		go func() {
+			done <- migrator.UpdateTenants(ctx, class.Class, []*models.Tenant{
+				{Name: "tenantB", ActivityStatus: models.TenantActivityStatusINACTIVE},
+			})
+		}()
+
+		select {
+		case <-done:
+			t.Fatal("tenant was unloaded while its shard was in use")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		release()
+		require.Nil(t, <-done)
+
+		require.Nil(t, migrator.UpdateTenants(ctx, class.Class, []*models.Tenant{
+			{Name: "tenantB", ActivityStatus: models.TenantActivityStatusACTIVE},
+		}))
+	})
+
+	t.Run("deleting a tenant removes its data", func(t *testing.T) {
+		require.Nil(t, migrator.DeleteTenants(ctx, class.Class, []string{"tenantB"}))
+
+		res, err := repo.ObjectByID(ctx, idB, traverser.SelectProperties{},
+			traverser.AdditionalProperties{}, "tenantB")
+		require.Nil(t, err)
+		assert.Nil(t, res)
+
+		_, err = repo.ClassSearch(ctx, traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Tenant:     "tenantB",
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), `tenant "tenantB" not found`)
+	})
+}
@@ -0,0 +1,228 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_Timestamps(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	timestampsConfig := invertedConfig()
+	timestampsConfig.IndexTimestamps = true
+	class := &models.Class{
+		Class:               "DocumentWithTimestamps",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: timestampsConfig,
+		Properties: []*models.Property{{
+			Name:     "title",
+			DataType: []string{string(schema.DataTypeString)},
+		}},
+	}
+	classWithoutTimestamps := &models.Class{
+		Class:               "DocumentWithoutTimestamps",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:     "title",
+			DataType: []string{string(schema.DataTypeString)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+	ctx := context.Background()
+
+	t.Run("creating the classes", func(t *testing.T) {
+		require.Nil(t, migrator.AddClass(ctx, class))
+		require.Nil(t, migrator.AddClass(ctx, classWithoutTimestamps))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class, classWithoutTimestamps},
+			},
+		}
+	})
+
+	// 2020-09-13T12:26:40Z, 2023-11-14T22:13:20Z and 2025-05-14T06:40:00Z in
+	// milliseconds since the epoch
+	early := int64(1600000000000)
+	middle := int64(1700000000000)
+	late := int64(1747204800000)
+
+	unchangedID := strfmt.UUID("9f8b2c7a-5d0e-4b3a-8c1d-7e8f9a0b1c2d")
+	updatedID := strfmt.UUID("a09c3d8b-6e1f-4c4b-9d2e-8f9a0b1c2d3e")
+	recentID := strfmt.UUID("b1ad4e9c-7f2a-4d5c-8e3f-9a0b1c2d3e4f")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objects := []*models.Object{{
+			ID:                 unchangedID,
+			Class:              class.Class,
+			CreationTimeUnix:   early,
+			LastUpdateTimeUnix: early,
+			Properties:         map[string]interface{}{"title": "unchanged"},
+		}, {
+			ID:                 updatedID,
+			Class:              class.Class,
+			CreationTimeUnix:   early,
+			LastUpdateTimeUnix: middle,
+			Properties:         map[string]interface{}{"title": "updated"},
+		}, {
+			ID:                 recentID,
+			Class:              class.Class,
+			CreationTimeUnix:   middle,
+			LastUpdateTimeUnix: middle,
+			Properties:         map[string]interface{}{"title": "recent"},
+		}}
+
+		for _, obj := range objects {
+			require.Nil(t, repo.PutObject(ctx, obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	search := func(className string, filter *filters.LocalFilter) ([]strfmt.UUID, error) {
+		res, err := repo.ClassSearch(ctx, traverser.GetParams{
+			ClassName:  className,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids, nil
+	}
+
+	created := filters.InternalPropCreationTimeUnix
+	lastUpdated := filters.InternalPropLastUpdateTimeUnix
+
+	type testCase struct {
+		name        string
+		filter      *filters.LocalFilter
+		expectedIDs []strfmt.UUID
+	}
+
+	tests := []testCase{
+		{
+			name:        "creation time equal to an int",
+			filter:      buildFilter(created, int(early), eq, dtInt),
+			expectedIDs: []strfmt.UUID{unchangedID, updatedID},
+		},
+		{
+			name:        "creation time greater than a string",
+			filter:      buildFilter(created, fmt.Sprint(early), gt, dtString),
+			expectedIDs: []strfmt.UUID{recentID},
+		},
+		{
+			name:        "last update time greater than or equal to an int",
+			filter:      buildFilter(lastUpdated, int(middle), gte, dtInt),
+			expectedIDs: []strfmt.UUID{updatedID, recentID},
+		},
+		{
+			name: "last update time less than a date",
+			filter: buildFilter(lastUpdated, mustParseTime("2021-01-01T00:00:00Z"),
+				lt, dtDate),
+			expectedIDs: []strfmt.UUID{unchangedID},
+		},
+		{
+			name: "changed since with other filters",
+			filter: compoundFilter(filters.OperatorAnd,
+				buildFilter(lastUpdated, int(middle), gte, dtInt),
+				buildFilter(created, int(early), eq, dtInt)),
+			expectedIDs: []strfmt.UUID{updatedID},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ids, err := search(class.Class, test.filter)
+			require.Nil(t, err)
+			assert.ElementsMatch(t, test.expectedIDs, ids)
+		})
+	}
+
+	t.Run("updating an object", func(t *testing.T) {
+		require.Nil(t, repo.PutObject(ctx, &models.Object{
+			ID:                 updatedID,
+			Class:              class.Class,
+			CreationTimeUnix:   early,
+			LastUpdateTimeUnix: late,
+			Properties:         map[string]interface{}{"title": "updated again"},
+		}, []float32{1, 3, 5, 0.4}))
+
+		ids, err := search(class.Class, buildFilter(lastUpdated, int(middle), eq, dtInt))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{recentID}, ids)
+
+		ids, err = search(class.Class, buildFilter(lastUpdated, int(middle), gt, dtInt))
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []strfmt.UUID{updatedID}, ids)
+	})
+
+	t.Run("deleting an object", func(t *testing.T) {
+		require.Nil(t, repo.DeleteObject(ctx, class.Class, recentID, ""))
+
+		ids, err := search(class.Class, buildFilter(created, int(middle), eq, dtInt))
+		require.Nil(t, err)
+		assert.Len(t, ids, 0)
+	})
+
+	t.Run("with an invalid string value", func(t *testing.T) {
+		_, err := search(class.Class, buildFilter(created, "yesterday", gt, dtString))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with an unsupported operator", func(t *testing.T) {
+		_, err := search(class.Class, buildFilter(created, "16*", like, dtString))
+		assert.NotNil(t, err)
+	})
+
+	t.Run("class without indexTimestamps", func(t *testing.T) {
+		_, err := search(classWithoutTimestamps.Class,
+			buildFilter(created, int(early), gt, dtInt))
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "indexTimestamps")
+	})
+}
@@ -0,0 +1,193 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_Tokenization(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "BookWithTokenization",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:         "title",
+			DataType:     []string{string(schema.DataTypeText)},
+			Tokenization: models.PropertyTokenizationField,
+		}, {
+			Name:         "author",
+			DataType:     []string{string(schema.DataTypeString)},
+			Tokenization: models.PropertyTokenizationLowercase,
+		}, {
+			Name:         "description",
+			DataType:     []string{string(schema.DataTypeText)},
+			Tokenization: models.PropertyTokenizationTrigram,
+		}, {
+			Name:     "summary",
+			DataType: []string{string(schema.DataTypeText)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	firstID := strfmt.UUID("5b9c7f1e-3c1a-4d0e-9a4f-6a1c2b3d4e5f")
+	secondID := strfmt.UUID("8d2e4f6a-7b1c-4e3d-8f5a-9b0c1d2e3f4a")
+
+	t.Run("adding objects", func(t *testing.T) {
+		objects := []*models.Object{{
+			ID:    firstID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title":       "The Lord of the Rings",
+				"author":      "J.R.R. Tolkien",
+				"description": "A hobbit carries a ring to Mordor",
+				"summary":     "The Lord of the Rings is an epic",
+			},
+		}, {
+			ID:    secondID,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"title":       "The Lord of the Flies",
+				"author":      "William Golding",
+				"description": "Boys stranded on an island",
+				"summary":     "The Lord of the Flies is a novel",
+			},
+		}}
+
+		for _, obj := range objects {
+			require.Nil(t, repo.PutObject(context.Background(), obj, []float32{1, 3, 5, 0.4}))
+		}
+	})
+
+	search := func(t *testing.T, filter *filters.LocalFilter) ([]strfmt.UUID, error) {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids, nil
+	}
+
+	type testCase struct {
+		name        string
+		filter      *filters.LocalFilter
+		expectedIDs []strfmt.UUID
+		expectedErr bool
+	}
+
+	tests := []testCase{
+		{
+			name:        "field tokenization matches the whole value",
+			filter:      buildFilter("title", "The Lord of the Rings", eq, dtText),
+			expectedIDs: []strfmt.UUID{firstID},
+		},
+		{
+			name:        "field tokenization does not match a single word",
+			filter:      buildFilter("title", "Lord", eq, dtText),
+			expectedIDs: []strfmt.UUID{},
+		},
+		{
+			name:        "field tokenization is case-sensitive",
+			filter:      buildFilter("title", "the lord of the rings", eq, dtText),
+			expectedIDs: []strfmt.UUID{},
+		},
+		{
+			name:        "field tokenization with like",
+			filter:      buildFilter("title", "The Lord of the *", like, dtText),
+			expectedIDs: []strfmt.UUID{firstID, secondID},
+		},
+		{
+			name:        "lowercase tokenization is case-insensitive",
+			filter:      buildFilter("author", "j.r.r. TOLKIEN", eq, dtString),
+			expectedIDs: []strfmt.UUID{firstID},
+		},
+		{
+			name:        "trigram tokenization matches substrings",
+			filter:      buildFilter("description", "Mordo", eq, dtText),
+			expectedIDs: []strfmt.UUID{firstID},
+		},
+		{
+			name:        "trigram tokenization does not support like",
+			filter:      buildFilter("description", "Mor*", like, dtText),
+			expectedErr: true,
+		},
+		{
+			name:        "default word tokenization matches a single word",
+			filter:      buildFilter("summary", "epic", eq, dtText),
+			expectedIDs: []strfmt.UUID{firstID},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ids, err := search(t, test.filter)
+			if test.expectedErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.ElementsMatch(t, test.expectedIDs, ids)
+		})
+	}
+}
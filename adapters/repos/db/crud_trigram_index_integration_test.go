@@ -0,0 +1,214 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// +build integrationTest
+
+package db
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/traverser"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCRUD_TrigramIndex(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	logger, _ := test.NewNullLogger()
+	class := &models.Class{
+		Class:               "ArticleWithTrigrams",
+		VectorIndexConfig:   hnsw.NewDefaultUserConfig(),
+		InvertedIndexConfig: invertedConfig(),
+		Properties: []*models.Property{{
+			Name:          "headline",
+			DataType:      []string{string(schema.DataTypeText)},
+			IndexTrigrams: true,
+		}, {
+			Name:          "tags",
+			DataType:      []string{string(schema.DataTypeStringArray)},
+			IndexTrigrams: true,
+		}, {
+			Name:     "body",
+			DataType: []string{string(schema.DataTypeText)},
+		}},
+	}
+	schemaGetter := &fakeSchemaGetter{}
+	repo := New(logger, Config{RootPath: dirName})
+	repo.SetSchemaGetter(schemaGetter)
+	err := repo.WaitForStartup(testCtx())
+	require.Nil(t, err)
+	migrator := NewMigrator(repo, logger)
+
+	t.Run("creating the class", func(t *testing.T) {
+		require.Nil(t,
+			migrator.AddClass(context.Background(), class))
+
+		// update schema getter so it's in sync with class
+		schemaGetter.schema = schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{class},
+			},
+		}
+	})
+
+	t.Run("only props with indexTrigrams have a trigram bucket", func(t *testing.T) {
+		shard := repo.GetIndex(schema.ClassName(class.Class)).Shards["single"]
+		assert.NotNil(t, shard.store.Bucket(
+			helpers.BucketFromPropNameLSM(helpers.TrigramProp("headline"))))
+		assert.NotNil(t, shard.store.Bucket(
+			helpers.BucketFromPropNameLSM(helpers.TrigramProp("tags"))))
+		assert.Nil(t, shard.store.Bucket(
+			helpers.BucketFromPropNameLSM(helpers.TrigramProp("body"))))
+	})
+
+	firstID := strfmt.UUID("0b4c3a2e-5d6f-4a1b-9c8d-7e6f5a4b3c2d")
+	secondID := strfmt.UUID("1c5d4b3f-6e7a-4b2c-8d9e-8f7a6b5c4d3e")
+	thirdID := strfmt.UUID("2d6e5c4a-7f8b-4c3d-9e0f-9a8b7c6d5e4f")
+
+	put := func(t *testing.T, id strfmt.UUID, headline string, tags []string) {
+		require.Nil(t, repo.PutObject(context.Background(), &models.Object{
+			ID:    id,
+			Class: class.Class,
+			Properties: map[string]interface{}{
+				"headline": headline,
+				"tags":     tags,
+				"body":     headline,
+			},
+		}, []float32{1, 3, 5, 0.4}))
+	}
+
+	t.Run("adding objects", func(t *testing.T) {
+		put(t, firstID, "A sunny day in the mountains", []string{"Weather", "Outdoors"})
+		put(t, secondID, "Running into the sunset", []string{"Sports", "Outdoors"})
+		put(t, thirdID, "Funny stories from the office", []string{"Humor"})
+	})
+
+	search := func(t *testing.T, filter *filters.LocalFilter) []strfmt.UUID {
+		res, err := repo.ClassSearch(context.Background(), traverser.GetParams{
+			ClassName:  class.Class,
+			Pagination: &filters.Pagination{Limit: 10},
+			Filters:    filter,
+		})
+		require.Nil(t, err)
+
+		ids := make([]strfmt.UUID, len(res))
+		for i, obj := range res {
+			ids[i] = obj.ID
+		}
+		return ids
+	}
+
+	type testCase struct {
+		name        string
+		prop        string
+		value       string
+		dataType    schema.DataType
+		expectedIDs []strfmt.UUID
+	}
+
+	tests := []testCase{
+		{
+			name:        "leading and trailing wildcard",
+			prop:        "headline",
+			value:       "*unn*",
+			dataType:    dtText,
+			expectedIDs: []strfmt.UUID{firstID, secondID, thirdID},
+		},
+		{
+			name:        "leading wildcard only",
+			prop:        "headline",
+			value:       "*nny",
+			dataType:    dtText,
+			expectedIDs: []strfmt.UUID{firstID, thirdID},
+		},
+		{
+			name:        "terms contain all trigrams, but don't match the pattern",
+			prop:        "headline",
+			value:       "*set*sun*",
+			dataType:    dtText,
+			expectedIDs: []strfmt.UUID{},
+		},
+		{
+			name:        "several literals",
+			prop:        "headline",
+			value:       "*un?ing",
+			dataType:    dtText,
+			expectedIDs: []strfmt.UUID{secondID},
+		},
+		{
+			name:        "no term contains the trigrams",
+			prop:        "headline",
+			value:       "*xyz*",
+			dataType:    dtText,
+			expectedIDs: []strfmt.UUID{},
+		},
+		{
+			name:        "literals too short for a trigram",
+			prop:        "headline",
+			value:       "*ou*",
+			dataType:    dtText,
+			expectedIDs: []strfmt.UUID{firstID},
+		},
+		{
+			name:        "array prop",
+			prop:        "tags",
+			value:       "*door*",
+			dataType:    dtString,
+			expectedIDs: []strfmt.UUID{firstID, secondID},
+		},
+		{
+			name:        "prop without trigram index",
+			prop:        "body",
+			value:       "*unn*",
+			dataType:    dtText,
+			expectedIDs: []strfmt.UUID{firstID, secondID, thirdID},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ids := search(t, buildFilter(test.prop, test.value, like, test.dataType))
+			assert.ElementsMatch(t, test.expectedIDs, ids)
+		})
+	}
+
+	t.Run("updating and deleting objects", func(t *testing.T) {
+		put(t, secondID, "Walking into the sunset", []string{"Sports"})
+		require.Nil(t, repo.DeleteObject(context.Background(), class.Class, thirdID, ""))
+
+		assert.ElementsMatch(t, []strfmt.UUID{firstID},
+			search(t, buildFilter("headline", "*unn*", like, dtText)))
+		assert.ElementsMatch(t, []strfmt.UUID{secondID},
+			search(t, buildFilter("headline", "*alk*", like, dtText)))
+		assert.ElementsMatch(t, []strfmt.UUID{firstID},
+			search(t, buildFilter("tags", "*door*", like, dtString)))
+	})
+}
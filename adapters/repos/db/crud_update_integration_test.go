@@ -110,7 +110,7 @@ func TestUpdateJourney(t *testing.T) {
 						Value: value,
 					},
 				},
-			}, traverser.AdditionalProperties{})
+			}, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		return extractPropValues(res, "name")
 	}
@@ -142,7 +142,7 @@ func TestUpdateJourney(t *testing.T) {
 			id := updateTestData()[0].ID
 
 			old, err := repo.ObjectByID(context.Background(), id, traverser.SelectProperties{},
-				traverser.AdditionalProperties{})
+				traverser.AdditionalProperties{}, "")
 			require.Nil(t, err)
 
 			err = repo.PutObject(context.Background(), old.Object(), updatedVec)
@@ -195,7 +195,7 @@ func TestUpdateJourney(t *testing.T) {
 			id := updateTestData()[2].ID
 
 			old, err := repo.ObjectByID(context.Background(), id, traverser.SelectProperties{},
-				traverser.AdditionalProperties{})
+				traverser.AdditionalProperties{}, "")
 			require.Nil(t, err)
 
 			old.Schema.(map[string]interface{})["intProp"] = int64(21)
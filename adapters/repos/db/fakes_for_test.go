@@ -13,12 +13,20 @@
 
 package db
 
-import "github.com/semi-technologies/weaviate/entities/schema"
+import (
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
 
 type fakeSchemaGetter struct {
-	schema schema.Schema
+	schema  schema.Schema
+	tenants map[string][]*models.Tenant
 }
 
 func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
 	return f.schema
 }
+
+func (f *fakeSchemaGetter) TenantsSkipAuth(className string) []*models.Tenant {
+	return f.tenants[className]
+}
@@ -76,6 +76,9 @@ var (
 	and  = filters.OperatorAnd
 	or   = filters.OperatorOr
 
+	containsAny = filters.OperatorContainsAny
+	containsAll = filters.OperatorContainsAll
+
 	// datatypes
 	dtInt            = schema.DataTypeInt
 	dtBool           = schema.DataTypeBoolean
@@ -14,7 +14,9 @@ package helpers
 import "fmt"
 
 const (
-	PropertyNameID = "_id"
+	PropertyNameID                 = "_id"
+	PropertyNameCreationTimeUnix   = "_creationTimeUnix"
+	PropertyNameLastUpdateTimeUnix = "_lastUpdateTimeUnix"
 )
 
 var (
@@ -37,6 +39,31 @@ func MetaCountProp(propName string) string {
 	return fmt.Sprintf("%s__meta_count", propName)
 }
 
+// ReindexProp creates an internally used propName for the inverted index of a
+// prop which is rebuilt side-by-side to the existing one
+func ReindexProp(propName string) string {
+	return fmt.Sprintf("%s__reindex", propName)
+}
+
+// NullStateProp creates an internally used propName for the index of whether
+// a prop is null, which is only built if the class has indexNullState set
+func NullStateProp(propName string) string {
+	return fmt.Sprintf("%s__null_state", propName)
+}
+
+// PropertyLengthProp creates an internally used propName for the index of the
+// length of a prop, which is only built if the class has indexPropertyLength
+// set
+func PropertyLengthProp(propName string) string {
+	return fmt.Sprintf("%s__property_len", propName)
+}
+
+// TrigramProp creates an internally used propName for the trigram index of a
+// prop, which maps the trigrams of its terms to the terms containing them
+func TrigramProp(propName string) string {
+	return fmt.Sprintf("%s__trigrams", propName)
+}
+
 // BucketFromPropName creates the byte-representation used as the bucket name
 // for a partiular prop in the inverted index
 func BucketFromPropNameLSM(propName string) string {
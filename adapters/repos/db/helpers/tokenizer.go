@@ -14,8 +14,38 @@ package helpers
 import (
 	"strings"
 	"unicode"
+
+	"github.com/semi-technologies/weaviate/entities/models"
 )
 
+// Tokenize splits the value of a string or text property into the terms of
+// the inverted index, as specified by the tokenization of the property
+func Tokenize(tokenization string, in string) []string {
+	switch tokenization {
+	case models.PropertyTokenizationWhitespace:
+		return TokenizeString(in)
+	case models.PropertyTokenizationField:
+		return tokenizeField(in)
+	case models.PropertyTokenizationLowercase:
+		return tokenizeLowercase(in)
+	case models.PropertyTokenizationTrigram:
+		return tokenizeTrigram(in)
+	default:
+		return TokenizeText(in)
+	}
+}
+
+// TokenizeWithWildcards is like Tokenize, but keeps the wildcard symbols of
+// a Like filter. The other tokenizations don't remove them in the first
+// place.
+func TokenizeWithWildcards(tokenization string, in string) []string {
+	if tokenization == models.PropertyTokenizationWord || tokenization == "" {
+		return TokenizeTextKeepWildcards(in)
+	}
+
+	return Tokenize(tokenization, in)
+}
+
 // TokenizeString only splits on spaces, it does not alter casing
 func TokenizeString(in string) []string {
 	parts := strings.FieldsFunc(in, func(c rune) bool {
@@ -49,3 +79,50 @@ func TokenizeTextKeepWildcards(in string) []string {
 
 	return parts
 }
+
+// tokenizeField trims the value, but otherwise keeps it as a single term
+func tokenizeField(in string) []string {
+	trimmed := strings.TrimSpace(in)
+	if trimmed == "" {
+		return nil
+	}
+
+	return []string{trimmed}
+}
+
+// tokenizeLowercase splits on spaces and lowercases the words
+func tokenizeLowercase(in string) []string {
+	parts := TokenizeString(in)
+	for i, part := range parts {
+		parts[i] = strings.ToLower(part)
+	}
+
+	return parts
+}
+
+// tokenizeTrigram lowercases the value, removes anything that is not a letter
+// or a number and returns every sequence of three consecutive characters.
+// Values shorter than three characters are a single term.
+func tokenizeTrigram(in string) []string {
+	var runes []rune
+	for _, r := range strings.ToLower(in) {
+		if unicode.IsLetter(r) || unicode.IsNumber(r) {
+			runes = append(runes, r)
+		}
+	}
+
+	if len(runes) == 0 {
+		return nil
+	}
+
+	if len(runes) < 3 {
+		return []string{string(runes)}
+	}
+
+	parts := make([]string, len(runes)-2)
+	for i := range parts {
+		parts[i] = string(runes[i : i+3])
+	}
+
+	return parts
+}
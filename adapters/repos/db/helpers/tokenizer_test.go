@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package helpers
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokenize(t *testing.T) {
+	input := " Hello World-wide, hello  Weaviate! "
+
+	tests := []struct {
+		tokenization string
+		expected     []string
+	}{
+		{
+			tokenization: models.PropertyTokenizationWord,
+			expected:     []string{"hello", "world", "wide", "hello", "weaviate"},
+		},
+		{
+			tokenization: models.PropertyTokenizationWhitespace,
+			expected:     []string{"Hello", "World-wide,", "hello", "Weaviate!"},
+		},
+		{
+			tokenization: models.PropertyTokenizationField,
+			expected:     []string{"Hello World-wide, hello  Weaviate!"},
+		},
+		{
+			tokenization: models.PropertyTokenizationLowercase,
+			expected:     []string{"hello", "world-wide,", "hello", "weaviate!"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.tokenization, func(t *testing.T) {
+			assert.Equal(t, test.expected, Tokenize(test.tokenization, input))
+		})
+	}
+
+	t.Run(models.PropertyTokenizationTrigram, func(t *testing.T) {
+		assert.Equal(t, []string{"hel", "ell", "llo", "low", "owo"},
+			Tokenize(models.PropertyTokenizationTrigram, "Hello, Wo"))
+		assert.Equal(t, []string{"ab"},
+			Tokenize(models.PropertyTokenizationTrigram, "a-B"))
+		assert.Empty(t, Tokenize(models.PropertyTokenizationTrigram, " !"))
+	})
+
+	t.Run("an empty field", func(t *testing.T) {
+		assert.Empty(t, Tokenize(models.PropertyTokenizationField, "   "))
+	})
+}
+
+func TestTokenizeWithWildcards(t *testing.T) {
+	assert.Equal(t, []string{"hel*", "w?rld"},
+		TokenizeWithWildcards(models.PropertyTokenizationWord, "Hel* W?rld"))
+	assert.Equal(t, []string{"Hel* W?rld"},
+		TokenizeWithWildcards(models.PropertyTokenizationField, "Hel* W?rld"))
+	assert.Equal(t, []string{"hel*", "w?rld"},
+		TokenizeWithWildcards(models.PropertyTokenizationLowercase, "Hel* W?rld"))
+}
@@ -15,10 +15,12 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
 	"github.com/semi-technologies/weaviate/entities/aggregation"
 	"github.com/semi-technologies/weaviate/entities/filters"
@@ -27,6 +29,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/usecases/objects"
 	schemaUC "github.com/semi-technologies/weaviate/usecases/schema"
+	"github.com/semi-technologies/weaviate/usecases/schema/migrate"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/sirupsen/logrus"
 )
@@ -36,19 +39,35 @@ import (
 // Shards, to allow for easy distribution across Nodes
 type Index struct {
 	classSearcher         inverted.ClassSearcher // to allow for nested by-references searches
+	shardsLock            sync.RWMutex
 	Shards                map[string]*Shard
+	tenantsLock           sync.Mutex // serializes loading and unloading tenants
+	inactiveTenants       map[string]struct{}
+	reindexing            bool
 	Config                IndexConfig
 	vectorIndexUserConfig schema.VectorIndexConfig
 	invertedIndexConfig   *models.InvertedIndexConfig
+	analyzerChains        *analysis.Chains
 	getSchema             schemaUC.SchemaGetter
 	logger                logrus.FieldLogger
 }
 
-func (i Index) ID() string {
+func (i *Index) ID() string {
 	return indexID(i.Config.ClassName)
 }
 
-// NewIndex - for now - always creates a single-shard index
+// analyzer creates the analyzer for the objects of the index, as configured
+// in the inverted index config of the class
+func (i *Index) analyzer() *inverted.Analyzer {
+	return inverted.NewAnalyzer(i.analyzerChains).
+		WithNullState(i.invertedIndexConfig.IndexNullState).
+		WithPropertyLength(i.invertedIndexConfig.IndexPropertyLength).
+		WithTimestamps(i.invertedIndexConfig.IndexTimestamps)
+}
+
+// NewIndex creates a single-shard index, unless multi-tenancy is enabled. In
+// that case there is one shard per tenant and shards are only created once
+// tenants are added.
 func NewIndex(ctx context.Context, config IndexConfig,
 	invertedIndexConfig *models.InvertedIndexConfig,
 	vectorIndexUserConfig schema.VectorIndexConfig, sg schemaUC.SchemaGetter,
@@ -56,15 +75,21 @@ func NewIndex(ctx context.Context, config IndexConfig,
 	index := &Index{
 		Config:                config,
 		Shards:                map[string]*Shard{},
+		inactiveTenants:       map[string]struct{}{},
 		getSchema:             sg,
 		logger:                logger,
 		classSearcher:         cs,
 		vectorIndexUserConfig: vectorIndexUserConfig,
 		invertedIndexConfig:   invertedIndexConfig,
+		analyzerChains:        analysis.NewChains(invertedIndexConfig),
+	}
+
+	if config.MultiTenancy {
+		return index, nil
 	}
 
-	// use explicit shard name "single" to indicate it's currently the only
-	// supported config
+	// use explicit shard name "single" to indicate it's the only shard of an
+	// index without multi-tenancy
 	singleShard, err := NewShard(ctx, "single", index)
 	if err != nil {
 		return nil, errors.Wrapf(err, "init index %s", index.ID())
@@ -75,21 +100,233 @@ func NewIndex(ctx context.Context, config IndexConfig,
 }
 
 func (i *Index) addProperty(ctx context.Context, prop *models.Property) error {
-	// TODO: pick the right shard instead of using the "single" shard
-	shard := i.Shards["single"]
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	// shards of inactive tenants pick up the property from the schema once
+	// they are loaded again
+	for name, shard := range i.Shards {
+		if err := shard.addProperty(ctx, prop); err != nil {
+			return errors.Wrapf(err, "shard %s", name)
+		}
+	}
+
+	return nil
+}
+
+// addNewProperty adds a property to a class which may already contain
+// objects. Their optional meta props for the property, such as the null state
+// or the length, are indexed in the background. Shards of inactive tenants
+// only pick up the buckets of the property once they are loaded again, the
+// meta props of their objects are not indexed.
+func (i *Index) addNewProperty(ctx context.Context, prop *models.Property) error {
+	if err := i.addProperty(ctx, prop); err != nil {
+		return err
+	}
+
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	for _, shard := range i.Shards {
+		shard.indexMetaPropsOfNewProperty(prop)
+	}
+
+	return nil
+}
+
+// dropProperty removes the property from all shards. This is only possible
+// while all tenants are active, otherwise the shards of inactive tenants would
+// keep serving the outdated indices once they are loaded again.
+func (i *Index) dropProperty(ctx context.Context, prop *models.Property) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	if err := i.checkNoInactiveTenants(); err != nil {
+		return err
+	}
+
+	for name, shard := range i.Shards {
+		if err := shard.dropProperty(ctx, prop); err != nil {
+			return errors.Wrapf(err, "shard %s", name)
+		}
+	}
+
+	return nil
+}
+
+// updatePropertyDataType rebuilds the inverted index of the property in all
+// shards, see dropProperty for the limitation regarding inactive tenants
+func (i *Index) updatePropertyDataType(ctx context.Context,
+	prop *models.Property) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	if err := i.checkNoInactiveTenants(); err != nil {
+		return err
+	}
+
+	for name, shard := range i.Shards {
+		if err := shard.updatePropertyDataType(ctx, prop); err != nil {
+			return errors.Wrapf(err, "shard %s", name)
+		}
+	}
 
-	return shard.addProperty(ctx, prop)
+	return nil
+}
+
+// checkNoInactiveTenants must be called with the shardsLock held
+func (i *Index) checkNoInactiveTenants() error {
+	if len(i.inactiveTenants) > 0 {
+		return errors.Errorf("class has %d inactive tenant(s), activate all "+
+			"tenants before migrating the class", len(i.inactiveTenants))
+	}
+
+	return nil
+}
+
+// reindex rebuilds indices of all shards side-by-side. Once every shard has
+// completed, writes are paused on all of them while the live indices are
+// replaced and commit is called. This way no write can observe a state in
+// which only some of the indices were replaced.
+func (i *Index) reindex(ctx context.Context, params migrate.ReindexParams,
+	commit func() error) error {
+	shards, err := i.startReindex()
+	if err != nil {
+		return err
+	}
+	defer i.stopReindex()
+
+	// shards wait for the reindex job to complete before they shut down
+	for _, shard := range shards {
+		shard.migrations.Add(1)
+		defer shard.migrations.Done()
+	}
+
+	swapped := false
+	defer func() {
+		if swapped {
+			return
+		}
+
+		for _, shard := range shards {
+			if err := shard.abortReindex(context.Background()); err != nil {
+				i.logger.WithField("action", "abort_reindex").
+					WithField("shard", shard.ID()).
+					WithError(err).
+					Error("could not remove the partially built indices")
+			}
+		}
+	}()
+
+	upTo := make([]uint64, len(shards))
+	for j, shard := range shards {
+		upTo[j], err = shard.prepareReindex(ctx, params)
+		if err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	for j, shard := range shards {
+		if err := shard.buildReindex(ctx, upTo[j]); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+
+	for _, shard := range shards {
+		shard.reindexLock.Lock()
+		defer shard.reindexLock.Unlock()
+	}
+
+	for _, shard := range shards {
+		if err := shard.swapReindex(ctx); err != nil {
+			return errors.Wrapf(err, "shard %s", shard.ID())
+		}
+	}
+	swapped = true
+
+	if params.VectorIndexConfig != nil {
+		i.shardsLock.Lock()
+		i.vectorIndexUserConfig = params.VectorIndexConfig
+		i.shardsLock.Unlock()
+	}
+
+	return commit()
+}
+
+// startReindex returns the shards to reindex. Tenants can not be changed
+// while the reindex job is running, as it only covers the shards which were
+// loaded when it was started.
+func (i *Index) startReindex() ([]*Shard, error) {
+	i.shardsLock.Lock()
+	defer i.shardsLock.Unlock()
+
+	if err := i.checkNoInactiveTenants(); err != nil {
+		return nil, err
+	}
+
+	if i.reindexing {
+		return nil, errors.Errorf("class is already being reindexed")
+	}
+	i.reindexing = true
+
+	shards := make([]*Shard, 0, len(i.Shards))
+	for _, shard := range i.Shards {
+		shards = append(shards, shard)
+	}
+
+	return shards, nil
+}
+
+func (i *Index) stopReindex() {
+	i.shardsLock.Lock()
+	defer i.shardsLock.Unlock()
+
+	i.reindexing = false
+}
+
+// checkNotReindexing must be called with the shardsLock held, in the same
+// critical section which changes the shards. Otherwise a reindex job could
+// start in between and miss the change.
+func (i *Index) checkNotReindexing() error {
+	if i.reindexing {
+		return errors.Errorf("class is being reindexed, tenants can be changed " +
+			"once the reindex job has completed")
+	}
+
+	return nil
 }
 
 func (i *Index) addUUIDProperty(ctx context.Context) error {
-	// TODO: pick the right shard instead of using the "single" shard
-	shard := i.Shards["single"]
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
 
-	return shard.addIDProperty(ctx)
+	for name, shard := range i.Shards {
+		if err := shard.addIDProperty(ctx); err != nil {
+			return errors.Wrapf(err, "shard %s", name)
+		}
+	}
+
+	return nil
+}
+
+func (i *Index) addTimestampProperties(ctx context.Context) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	for name, shard := range i.Shards {
+		if err := shard.addTimestampProperties(ctx); err != nil {
+			return errors.Wrapf(err, "shard %s", name)
+		}
+	}
+
+	return nil
 }
 
 func (i *Index) updateVectorIndexConfig(ctx context.Context,
 	updated schema.VectorIndexConfig) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	// an updated is not specific to one shard, but rather all
 	for name, shard := range i.Shards {
 		// At the moment, we don't do anything in an update that could fail, but
@@ -105,23 +342,86 @@ func (i *Index) updateVectorIndexConfig(ctx context.Context,
 }
 
 type IndexConfig struct {
-	RootPath  string
-	ClassName schema.ClassName
+	RootPath     string
+	ClassName    schema.ClassName
+	NamedVectors []string
+	MultiTenancy bool
 }
 
 func indexID(class schema.ClassName) string {
 	return strings.ToLower(string(class))
 }
 
+// shardForTenant returns the shard which holds the data of the tenant. An
+// index without multi-tenancy only consists of the "single" shard and must
+// not be queried with a tenant. The shard is marked as in use until release
+// is called, so it is not unloaded or deleted while the request is running.
+func (i *Index) shardForTenant(tenant string) (*Shard, func(), error) {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	if !i.Config.MultiTenancy {
+		if tenant != "" {
+			return nil, nil, errors.Errorf("class %s does not have multi-tenancy enabled, "+
+				"but request was made with tenant %q", i.Config.ClassName, tenant)
+		}
+
+		return i.Shards["single"].acquire(), i.Shards["single"].release, nil
+	}
+
+	if tenant == "" {
+		return nil, nil, errors.Errorf("class %s has multi-tenancy enabled, "+
+			"but request was made without tenant", i.Config.ClassName)
+	}
+
+	if shard, ok := i.Shards[tenant]; ok {
+		return shard.acquire(), shard.release, nil
+	}
+
+	if _, ok := i.inactiveTenants[tenant]; ok {
+		return nil, nil, errors.Errorf("tenant %q is not active", tenant)
+	}
+
+	return nil, nil, errors.Errorf("tenant %q not found", tenant)
+}
+
+// servesTenant indicates whether the index can be queried with the given
+// tenant. It is used to skip indices when searching across all classes.
+func (i *Index) servesTenant(tenant string) bool {
+	if !i.Config.MultiTenancy {
+		return tenant == ""
+	}
+
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
+	_, ok := i.Shards[tenant]
+	return ok
+}
+
+// setTenant annotates objects read from a tenant's shard with the tenant, as
+// it is not part of the stored object itself
+func setTenant(objs []*storobj.Object, tenant string) {
+	for _, obj := range objs {
+		if obj != nil {
+			obj.Object.Tenant = tenant
+		}
+	}
+}
+
 func (i *Index) putObject(ctx context.Context, object *storobj.Object) error {
 	if i.Config.ClassName != object.Class() {
 		return fmt.Errorf("cannot import object of class %s into index of class %s",
 			object.Class(), i.Config.ClassName)
 	}
 
-	// TODO: pick the right shard instead of using the "single" shard
-	shard := i.Shards["single"]
-	err := shard.putObject(ctx, object)
+	shard, release, err := i.shardForTenant(object.Object.Tenant)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	err = shard.putObject(ctx, object)
 	if err != nil {
 		return errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -132,54 +432,116 @@ func (i *Index) putObject(ctx context.Context, object *storobj.Object) error {
 // return value map[int]error gives the error for the index as it received it
 func (i *Index) putObjectBatch(ctx context.Context,
 	objects []*storobj.Object) map[int]error {
-	// TODO: pick the right shard(s) instead of using the "single" shard
-	shard := i.Shards["single"]
-	return shard.putObjectBatch(ctx, objects)
+	byTenant := map[string][]int{}
+	for pos, object := range objects {
+		byTenant[object.Object.Tenant] = append(byTenant[object.Object.Tenant], pos)
+	}
+
+	out := map[int]error{}
+	for tenant, positions := range byTenant {
+		shard, release, err := i.shardForTenant(tenant)
+		if err != nil {
+			for _, pos := range positions {
+				out[pos] = err
+			}
+			continue
+		}
+
+		batch := make([]*storobj.Object, len(positions))
+		for j, pos := range positions {
+			batch[j] = objects[pos]
+		}
+
+		errs := shard.putObjectBatch(ctx, batch)
+		release()
+
+		for j, err := range errs {
+			out[positions[j]] = err
+		}
+	}
+
+	return out
 }
 
 // return value map[int]error gives the error for the index as it received it
 func (i *Index) addReferencesBatch(ctx context.Context,
 	refs objects.BatchReferences) map[int]error {
-	// TODO: pick the right shard(s) instead of using the "single" shard
-	shard := i.Shards["single"]
-	return shard.addReferencesBatch(ctx, refs)
+	byTenant := map[string][]int{}
+	for pos, ref := range refs {
+		byTenant[ref.Tenant] = append(byTenant[ref.Tenant], pos)
+	}
+
+	out := map[int]error{}
+	for tenant, positions := range byTenant {
+		shard, release, err := i.shardForTenant(tenant)
+		if err != nil {
+			for _, pos := range positions {
+				out[pos] = err
+			}
+			continue
+		}
+
+		batch := make(objects.BatchReferences, len(positions))
+		for j, pos := range positions {
+			batch[j] = refs[pos]
+		}
+
+		errs := shard.addReferencesBatch(ctx, batch)
+		release()
+
+		for j, err := range errs {
+			out[positions[j]] = err
+		}
+	}
+
+	return out
 }
 
 func (i *Index) objectByID(ctx context.Context, id strfmt.UUID,
-	props traverser.SelectProperties, additional traverser.AdditionalProperties) (*storobj.Object, error) {
+	props traverser.SelectProperties, additional traverser.AdditionalProperties,
+	tenant string) (*storobj.Object, error) {
 	// TODO: don't ignore meta
 
-	// TODO: search across all shards, rather than hard-coded "single" shard
-	// TODO: can we improve this by hashing so we know the target shard?
+	shard, release, err := i.shardForTenant(tenant)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
-	shard := i.Shards["single"]
 	obj, err := shard.objectByID(ctx, id, props, additional)
 	if err != nil {
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
 
+	setTenant([]*storobj.Object{obj}, tenant)
 	return obj, nil
 }
 
 func (i *Index) multiObjectByID(ctx context.Context,
-	query []multi.Identifier) ([]*storobj.Object, error) {
-	// TODO: search across all shards, rather than hard-coded "single" shard
-	// TODO: can we improve this by hashing so we know the target shard?
+	query []multi.Identifier, tenant string) ([]*storobj.Object, error) {
+	shard, release, err := i.shardForTenant(tenant)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
-	shard := i.Shards["single"]
 	objects, err := shard.multiObjectByID(ctx, query)
 	if err != nil {
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
 
+	setTenant(objects, tenant)
 	return objects, nil
 }
 
-func (i *Index) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
-	// TODO: search across all shards, rather than hard-coded "single" shard
-	// TODO: can we improve this by hashing so we know the target shard?
+func (i *Index) exists(ctx context.Context, id strfmt.UUID,
+	tenant string) (bool, error) {
+	shard, release, err := i.shardForTenant(tenant)
+	if err != nil {
+		return false, err
+	}
+	defer release()
 
-	shard := i.Shards["single"]
 	ok, err := shard.exists(ctx, id)
 	if err != nil {
 		return false, errors.Wrapf(err, "shard %s", shard.ID())
@@ -190,37 +552,54 @@ func (i *Index) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
 
 func (i *Index) objectSearch(ctx context.Context, limit int,
 	filters *filters.LocalFilter,
-	additional traverser.AdditionalProperties) ([]*storobj.Object, error) {
+	additional traverser.AdditionalProperties,
+	tenant string) ([]*storobj.Object, error) {
 	// TODO: don't ignore meta
-	// TODO: search across all shards, rather than hard-coded "single" shard
 
-	shard := i.Shards["single"]
+	shard, release, err := i.shardForTenant(tenant)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	res, err := shard.objectSearch(ctx, limit, filters, additional)
 	if err != nil {
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
 
+	setTenant(res, tenant)
 	return res, nil
 }
 
-func (i *Index) objectVectorSearch(ctx context.Context, searchVector []float32,
-	limit int, filters *filters.LocalFilter, additional traverser.AdditionalProperties) ([]*storobj.Object, error) {
+func (i *Index) objectVectorSearch(ctx context.Context, targetVector string,
+	searchVector []float32, limit int, filters *filters.LocalFilter, additional traverser.AdditionalProperties,
+	tenant string) ([]*storobj.Object, error) {
 	// TODO: don't ignore meta
-	// TODO: search across all shards, rather than hard-coded "single" shard
 
-	shard := i.Shards["single"]
-	res, err := shard.objectVectorSearch(ctx, searchVector, limit, filters, additional)
+	shard, release, err := i.shardForTenant(tenant)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	res, err := shard.objectVectorSearch(ctx, targetVector, searchVector, limit,
+		filters, additional)
 	if err != nil {
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
 	}
 
+	setTenant(res, tenant)
 	return res, nil
 }
 
-func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID) error {
-	// TODO: search across all shards, rather than hard-coded "single" shard
+func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID,
+	tenant string) error {
+	shard, release, err := i.shardForTenant(tenant)
+	if err != nil {
+		return err
+	}
+	defer release()
 
-	shard := i.Shards["single"]
 	if err := shard.deleteObject(ctx, id); err != nil {
 		return errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -229,9 +608,12 @@ func (i *Index) deleteObject(ctx context.Context, id strfmt.UUID) error {
 }
 
 func (i *Index) mergeObject(ctx context.Context, merge objects.MergeDocument) error {
-	// TODO: search across all shards, rather than hard-coded "single" shard
+	shard, release, err := i.shardForTenant(merge.Tenant)
+	if err != nil {
+		return err
+	}
+	defer release()
 
-	shard := i.Shards["single"]
 	if err := shard.mergeObject(ctx, merge); err != nil {
 		return errors.Wrapf(err, "shard %s", shard.ID())
 	}
@@ -243,10 +625,12 @@ func (i *Index) aggregate(ctx context.Context,
 	params traverser.AggregateParams) (*aggregation.Result, error) {
 	// TODO: don't ignore meta
 
-	// TODO: search across all shards, rather than hard-coded "single" shard
-	// TODO: can we improve this by hashing so we know the target shard?
+	shard, release, err := i.shardForTenant(params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
 
-	shard := i.Shards["single"]
 	obj, err := shard.aggregate(ctx, params)
 	if err != nil {
 		return nil, errors.Wrapf(err, "shard %s", shard.ID())
@@ -256,15 +640,31 @@ func (i *Index) aggregate(ctx context.Context,
 }
 
 func (i *Index) drop() error {
-	shard := i.Shards["single"]
-	err := shard.drop()
-	if err != nil {
-		return errors.Wrapf(err, "delete shard %s", shard.ID())
+	i.shardsLock.Lock()
+	defer i.shardsLock.Unlock()
+
+	for name, shard := range i.Shards {
+		if err := shard.drop(); err != nil {
+			return errors.Wrapf(err, "delete shard %s", shard.ID())
+		}
+		delete(i.Shards, name)
 	}
+
+	// the files of inactive tenants are still on disk
+	for tenant := range i.inactiveTenants {
+		if err := i.dropInactiveTenant(tenant); err != nil {
+			return err
+		}
+		delete(i.inactiveTenants, tenant)
+	}
+
 	return nil
 }
 
 func (i *Index) Shutdown(ctx context.Context) error {
+	i.shardsLock.RLock()
+	defer i.shardsLock.RUnlock()
+
 	for id, shard := range i.Shards {
 		if err := shard.shutdown(ctx); err != nil {
 			return errors.Wrapf(err, "shutdown shard %q", id)
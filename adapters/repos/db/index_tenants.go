@@ -0,0 +1,183 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// addTenants creates a shard for every active tenant. Inactive tenants are
+// only registered, their shard is created once they are activated.
+func (i *Index) addTenants(ctx context.Context, tenants []*models.Tenant) error {
+	i.tenantsLock.Lock()
+	defer i.tenantsLock.Unlock()
+
+	for _, tenant := range tenants {
+		if tenant.ActivityStatus == models.TenantActivityStatusINACTIVE {
+			if err := i.addInactiveTenant(tenant.Name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := i.activateTenant(ctx, tenant.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateTenants loads the shards of activated tenants and unloads the shards
+// of deactivated tenants. An unloaded shard keeps all of its data on disk.
+func (i *Index) updateTenants(ctx context.Context, tenants []*models.Tenant) error {
+	// a tenant can not be loaded again while its shard is still being unloaded
+	i.tenantsLock.Lock()
+	defer i.tenantsLock.Unlock()
+
+	for _, tenant := range tenants {
+		var err error
+		switch tenant.ActivityStatus {
+		case models.TenantActivityStatusACTIVE:
+			err = i.activateTenant(ctx, tenant.Name)
+		case models.TenantActivityStatusINACTIVE:
+			err = i.deactivateTenant(ctx, tenant.Name)
+		default:
+			err = errors.Errorf("invalid activity status %q", tenant.ActivityStatus)
+		}
+		if err != nil {
+			return errors.Wrapf(err, "tenant %q", tenant.Name)
+		}
+	}
+
+	return nil
+}
+
+// deleteTenants removes the shards of the tenants including all their data
+func (i *Index) deleteTenants(ctx context.Context, tenants []string) error {
+	i.tenantsLock.Lock()
+	defer i.tenantsLock.Unlock()
+
+	for _, tenant := range tenants {
+		i.shardsLock.Lock()
+		if err := i.checkNotReindexing(); err != nil {
+			i.shardsLock.Unlock()
+			return err
+		}
+		shard, active := i.Shards[tenant]
+		delete(i.Shards, tenant)
+		delete(i.inactiveTenants, tenant)
+		i.shardsLock.Unlock()
+
+		if !active {
+			if err := i.dropInactiveTenant(tenant); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// the shard can no longer be handed out, but running requests may
+		// still use it
+		shard.inUse.Wait()
+		if err := shard.drop(); err != nil {
+			return errors.Wrapf(err, "delete shard %s", shard.ID())
+		}
+	}
+
+	return nil
+}
+
+func (i *Index) activateTenant(ctx context.Context, tenant string) error {
+	i.shardsLock.RLock()
+	_, loaded := i.Shards[tenant]
+	i.shardsLock.RUnlock()
+	if loaded {
+		return nil
+	}
+
+	// loading a shard can take a while, so it happens outside of the lock to
+	// not block requests to other tenants
+	shard, err := NewShard(ctx, tenant, i)
+	if err != nil {
+		return errors.Wrapf(err, "load shard of tenant %q", tenant)
+	}
+
+	i.shardsLock.Lock()
+	if err := i.checkNotReindexing(); err != nil {
+		i.shardsLock.Unlock()
+		// the shard has not been added yet, so it can not have been used
+		if shutdownErr := shard.shutdown(ctx); shutdownErr != nil {
+			return errors.Wrapf(err, "unload shard %s (%v)", shard.ID(), shutdownErr)
+		}
+		return err
+	}
+	i.Shards[tenant] = shard
+	delete(i.inactiveTenants, tenant)
+	i.shardsLock.Unlock()
+
+	return nil
+}
+
+func (i *Index) addInactiveTenant(tenant string) error {
+	i.shardsLock.Lock()
+	defer i.shardsLock.Unlock()
+
+	if err := i.checkNotReindexing(); err != nil {
+		return err
+	}
+
+	i.inactiveTenants[tenant] = struct{}{}
+	return nil
+}
+
+func (i *Index) deactivateTenant(ctx context.Context, tenant string) error {
+	i.shardsLock.Lock()
+	if err := i.checkNotReindexing(); err != nil {
+		i.shardsLock.Unlock()
+		return err
+	}
+	shard, loaded := i.Shards[tenant]
+	delete(i.Shards, tenant)
+	i.inactiveTenants[tenant] = struct{}{}
+	i.shardsLock.Unlock()
+
+	if !loaded {
+		return nil
+	}
+
+	// the shard can no longer be handed out, but running requests may still
+	// use it
+	shard.inUse.Wait()
+	if err := shard.shutdown(ctx); err != nil {
+		return errors.Wrapf(err, "unload shard %s", shard.ID())
+	}
+
+	return nil
+}
+
+// dropInactiveTenant loads the shard of an inactive tenant only to remove its
+// files from disk
+func (i *Index) dropInactiveTenant(tenant string) error {
+	shard, err := NewShard(context.Background(), tenant, i)
+	if err != nil {
+		return errors.Wrapf(err, "load shard of inactive tenant %q", tenant)
+	}
+
+	if err := shard.drop(); err != nil {
+		return errors.Wrapf(err, "delete shard %s", shard.ID())
+	}
+
+	return nil
+}
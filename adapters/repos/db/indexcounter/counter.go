@@ -67,6 +67,25 @@ func (c *Counter) GetAndInc() (uint64, error) {
 	return before, nil
 }
 
+// Get returns the next id without increasing the counter. All ids which
+// were handed out so far are smaller than the returned value
+func (c *Counter) Get() uint64 {
+	c.Lock()
+	defer c.Unlock()
+	return c.count
+}
+
+// Close releases the underlying file, the counter can no longer be used
+// afterwards
+func (c *Counter) Close() error {
+	c.Lock()
+	defer c.Unlock()
+	if c.f == nil {
+		return nil
+	}
+	return c.f.Close()
+}
+
 func (c *Counter) Drop() error {
 	c.Lock()
 	defer c.Unlock()
@@ -46,14 +46,23 @@ func (d *DB) init(ctx context.Context) error {
 			}
 
 			idx, err := NewIndex(ctx, IndexConfig{
-				ClassName: schema.ClassName(class.Class),
-				RootPath:  d.config.RootPath,
+				ClassName:    schema.ClassName(class.Class),
+				RootPath:     d.config.RootPath,
+				NamedVectors: class.NamedVectors,
+				MultiTenancy: schema.MultiTenancyEnabled(class),
 			}, invertedConfig, class.VectorIndexConfig.(schema.VectorIndexConfig),
 				d.schemaGetter, d, d.logger)
 			if err != nil {
 				return errors.Wrap(err, "create index")
 			}
 
+			if idx.Config.MultiTenancy {
+				tenants := d.schemaGetter.TenantsSkipAuth(class.Class)
+				if err := idx.addTenants(ctx, tenants); err != nil {
+					return errors.Wrapf(err, "load tenants of index %s", idx.ID())
+				}
+			}
+
 			d.indices[idx.ID()] = idx
 		}
 	}
@@ -0,0 +1,160 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+// Package analysis contains the language-aware analysis of the terms of
+// string and text properties, such as stemming, stopword removal and ASCII
+// folding
+package analysis
+
+import (
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// Chain post-processes the terms of a tokenized value as configured by an
+// AnalyzerConfig. Stopwords are removed first, then the remaining terms are
+// stemmed and folded to ASCII. A nil chain returns the terms as is.
+type Chain struct {
+	stopwords    map[string]struct{}
+	stem         func(term string) string
+	asciiFolding bool
+}
+
+// NewChain returns nil if the config does not alter any terms
+func NewChain(cfg *models.AnalyzerConfig) *Chain {
+	if cfg == nil {
+		return nil
+	}
+
+	c := &Chain{
+		stopwords:    stopwords(cfg.Stopwords),
+		stem:         stemmer(cfg.Stemmer),
+		asciiFolding: cfg.ASCIIFolding,
+	}
+
+	if c.stopwords == nil && c.stem == nil && !c.asciiFolding {
+		return nil
+	}
+
+	return c
+}
+
+// Terms analyzes the terms of a value, stopwords are dropped
+func (c *Chain) Terms(terms []string) []string {
+	if c == nil {
+		return terms
+	}
+
+	out := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if analyzed, ok := c.term(term); ok {
+			out = append(out, analyzed)
+		}
+	}
+
+	return out
+}
+
+// WildcardTerms analyzes the terms of a Like filter value. Terms with
+// wildcards are only folded to ASCII, as stemming or dropping them would
+// change the pattern.
+func (c *Chain) WildcardTerms(terms []string) []string {
+	if c == nil {
+		return terms
+	}
+
+	out := make([]string, 0, len(terms))
+	for _, term := range terms {
+		if strings.ContainsAny(term, "*?") {
+			out = append(out, c.fold(term))
+			continue
+		}
+
+		if analyzed, ok := c.term(term); ok {
+			out = append(out, analyzed)
+		}
+	}
+
+	return out
+}
+
+func (c *Chain) term(term string) (string, bool) {
+	if _, ok := c.stopwords[term]; ok {
+		return "", false
+	}
+
+	if c.stem != nil {
+		term = c.stem(term)
+	}
+
+	return c.fold(term), true
+}
+
+func (c *Chain) fold(term string) string {
+	if !c.asciiFolding {
+		return term
+	}
+
+	return foldASCII(term)
+}
+
+// Chains are the analyzer chains of the string and text properties of a
+// class, as configured in its InvertedIndexConfig
+type Chains struct {
+	class *Chain
+	props map[string]*Chain
+}
+
+// NewChains builds the chains of all properties up front, so they can be
+// reused for every object of the class
+func NewChains(cfg *models.InvertedIndexConfig) *Chains {
+	if cfg == nil {
+		return nil
+	}
+
+	c := &Chains{
+		class: NewChain(cfg.Analyzer),
+		props: map[string]*Chain{},
+	}
+
+	for name, propCfg := range cfg.PropertyAnalyzers {
+		propCfg := propCfg
+		c.props[name] = NewChain(&propCfg)
+	}
+
+	return c
+}
+
+// ForProperty returns the chain of the property. The chains only apply to
+// the word and lowercase tokenizations, as the other tokenizations are meant
+// for exact matches, so nil is returned for those.
+func (c *Chains) ForProperty(propName, tokenization string) *Chain {
+	if c == nil || !AppliesTo(tokenization) {
+		return nil
+	}
+
+	if chain, ok := c.props[propName]; ok {
+		return chain
+	}
+
+	return c.class
+}
+
+// AppliesTo is true if terms of the tokenization are analyzed
+func AppliesTo(tokenization string) bool {
+	switch tokenization {
+	case models.PropertyTokenizationWord, models.PropertyTokenizationLowercase:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,113 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChain(t *testing.T) {
+	t.Run("without config", func(t *testing.T) {
+		chain := NewChain(&models.AnalyzerConfig{})
+		assert.Nil(t, chain)
+		assert.Equal(t, []string{"the", "running"}, chain.Terms([]string{"the", "running"}))
+	})
+
+	t.Run("with stopwords and stemming", func(t *testing.T) {
+		chain := NewChain(&models.AnalyzerConfig{
+			Stemmer: models.AnalyzerConfigStemmerEnglish,
+			Stopwords: &models.StopwordConfig{
+				Preset:    models.StopwordConfigPresetEnglish,
+				Additions: []string{"Dog"},
+				Removals:  []string{"not"},
+			},
+		})
+
+		res := chain.Terms([]string{"the", "dog", "is", "not", "running", "with", "cats"})
+		assert.Equal(t, []string{"not", "run", "cat"}, res)
+	})
+
+	t.Run("with ascii folding", func(t *testing.T) {
+		chain := NewChain(&models.AnalyzerConfig{ASCIIFolding: true})
+
+		res := chain.Terms([]string{"café", "straße", "łódź"})
+		assert.Equal(t, []string{"cafe", "strasse", "lodz"}, res)
+	})
+
+	t.Run("stopwords are removed before folding", func(t *testing.T) {
+		chain := NewChain(&models.AnalyzerConfig{
+			Stemmer:      models.AnalyzerConfigStemmerGerman,
+			ASCIIFolding: true,
+			Stopwords: &models.StopwordConfig{
+				Preset: models.StopwordConfigPresetGerman,
+			},
+		})
+
+		res := chain.Terms([]string{"über", "die", "häuser"})
+		assert.Equal(t, []string{"haus"}, res)
+	})
+
+	t.Run("terms with wildcards are only folded", func(t *testing.T) {
+		chain := NewChain(&models.AnalyzerConfig{
+			Stemmer:      models.AnalyzerConfigStemmerEnglish,
+			ASCIIFolding: true,
+			Stopwords: &models.StopwordConfig{
+				Preset: models.StopwordConfigPresetEnglish,
+			},
+		})
+
+		res := chain.WildcardTerms([]string{"the", "cafés", "runn*", "th?"})
+		assert.Equal(t, []string{"cafe", "runn*", "th?"}, res)
+	})
+}
+
+func TestChains(t *testing.T) {
+	chains := NewChains(&models.InvertedIndexConfig{
+		Analyzer: &models.AnalyzerConfig{
+			Stemmer: models.AnalyzerConfigStemmerEnglish,
+		},
+		PropertyAnalyzers: map[string]models.AnalyzerConfig{
+			"title": {Stemmer: models.AnalyzerConfigStemmerDutch},
+			"code":  {},
+		},
+	})
+
+	t.Run("class analyzer", func(t *testing.T) {
+		res := chains.ForProperty("description", models.PropertyTokenizationWord).
+			Terms([]string{"running"})
+		assert.Equal(t, []string{"run"}, res)
+	})
+
+	t.Run("property analyzer", func(t *testing.T) {
+		res := chains.ForProperty("title", models.PropertyTokenizationLowercase).
+			Terms([]string{"katten"})
+		assert.Equal(t, []string{"kat"}, res)
+	})
+
+	t.Run("property analyzer which does not alter any terms", func(t *testing.T) {
+		res := chains.ForProperty("code", models.PropertyTokenizationWord).
+			Terms([]string{"running"})
+		assert.Equal(t, []string{"running"}, res)
+	})
+
+	t.Run("tokenization meant for exact matches", func(t *testing.T) {
+		assert.Nil(t, chains.ForProperty("description", models.PropertyTokenizationField))
+	})
+
+	t.Run("without config", func(t *testing.T) {
+		var chains *Chains
+		assert.Nil(t, chains.ForProperty("description", models.PropertyTokenizationWord))
+	})
+}
@@ -0,0 +1,86 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package analysis
+
+import (
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// stemmer returns the stemming function of the language, or nil if terms
+// should not be stemmed
+func stemmer(language string) func(term string) string {
+	switch language {
+	case models.AnalyzerConfigStemmerEnglish:
+		return stemEnglish
+	case models.AnalyzerConfigStemmerGerman:
+		return stemGerman
+	case models.AnalyzerConfigStemmerDutch:
+		return stemDutch
+	default:
+		return nil
+	}
+}
+
+// regions returns the start of the R1 and R2 regions of the Snowball
+// stemmers. R1 starts after the first non-vowel following a vowel, R2 is R1
+// of R1. If there is no such non-vowel, the region is empty and starts at the
+// end of the word.
+func regions(w []rune, isVowel func(rune) bool) (int, int) {
+	r1 := regionStart(w, 0, isVowel)
+	r2 := regionStart(w, r1, isVowel)
+	return r1, r2
+}
+
+func regionStart(w []rune, from int, isVowel func(rune) bool) int {
+	for i := from + 1; i < len(w); i++ {
+		if !isVowel(w[i]) && isVowel(w[i-1]) {
+			return i + 1
+		}
+	}
+	return len(w)
+}
+
+// longestSuffix returns the longest of the suffixes the word ends with, or
+// an empty string if none matches
+func longestSuffix(w []rune, suffixes ...string) string {
+	longest := ""
+	for _, suffix := range suffixes {
+		if len(suffix) > len(longest) && hasSuffix(w, suffix) {
+			longest = suffix
+		}
+	}
+	return longest
+}
+
+func hasSuffix(w []rune, suffix string) bool {
+	s := []rune(suffix)
+	if len(s) > len(w) {
+		return false
+	}
+
+	offset := len(w) - len(s)
+	for i, r := range s {
+		if w[offset+i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// inRegion is true if the suffix of the word starts at or after the region
+func inRegion(w []rune, suffix string, region int) bool {
+	return len(w)-len([]rune(suffix)) >= region
+}
+
+func trimSuffix(w []rune, suffix string) []rune {
+	return w[:len(w)-len([]rune(suffix))]
+}
@@ -0,0 +1,198 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package analysis
+
+import "strings"
+
+var dutchAccents = strings.NewReplacer(
+	"ä", "a", "ë", "e", "ï", "i", "ö", "o", "ü", "u",
+	"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u",
+)
+
+// stemDutch implements the Dutch Snowball stemming algorithm, see
+// https://snowballstem.org/algorithms/dutch/stemmer.html. The term is
+// expected to be lowercased.
+func stemDutch(term string) string {
+	w := []rune(dutchAccents.Replace(term))
+
+	// an initial y, a y after a vowel and an i between vowels are treated as
+	// consonants
+	if len(w) > 0 && w[0] == 'y' {
+		w[0] = 'Y'
+	}
+	for i := 1; i < len(w); i++ {
+		if !isDutchVowel(w[i-1]) {
+			continue
+		}
+
+		switch {
+		case w[i] == 'y':
+			w[i] = 'Y'
+		case w[i] == 'i' && i < len(w)-1 && isDutchVowel(w[i+1]):
+			w[i] = 'I'
+		}
+	}
+
+	r1, r2 := regions(w, isDutchVowel)
+	if r1 < 3 {
+		r1 = 3
+	}
+
+	w = dutchStep1(w, r1)
+	w, eRemoved := dutchStep2(w, r1)
+	w = dutchStep3a(w, r1, r2)
+	w = dutchStep3b(w, r1, r2, eRemoved)
+	w = dutchStep4(w)
+
+	for i, r := range w {
+		switch r {
+		case 'I':
+			w[i] = 'i'
+		case 'Y':
+			w[i] = 'y'
+		}
+	}
+
+	return string(w)
+}
+
+func isDutchVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'è':
+		return true
+	default:
+		return false
+	}
+}
+
+// dutchUndouble removes the last letter if the word ends in kk, dd or tt
+func dutchUndouble(w []rune) []rune {
+	if longestSuffix(w, "kk", "dd", "tt") != "" {
+		return w[:len(w)-1]
+	}
+	return w
+}
+
+// dutchENEnding deletes the suffix if it is in R1 and preceded by a
+// non-vowel which is not part of gem, then undoubles the ending
+func dutchENEnding(w []rune, suffix string, r1 int) []rune {
+	if !inRegion(w, suffix, r1) {
+		return w
+	}
+
+	stem := trimSuffix(w, suffix)
+	if len(stem) == 0 || isDutchVowel(stem[len(stem)-1]) || hasSuffix(stem, "gem") {
+		return w
+	}
+
+	return dutchUndouble(stem)
+}
+
+func dutchStep1(w []rune, r1 int) []rune {
+	suffix := longestSuffix(w, "heden", "en", "ene", "s", "se")
+	switch suffix {
+	case "heden":
+		if inRegion(w, suffix, r1) {
+			return append(trimSuffix(w, suffix), []rune("heid")...)
+		}
+	case "en", "ene":
+		return dutchENEnding(w, suffix, r1)
+	case "s", "se":
+		stem := trimSuffix(w, suffix)
+		if inRegion(w, suffix, r1) && len(stem) > 0 &&
+			!isDutchVowel(stem[len(stem)-1]) && stem[len(stem)-1] != 'j' {
+			return stem
+		}
+	}
+
+	return w
+}
+
+// dutchStep2 deletes a final e if it is in R1 and preceded by a non-vowel,
+// then undoubles the ending. It also returns whether the e was deleted.
+func dutchStep2(w []rune, r1 int) ([]rune, bool) {
+	if !hasSuffix(w, "e") || !inRegion(w, "e", r1) {
+		return w, false
+	}
+
+	stem := trimSuffix(w, "e")
+	if len(stem) == 0 || isDutchVowel(stem[len(stem)-1]) {
+		return w, false
+	}
+
+	return dutchUndouble(stem), true
+}
+
+func dutchStep3a(w []rune, r1, r2 int) []rune {
+	if !hasSuffix(w, "heid") || !inRegion(w, "heid", r2) ||
+		hasSuffix(w, "cheid") {
+		return w
+	}
+
+	w = trimSuffix(w, "heid")
+	if hasSuffix(w, "en") {
+		w = dutchENEnding(w, "en", r1)
+	}
+
+	return w
+}
+
+func dutchStep3b(w []rune, r1, r2 int, eRemoved bool) []rune {
+	suffix := longestSuffix(w, "end", "ing", "ig", "lijk", "baar", "bar")
+	if suffix == "" || !inRegion(w, suffix, r2) {
+		return w
+	}
+
+	switch suffix {
+	case "end", "ing":
+		w = trimSuffix(w, suffix)
+		if hasSuffix(w, "ig") && inRegion(w, "ig", r2) && !hasSuffix(w, "eig") {
+			return trimSuffix(w, "ig")
+		}
+		return dutchUndouble(w)
+	case "ig":
+		if !hasSuffix(w, "eig") {
+			return trimSuffix(w, suffix)
+		}
+	case "lijk":
+		w, _ = dutchStep2(trimSuffix(w, suffix), r1)
+	case "baar":
+		return trimSuffix(w, suffix)
+	case "bar":
+		if eRemoved {
+			return trimSuffix(w, suffix)
+		}
+	}
+
+	return w
+}
+
+// dutchStep4 undoubles the vowel of a word ending in a non-vowel, a double
+// a, e, o or u and a non-vowel other than I, e.g. maan becomes man
+func dutchStep4(w []rune) []rune {
+	n := len(w)
+	if n < 4 {
+		return w
+	}
+
+	c, v1, v2, d := w[n-4], w[n-3], w[n-2], w[n-1]
+	if isDutchVowel(c) || isDutchVowel(d) || d == 'I' || v1 != v2 {
+		return w
+	}
+
+	switch v1 {
+	case 'a', 'e', 'o', 'u':
+		return append(w[:n-2], d)
+	default:
+		return w
+	}
+}
@@ -0,0 +1,331 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package analysis
+
+// stemEnglish implements the Porter stemming algorithm, see
+// https://tartarus.org/martin/PorterStemmer/def.txt. The term is expected to
+// be lowercased, terms of up to two letters are returned as is.
+func stemEnglish(term string) string {
+	if len(term) <= 2 {
+		return term
+	}
+
+	p := &porter{b: []rune(term)}
+	p.k = len(p.b) - 1
+	if p.k <= 1 {
+		return term
+	}
+
+	p.step1ab()
+	if p.k > 0 {
+		p.step1c()
+		p.step2()
+		p.step3()
+		p.step4()
+		p.step5()
+	}
+
+	return string(p.b[:p.k+1])
+}
+
+// porter holds the state of a single stemming operation, b[0:k+1] is the
+// current stem, j marks the end of the stem without the suffix matched last
+type porter struct {
+	b []rune
+	j int
+	k int
+}
+
+// cons is true if b[i] is a consonant
+func (p *porter) cons(i int) bool {
+	switch p.b[i] {
+	case 'a', 'e', 'i', 'o', 'u':
+		return false
+	case 'y':
+		if i == 0 {
+			return true
+		}
+		return !p.cons(i - 1)
+	default:
+		return true
+	}
+}
+
+// m measures the number of consonant sequences between 0 and j. With c a
+// consonant sequence and v a vowel sequence, every stem has the form
+// [c](vc){m}[v].
+func (p *porter) m() int {
+	n := 0
+	i := 0
+	for {
+		if i > p.j {
+			return n
+		}
+		if !p.cons(i) {
+			break
+		}
+		i++
+	}
+	i++
+
+	for {
+		for {
+			if i > p.j {
+				return n
+			}
+			if p.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+		n++
+
+		for {
+			if i > p.j {
+				return n
+			}
+			if !p.cons(i) {
+				break
+			}
+			i++
+		}
+		i++
+	}
+}
+
+// vowelInStem is true if b[0:j+1] contains a vowel
+func (p *porter) vowelInStem() bool {
+	for i := 0; i <= p.j; i++ {
+		if !p.cons(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// doubleC is true if b[j-1:j+1] is a double consonant
+func (p *porter) doubleC(j int) bool {
+	if j < 1 || p.b[j] != p.b[j-1] {
+		return false
+	}
+	return p.cons(j)
+}
+
+// cvc is true if b[i-2:i+1] has the form consonant - vowel - consonant and
+// the last consonant is not w, x or y. It is used to restore an e at the end
+// of short words, e.g. cav(e), lov(e), hop(e), crim(e), but snow, box, tray.
+func (p *porter) cvc(i int) bool {
+	if i < 2 || !p.cons(i) || p.cons(i-1) || !p.cons(i-2) {
+		return false
+	}
+
+	switch p.b[i] {
+	case 'w', 'x', 'y':
+		return false
+	default:
+		return true
+	}
+}
+
+// ends is true if b[0:k+1] ends with the suffix, in which case j is set to
+// the end of the stem without it
+func (p *porter) ends(suffix string) bool {
+	s := []rune(suffix)
+	if len(s) > p.k+1 {
+		return false
+	}
+
+	start := p.k + 1 - len(s)
+	for i, r := range s {
+		if p.b[start+i] != r {
+			return false
+		}
+	}
+
+	p.j = p.k - len(s)
+	return true
+}
+
+// setTo replaces b[j+1:k+1] with the replacement
+func (p *porter) setTo(replacement string) {
+	p.b = append(p.b[:p.j+1], []rune(replacement)...)
+	p.k = p.j + len([]rune(replacement))
+}
+
+// r replaces the suffix matched last if the stem has at least one consonant
+// sequence
+func (p *porter) r(replacement string) {
+	if p.m() > 0 {
+		p.setTo(replacement)
+	}
+}
+
+// replaceFirst applies r to the first of the suffixes which matches, pairs
+// is a list of suffix and replacement
+func (p *porter) replaceFirst(pairs ...string) {
+	for i := 0; i < len(pairs); i += 2 {
+		if p.ends(pairs[i]) {
+			p.r(pairs[i+1])
+			return
+		}
+	}
+}
+
+// step1ab gets rid of plurals and -ed or -ing, e.g.
+//
+//	caresses  ->  caress
+//	ponies    ->  poni
+//	meetings  ->  meet
+//	agreed    ->  agree
+//	hopping   ->  hop
+//	filing    ->  file
+func (p *porter) step1ab() {
+	if p.b[p.k] == 's' {
+		if p.ends("sses") {
+			p.k -= 2
+		} else if p.ends("ies") {
+			p.setTo("i")
+		} else if p.b[p.k-1] != 's' {
+			p.k--
+		}
+	}
+
+	if p.ends("eed") {
+		if p.m() > 0 {
+			p.k--
+		}
+		return
+	}
+
+	if (p.ends("ed") || p.ends("ing")) && p.vowelInStem() {
+		p.k = p.j
+		switch {
+		case p.ends("at"):
+			p.setTo("ate")
+		case p.ends("bl"):
+			p.setTo("ble")
+		case p.ends("iz"):
+			p.setTo("ize")
+		case p.doubleC(p.k):
+			p.k--
+			switch p.b[p.k] {
+			case 'l', 's', 'z':
+				p.k++
+			}
+		default:
+			p.j = p.k
+			if p.m() == 1 && p.cvc(p.k) {
+				p.setTo("e")
+			}
+		}
+	}
+}
+
+// step1c turns a terminal y into i if there is another vowel in the stem
+func (p *porter) step1c() {
+	if p.ends("y") && p.vowelInStem() {
+		p.b[p.k] = 'i'
+	}
+}
+
+// step2 maps double suffixes to single ones, e.g. -ization to -ize
+func (p *porter) step2() {
+	switch p.b[p.k-1] {
+	case 'a':
+		p.replaceFirst("ational", "ate", "tional", "tion")
+	case 'c':
+		p.replaceFirst("enci", "ence", "anci", "ance")
+	case 'e':
+		p.replaceFirst("izer", "ize")
+	case 'l':
+		p.replaceFirst("bli", "ble", "alli", "al", "entli", "ent", "eli", "e",
+			"ousli", "ous")
+	case 'o':
+		p.replaceFirst("ization", "ize", "ation", "ate", "ator", "ate")
+	case 's':
+		p.replaceFirst("alism", "al", "iveness", "ive", "fulness", "ful",
+			"ousness", "ous")
+	case 't':
+		p.replaceFirst("aliti", "al", "iviti", "ive", "biliti", "ble")
+	case 'g':
+		p.replaceFirst("logi", "log")
+	}
+}
+
+// step3 deals with -ic-, -full, -ness etc.
+func (p *porter) step3() {
+	switch p.b[p.k] {
+	case 'e':
+		p.replaceFirst("icate", "ic", "ative", "", "alize", "al")
+	case 'i':
+		p.replaceFirst("iciti", "ic")
+	case 'l':
+		p.replaceFirst("ical", "ic", "ful", "")
+	case 's':
+		p.replaceFirst("ness", "")
+	}
+}
+
+// step4 removes -ant, -ence etc. in the context of a stem with more than one
+// consonant sequence
+func (p *porter) step4() {
+	var matched bool
+	switch p.b[p.k-1] {
+	case 'a':
+		matched = p.ends("al")
+	case 'c':
+		matched = p.ends("ance") || p.ends("ence")
+	case 'e':
+		matched = p.ends("er")
+	case 'i':
+		matched = p.ends("ic")
+	case 'l':
+		matched = p.ends("able") || p.ends("ible")
+	case 'n':
+		matched = p.ends("ant") || p.ends("ement") || p.ends("ment") ||
+			p.ends("ent")
+	case 'o':
+		matched = (p.ends("ion") && p.j >= 0 &&
+			(p.b[p.j] == 's' || p.b[p.j] == 't')) || p.ends("ou")
+	case 's':
+		matched = p.ends("ism")
+	case 't':
+		matched = p.ends("ate") || p.ends("iti")
+	case 'u':
+		matched = p.ends("ous")
+	case 'v':
+		matched = p.ends("ive")
+	case 'z':
+		matched = p.ends("ize")
+	}
+
+	if matched && p.m() > 1 {
+		p.k = p.j
+	}
+}
+
+// step5 removes a final -e if there is more than one consonant sequence and
+// changes -ll to -l in that case
+func (p *porter) step5() {
+	p.j = p.k
+	if p.b[p.k] == 'e' {
+		a := p.m()
+		if a > 1 || (a == 1 && !p.cvc(p.k-1)) {
+			p.k--
+		}
+	}
+
+	if p.b[p.k] == 'l' && p.doubleC(p.k) && p.m() > 1 {
+		p.k--
+	}
+}
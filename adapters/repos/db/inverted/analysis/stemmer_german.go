@@ -0,0 +1,159 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package analysis
+
+import "strings"
+
+// stemGerman implements the German Snowball stemming algorithm, see
+// https://snowballstem.org/algorithms/german/stemmer.html. The term is
+// expected to be lowercased.
+func stemGerman(term string) string {
+	w := []rune(strings.ReplaceAll(term, "ß", "ss"))
+
+	// u and y between vowels are treated as consonants
+	for i := 1; i < len(w)-1; i++ {
+		if isGermanVowel(w[i-1]) && isGermanVowel(w[i+1]) {
+			switch w[i] {
+			case 'u':
+				w[i] = 'U'
+			case 'y':
+				w[i] = 'Y'
+			}
+		}
+	}
+
+	r1, r2 := regions(w, isGermanVowel)
+	if r1 < 3 {
+		r1 = 3
+	}
+
+	w = germanStep1(w, r1)
+	w = germanStep2(w, r1)
+	w = germanStep3(w, r1, r2)
+
+	for i, r := range w {
+		switch r {
+		case 'U':
+			w[i] = 'u'
+		case 'Y':
+			w[i] = 'y'
+		case 'ä':
+			w[i] = 'a'
+		case 'ö':
+			w[i] = 'o'
+		case 'ü':
+			w[i] = 'u'
+		}
+	}
+
+	return string(w)
+}
+
+func isGermanVowel(r rune) bool {
+	switch r {
+	case 'a', 'e', 'i', 'o', 'u', 'y', 'ä', 'ö', 'ü':
+		return true
+	default:
+		return false
+	}
+}
+
+func isGermanSEnding(r rune) bool {
+	switch r {
+	case 'b', 'd', 'f', 'g', 'h', 'k', 'l', 'm', 'n', 'r', 't':
+		return true
+	default:
+		return false
+	}
+}
+
+func isGermanSTEnding(r rune) bool {
+	return r != 'r' && isGermanSEnding(r)
+}
+
+func germanStep1(w []rune, r1 int) []rune {
+	suffix := longestSuffix(w, "em", "ern", "er", "e", "en", "es", "s")
+	switch suffix {
+	case "":
+		return w
+	case "s":
+		if len(w) < 2 || !isGermanSEnding(w[len(w)-2]) {
+			return w
+		}
+	}
+
+	if !inRegion(w, suffix, r1) {
+		return w
+	}
+
+	w = trimSuffix(w, suffix)
+	switch suffix {
+	case "e", "en", "es":
+		if hasSuffix(w, "niss") {
+			w = w[:len(w)-1]
+		}
+	}
+
+	return w
+}
+
+func germanStep2(w []rune, r1 int) []rune {
+	suffix := longestSuffix(w, "en", "er", "est", "st")
+	switch suffix {
+	case "":
+		return w
+	case "st":
+		// st needs to be preceded by a valid st-ending, which itself needs to
+		// be preceded by at least three letters
+		if len(w) < 6 || !isGermanSTEnding(w[len(w)-3]) {
+			return w
+		}
+	}
+
+	if !inRegion(w, suffix, r1) {
+		return w
+	}
+
+	return trimSuffix(w, suffix)
+}
+
+func germanStep3(w []rune, r1, r2 int) []rune {
+	suffix := longestSuffix(w, "end", "ung", "ig", "ik", "isch", "lich", "heit",
+		"keit")
+	if suffix == "" || !inRegion(w, suffix, r2) {
+		return w
+	}
+
+	switch suffix {
+	case "end", "ung":
+		w = trimSuffix(w, suffix)
+		if hasSuffix(w, "ig") && !hasSuffix(w, "eig") && inRegion(w, "ig", r2) {
+			w = trimSuffix(w, "ig")
+		}
+	case "ig", "ik", "isch":
+		if !hasSuffix(w, "e"+suffix) {
+			w = trimSuffix(w, suffix)
+		}
+	case "lich", "heit":
+		w = trimSuffix(w, suffix)
+		if pre := longestSuffix(w, "er", "en"); pre != "" && inRegion(w, pre, r1) {
+			w = trimSuffix(w, pre)
+		}
+	case "keit":
+		w = trimSuffix(w, suffix)
+		if pre := longestSuffix(w, "lich", "ig"); pre != "" && inRegion(w, pre, r2) {
+			w = trimSuffix(w, pre)
+		}
+	}
+
+	return w
+}
@@ -0,0 +1,79 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package analysis
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStemmers(t *testing.T) {
+	type test struct {
+		in       string
+		expected string
+	}
+
+	t.Run("english", func(t *testing.T) {
+		tests := []test{
+			{in: "caresses", expected: "caress"},
+			{in: "ponies", expected: "poni"},
+			{in: "cats", expected: "cat"},
+			{in: "agreed", expected: "agre"},
+			{in: "plastered", expected: "plaster"},
+			{in: "motoring", expected: "motor"},
+			{in: "hopping", expected: "hop"},
+			{in: "filing", expected: "file"},
+			{in: "happy", expected: "happi"},
+			{in: "relational", expected: "relat"},
+			{in: "generalization", expected: "gener"},
+			{in: "running", expected: "run"},
+			{in: "runs", expected: "run"},
+			{in: "run", expected: "run"},
+			{in: "is", expected: "is"},
+		}
+
+		for _, test := range tests {
+			assert.Equal(t, test.expected, stemEnglish(test.in), test.in)
+		}
+	})
+
+	t.Run("german", func(t *testing.T) {
+		tests := []test{
+			{in: "häuser", expected: "haus"},
+			{in: "laufen", expected: "lauf"},
+			{in: "kategorien", expected: "kategori"},
+			{in: "aufeinanderfolgenden", expected: "aufeinanderfolg"},
+			{in: "freundlichkeit", expected: "freundlich"},
+			{in: "straße", expected: "strass"},
+		}
+
+		for _, test := range tests {
+			assert.Equal(t, test.expected, stemGerman(test.in), test.in)
+		}
+	})
+
+	t.Run("dutch", func(t *testing.T) {
+		tests := []test{
+			{in: "boeken", expected: "boek"},
+			{in: "katten", expected: "kat"},
+			{in: "fietsen", expected: "fiets"},
+			{in: "opgaven", expected: "opgav"},
+			{in: "lichamelijk", expected: "licham"},
+			{in: "maan", expected: "man"},
+		}
+
+		for _, test := range tests {
+			assert.Equal(t, test.expected, stemDutch(test.in), test.in)
+		}
+	})
+}
@@ -0,0 +1,85 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package analysis
+
+import (
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// stopwordPresets are the stopword lists of the supported languages, they
+// are based on the Snowball stopword lists
+var stopwordPresets = map[string][]string{
+	models.StopwordConfigPresetEnglish: strings.Fields(`
+		a an and are as at be but by for if in into is it no not of on or such
+		that the their then there these they this to was will with
+	`),
+	models.StopwordConfigPresetGerman: strings.Fields(`
+		aber alle allem allen aller alles als also am an ander andere anderem
+		anderen anderer anderes anderm andern anderr anders auch auf aus bei
+		bin bis bist da damit dann der den des dem die das dass daß derselbe
+		derselben denselben desselben demselben dieselbe dieselben dasselbe
+		dazu dein deine deinem deinen deiner deines denn derer dessen dich dir
+		du dies diese diesem diesen dieser dieses doch dort durch ein eine
+		einem einen einer eines einig einige einigem einigen einiger einiges
+		einmal er ihn ihm es etwas euer eure eurem euren eurer eures für gegen
+		gewesen hab habe haben hat hatte hatten hier hin hinter ich mich mir
+		ihr ihre ihrem ihren ihrer ihres euch im in indem ins ist jede jedem
+		jeden jeder jedes jene jenem jenen jener jenes jetzt kann kein keine
+		keinem keinen keiner keines können könnte machen man manche manchem
+		manchen mancher manches mein meine meinem meinen meiner meines mit
+		muss musste nach nicht nichts noch nun nur ob oder ohne sehr sein
+		seine seinem seinen seiner seines selbst sich sie ihnen sind so solche
+		solchem solchen solcher solches soll sollte sondern sonst über um und
+		uns unsere unserem unseren unser unseres unter viel vom von vor
+		während war waren warst was weg weil weiter welche welchem welchen
+		welcher welches wenn werde werden wie wieder will wir wird wirst wo
+		wollen wollte würde würden zu zum zur zwar zwischen
+	`),
+	models.StopwordConfigPresetDutch: strings.Fields(`
+		de en van ik te dat die in een hij het niet zijn is was op aan met als
+		voor had er maar om hem dan zou of wat mijn men dit zo door over ze
+		zich bij ook tot je mij uit der daar haar naar heb hoe heeft hebben
+		deze u want nog zal me zij nu ge geen omdat iets worden toch al waren
+		veel meer doen toen moet ben zonder kan hun dus alles onder ja eens
+		hier wie werd altijd doch wordt wezen kunnen ons zelf tegen na reeds
+		wil kon niets uw iemand geweest andere
+	`),
+}
+
+// stopwords returns the set of stopwords of the config, the terms are
+// lowercased as the analyzer only applies to lowercased tokenizations
+func stopwords(cfg *models.StopwordConfig) map[string]struct{} {
+	if cfg == nil {
+		return nil
+	}
+
+	out := map[string]struct{}{}
+	for _, word := range stopwordPresets[cfg.Preset] {
+		out[word] = struct{}{}
+	}
+
+	for _, word := range cfg.Additions {
+		out[strings.ToLower(word)] = struct{}{}
+	}
+
+	for _, word := range cfg.Removals {
+		delete(out, strings.ToLower(word))
+	}
+
+	if len(out) == 0 {
+		return nil
+	}
+
+	return out
+}
@@ -14,10 +14,11 @@ package inverted
 import (
 	"bytes"
 	"encoding/binary"
-	"strings"
 
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 )
 
 type Countable struct {
@@ -29,52 +30,49 @@ type Property struct {
 	Name         string
 	Items        []Countable
 	HasFrequency bool
+	// HasTrigramIndex is set for string and text props with indexTrigrams, the
+	// trigrams of their terms are indexed in addition to the terms
+	HasTrigramIndex bool
 }
 
-type Analyzer struct{}
+type Analyzer struct {
+	chains              *analysis.Chains
+	indexNullState      bool
+	indexPropertyLength bool
+	indexTimestamps     bool
+}
 
 // Text removes non alpha-numeric and splits into words, then aggregates
 // duplicates
 func (a *Analyzer) Text(in string) []Countable {
-	parts := helpers.TokenizeText(in)
-	terms := map[string]uint64{}
-	total := 0
-	for _, word := range parts {
-		word = strings.ToLower(word)
-		count, ok := terms[word]
-		if !ok {
-			terms[word] = 0
-		}
-		terms[word] = count + 1
-		total++
-	}
-
-	out := make([]Countable, len(terms))
-	i := 0
-	for term, count := range terms {
-		out[i] = Countable{
-			Data:          []byte(term),
-			TermFrequency: float64(count) / float64(total),
-		}
-		i++
-	}
-
-	return out
+	return a.Tokenized(models.PropertyTokenizationWord, in)
 }
 
 // String splits only on spaces and does not lowercase, then aggregates
 // duplicates
 func (a *Analyzer) String(in string) []Countable {
-	parts := helpers.TokenizeString(in)
+	return a.Tokenized(models.PropertyTokenizationWhitespace, in)
+}
+
+// Tokenized splits the values into terms as specified by the tokenization,
+// then aggregates duplicates. If there are multiple values, such as the
+// elements of an array prop, the term frequencies are relative to the terms
+// of all values.
+func (a *Analyzer) Tokenized(tokenization string, in ...string) []Countable {
+	return a.analyzed(tokenization, nil, in)
+}
+
+// analyzed is like Tokenized, but also applies the analyzer chain to the
+// terms
+func (a *Analyzer) analyzed(tokenization string, chain *analysis.Chain,
+	in []string) []Countable {
 	terms := map[string]uint64{}
 	total := 0
-	for _, word := range parts {
-		count, ok := terms[word]
-		if !ok {
-			terms[word] = 0
+	for _, value := range in {
+		for _, term := range chain.Terms(helpers.Tokenize(tokenization, value)) {
+			terms[term]++
+			total++
 		}
-		terms[word] = count + 1
-		total++
 	}
 
 	out := make([]Countable, len(terms))
@@ -90,6 +88,13 @@ func (a *Analyzer) String(in string) []Countable {
 	return out
 }
 
+// analyzedProp tokenizes the values of a string or text prop and applies the
+// analyzer chain configured for the prop
+func (a *Analyzer) analyzedProp(prop *models.Property, in ...string) []Countable {
+	tokenization := schema.PropertyTokenization(prop)
+	return a.analyzed(tokenization, a.chains.ForProperty(prop.Name, tokenization), in)
+}
+
 // Int requires no analysis, so it's actually just a simple conversion to a
 // string-formatted byte slice of the int
 func (a *Analyzer) Int(in int64) ([]Countable, error) {
@@ -165,6 +170,31 @@ func (a *Analyzer) Ref(in models.MultipleRef) ([]Countable, error) {
 	return out, nil
 }
 
-func NewAnalyzer() *Analyzer {
-	return &Analyzer{}
+// NewAnalyzer creates an analyzer which applies the given analyzer chains to
+// the terms of string and text props. The chains may be nil, in which case the
+// terms are only tokenized.
+func NewAnalyzer(chains *analysis.Chains) *Analyzer {
+	return &Analyzer{chains: chains}
+}
+
+// WithNullState makes the analyzer additionally index whether each prop of
+// an object is null, so objects can be filtered with the IsNull operator
+func (a *Analyzer) WithNullState(indexNullState bool) *Analyzer {
+	a.indexNullState = indexNullState
+	return a
+}
+
+// WithPropertyLength makes the analyzer additionally index the length of the
+// string, text and array props of an object, so objects can be filtered by
+// it
+func (a *Analyzer) WithPropertyLength(indexPropertyLength bool) *Analyzer {
+	a.indexPropertyLength = indexPropertyLength
+	return a
+}
+
+// WithTimestamps makes the analyzer additionally index the creation and last
+// update timestamps of an object, see Timestamps
+func (a *Analyzer) WithTimestamps(indexTimestamps bool) *Analyzer {
+	a.indexTimestamps = indexTimestamps
+	return a
 }
@@ -23,7 +23,7 @@ import (
 )
 
 func TestAnalyzer(t *testing.T) {
-	a := NewAnalyzer()
+	a := NewAnalyzer(nil)
 
 	t.Run("with text", func(t *testing.T) {
 		t.Run("only unique words", func(t *testing.T) {
@@ -101,6 +101,61 @@ func TestAnalyzer(t *testing.T) {
 		})
 	})
 
+	t.Run("with tokenization", func(t *testing.T) {
+		in := "Hello, hello World"
+
+		tests := []struct {
+			tokenization string
+			expected     []Countable
+		}{
+			{
+				tokenization: models.PropertyTokenizationWord,
+				expected: []Countable{
+					{Data: []byte("hello"), TermFrequency: float64(2) / 3},
+					{Data: []byte("world"), TermFrequency: float64(1) / 3},
+				},
+			},
+			{
+				tokenization: models.PropertyTokenizationWhitespace,
+				expected: []Countable{
+					{Data: []byte("Hello,"), TermFrequency: float64(1) / 3},
+					{Data: []byte("hello"), TermFrequency: float64(1) / 3},
+					{Data: []byte("World"), TermFrequency: float64(1) / 3},
+				},
+			},
+			{
+				tokenization: models.PropertyTokenizationField,
+				expected: []Countable{
+					{Data: []byte("Hello, hello World"), TermFrequency: 1},
+				},
+			},
+			{
+				tokenization: models.PropertyTokenizationLowercase,
+				expected: []Countable{
+					{Data: []byte("hello,"), TermFrequency: float64(1) / 3},
+					{Data: []byte("hello"), TermFrequency: float64(1) / 3},
+					{Data: []byte("world"), TermFrequency: float64(1) / 3},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.tokenization, func(t *testing.T) {
+				res := a.Tokenized(test.tokenization, in)
+				assert.ElementsMatch(t, test.expected, res)
+			})
+		}
+
+		t.Run("with multiple values", func(t *testing.T) {
+			res := a.Tokenized(models.PropertyTokenizationField, "New York", "Berlin",
+				"New York")
+			assert.ElementsMatch(t, []Countable{
+				{Data: []byte("New York"), TermFrequency: float64(2) / 3},
+				{Data: []byte("Berlin"), TermFrequency: float64(1) / 3},
+			}, res)
+		})
+	})
+
 	t.Run("with int it stays sortable", func(t *testing.T) {
 		getData := func(in []Countable, err error) []byte {
 			require.Nil(t, err)
@@ -49,16 +49,18 @@ func Delta(previous, next []Property) DeltaResults {
 		toAdd, toDelete := countableDelta(prev.Items, nextProp.Items)
 		if len(toAdd) > 0 {
 			out.ToAdd = append(out.ToAdd, Property{
-				Name:         nextProp.Name,
-				Items:        toAdd,
-				HasFrequency: nextProp.HasFrequency,
+				Name:            nextProp.Name,
+				Items:           toAdd,
+				HasFrequency:    nextProp.HasFrequency,
+				HasTrigramIndex: nextProp.HasTrigramIndex,
 			})
 		}
 		if len(toDelete) > 0 {
 			out.ToDelete = append(out.ToDelete, Property{
-				Name:         nextProp.Name,
-				Items:        toDelete,
-				HasFrequency: nextProp.HasFrequency,
+				Name:            nextProp.Name,
+				Items:           toDelete,
+				HasFrequency:    nextProp.HasFrequency,
+				HasTrigramIndex: nextProp.HasTrigramIndex,
 			})
 		}
 	}
@@ -13,7 +13,9 @@ package inverted
 
 import (
 	"fmt"
+	"reflect"
 	"time"
+	"unicode/utf8"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/pkg/errors"
@@ -41,9 +43,115 @@ func (a *Analyzer) Object(input map[string]interface{}, props []*models.Property
 
 	properties = append(properties, *property)
 
+	if a.indexNullState {
+		properties = append(properties, a.analyzeNullState(props, input)...)
+	}
+
+	if a.indexPropertyLength {
+		lengths, err := a.analyzePropertyLength(props, input)
+		if err != nil {
+			return nil, errors.Wrap(err, "analyze property length")
+		}
+		properties = append(properties, lengths...)
+	}
+
 	return properties, nil
 }
 
+// analyzeNullState indexes for every prop whether it is null in the object.
+// A prop which is not set or which is an empty list is null as well.
+func (a *Analyzer) analyzeNullState(props []*models.Property,
+	input map[string]interface{}) []Property {
+	var out []Property
+	for _, prop := range props {
+		if len(prop.DataType) < 1 || schema.IsBlobDataType(prop.DataType) {
+			continue
+		}
+
+		if prop.IndexInverted != nil && !*prop.IndexInverted {
+			continue
+		}
+
+		// encoding a bool cannot fail
+		items, _ := a.Bool(isNullValue(input[prop.Name]))
+		out = append(out, Property{
+			Name:         helpers.NullStateProp(prop.Name),
+			Items:        items,
+			HasFrequency: false,
+		})
+	}
+
+	return out
+}
+
+// analyzePropertyLength indexes the length of every string and text prop in
+// characters and of every array prop in elements. A prop which is not set
+// has a length of 0.
+func (a *Analyzer) analyzePropertyLength(props []*models.Property,
+	input map[string]interface{}) ([]Property, error) {
+	var out []Property
+	for _, prop := range props {
+		if len(prop.DataType) < 1 || !HasPropertyLength(schema.DataType(prop.DataType[0])) {
+			continue
+		}
+
+		if prop.IndexInverted != nil && !*prop.IndexInverted {
+			continue
+		}
+
+		length, err := propertyLength(input[prop.Name])
+		if err != nil {
+			return nil, errors.Wrapf(err, "prop %q", prop.Name)
+		}
+
+		data, err := LexicographicallySortableUint64(length)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, Property{
+			Name:         helpers.PropertyLengthProp(prop.Name),
+			Items:        []Countable{{Data: data}},
+			HasFrequency: false,
+		})
+	}
+
+	return out, nil
+}
+
+func propertyLength(value interface{}) (uint64, error) {
+	if value == nil {
+		return 0, nil
+	}
+
+	if asString, ok := value.(string); ok {
+		return uint64(utf8.RuneCountInString(asString)), nil
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice {
+		return 0, fmt.Errorf("expected a string or a list, but got %T", value)
+	}
+
+	return uint64(rv.Len()), nil
+}
+
+// HasPropertyLength checks whether the length of props of the data type can
+// be indexed, which is the case for string, text and array props
+func HasPropertyLength(dt schema.DataType) bool {
+	return dt == schema.DataTypeString || dt == schema.DataTypeText ||
+		schema.IsArrayDataType(dt)
+}
+
+func isNullValue(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+
+	rv := reflect.ValueOf(value)
+	return rv.Kind() == reflect.Slice && rv.Len() == 0
+}
+
 func (a *Analyzer) analyzeProps(propsMap map[string]*models.Property,
 	input map[string]interface{}) ([]Property, error) {
 	var out []Property
@@ -64,6 +172,14 @@ func (a *Analyzer) analyzeProps(propsMap map[string]*models.Property,
 			if err := a.extendPropertiesWithReference(&out, prop, input, key); err != nil {
 				return nil, err
 			}
+		} else if schema.IsNestedDataType(prop.DataType) {
+			if err := a.extendPropertiesWithNested(&out, prop, input, key); err != nil {
+				return nil, err
+			}
+		} else if schema.IsArrayDataType(schema.DataType(prop.DataType[0])) {
+			if err := a.extendPropertiesWithArray(&out, prop, input, key); err != nil {
+				return nil, err
+			}
 		} else {
 			if err := a.extendPropertiesWithPrimitive(&out, prop, input, key); err != nil {
 				return nil, err
@@ -74,6 +190,36 @@ func (a *Analyzer) analyzeProps(propsMap map[string]*models.Property,
 	return out, nil
 }
 
+// Timestamps analyzes the creation and last update timestamps of an object,
+// which are not part of its props. Nothing is indexed unless the analyzer was
+// created WithTimestamps.
+func (a *Analyzer) Timestamps(creationTimeUnix,
+	lastUpdateTimeUnix int64) ([]Property, error) {
+	if !a.indexTimestamps {
+		return nil, nil
+	}
+
+	creation, err := LexicographicallySortableInt64(creationTimeUnix)
+	if err != nil {
+		return nil, errors.Wrap(err, "creation time")
+	}
+
+	lastUpdate, err := LexicographicallySortableInt64(lastUpdateTimeUnix)
+	if err != nil {
+		return nil, errors.Wrap(err, "last update time")
+	}
+
+	return []Property{{
+		Name:         helpers.PropertyNameCreationTimeUnix,
+		Items:        []Countable{{Data: creation}},
+		HasFrequency: false,
+	}, {
+		Name:         helpers.PropertyNameLastUpdateTimeUnix,
+		Items:        []Countable{{Data: lastUpdate}},
+		HasFrequency: false,
+	}}, nil
+}
+
 func (a *Analyzer) analyzeIDProp(id strfmt.UUID) (*Property, error) {
 	value, err := id.MarshalText()
 	if err != nil {
@@ -114,7 +260,174 @@ func (a *Analyzer) extendPropertiesWithPrimitive(properties *[]Property,
 	return nil
 }
 
+// extendPropertiesWithNested mutates the passed in properties, by extending
+// it with one property per nested primitive property, such as
+// "address.city". The values of all nested objects of an object[] prop are
+// combined, so that a filter matches if any of the nested objects matches.
+func (a *Analyzer) extendPropertiesWithNested(properties *[]Property,
+	prop *models.Property, input map[string]interface{}, propName string) error {
+	value, ok := input[propName]
+	if !ok {
+		// skip any nested prop that's not set
+		return nil
+	}
+
+	values := map[string][]interface{}{}
+	collectNestedValues(propName, value, values)
+
+	for _, nestedProp := range schema.FlattenNestedProperties(prop) {
+		nestedValues, ok := values[nestedProp.Name]
+		if !ok {
+			continue
+		}
+
+		property, err := a.analyzeMultiValueProp(nestedProp, nestedValues)
+		if err != nil {
+			return errors.Wrapf(err, "analyze nested prop %q", nestedProp.Name)
+		}
+		if property == nil {
+			continue
+		}
+
+		*properties = append(*properties, *property)
+	}
+
+	return nil
+}
+
+// collectNestedValues groups the primitive values of nested objects by the
+// path of their nested property
+func collectNestedValues(path string, value interface{},
+	out map[string][]interface{}) {
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for key, nestedValue := range typed {
+			collectNestedValues(schema.NestedPropertyPath(path, key), nestedValue, out)
+		}
+	case []interface{}:
+		for _, elem := range typed {
+			collectNestedValues(path, elem, out)
+		}
+	default:
+		out[path] = append(out[path], value)
+	}
+}
+
+// extendPropertiesWithArray mutates the passed in properties, by extending
+// it with an additional property - if applicable. Each element of an array
+// prop, such as string[], is indexed, so that a filter on the prop matches if
+// any of the elements matches.
+func (a *Analyzer) extendPropertiesWithArray(properties *[]Property,
+	prop *models.Property, input map[string]interface{}, propName string) error {
+	value, ok := input[propName]
+	if !ok {
+		// skip any array prop that's not set
+		return nil
+	}
+
+	elemType, _ := schema.ArrayElementDataType(schema.DataType(prop.DataType[0]))
+	elemProp := &models.Property{
+		Name:          prop.Name,
+		DataType:      []string{string(elemType)},
+		Tokenization:  prop.Tokenization,
+		IndexTrigrams: prop.IndexTrigrams,
+	}
+
+	rv := reflect.ValueOf(value)
+	if rv.Kind() != reflect.Slice {
+		return fmt.Errorf("expected property %s to be a list, but got %T", prop.Name, value)
+	}
+
+	values := make([]interface{}, rv.Len())
+	for i := range values {
+		values[i] = rv.Index(i).Interface()
+	}
+
+	property, err := a.analyzeMultiValueProp(elemProp, values)
+	if err != nil {
+		return errors.Wrapf(err, "analyze array prop %q", prop.Name)
+	}
+	if property == nil {
+		return nil
+	}
+
+	*properties = append(*properties, *property)
+	return nil
+}
+
+// analyzeMultiValueProp analyzes several values of the same property, such as
+// the elements of an array prop or the values of a nested prop across all
+// nested objects, into a single property
+func (a *Analyzer) analyzeMultiValueProp(prop *models.Property,
+	values []interface{}) (*Property, error) {
+	dt := schema.DataType(prop.DataType[0])
+	if HasFrequency(dt) {
+		// analyze all values at once, so that the term frequencies are
+		// aggregated across all values
+		parts := make([]string, len(values))
+		for i, value := range values {
+			asString, ok := value.(string)
+			if !ok {
+				return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
+			}
+			parts[i] = asString
+		}
+
+		return &Property{
+			Name:            prop.Name,
+			Items:           a.analyzedProp(prop, parts...),
+			HasFrequency:    true,
+			HasTrigramIndex: prop.IndexTrigrams,
+		}, nil
+	}
+
+	var out *Property
+	seen := map[string]struct{}{}
+	for _, value := range values {
+		if asString, ok := value.(string); ok && dt == schema.DataTypeDate {
+			// dates within lists or nested objects of objects read from disk are
+			// still in their marshalled form
+			asTime, err := time.Parse(time.RFC3339Nano, asString)
+			if err != nil {
+				return nil, errors.Wrapf(err, "parse date of property %s", prop.Name)
+			}
+			value = asTime
+		}
+
+		property, err := a.analyzePrimitiveProp(prop, value)
+		if err != nil {
+			return nil, err
+		}
+		if property == nil {
+			continue
+		}
+
+		if out == nil {
+			out = &Property{
+				Name:            property.Name,
+				HasFrequency:    property.HasFrequency,
+				HasTrigramIndex: property.HasTrigramIndex,
+			}
+		}
+
+		for _, item := range property.Items {
+			if _, ok := seen[string(item.Data)]; ok {
+				continue
+			}
+
+			seen[string(item.Data)] = struct{}{}
+			out.Items = append(out.Items, item)
+		}
+	}
+
+	return out, nil
+}
+
 func HasFrequency(dt schema.DataType) bool {
+	if elemType, ok := schema.ArrayElementDataType(dt); ok {
+		dt = elemType
+	}
+
 	if dt == schema.DataTypeText || dt == schema.DataTypeString {
 		return true
 	}
@@ -133,14 +446,14 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value interface{}
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.Text(asString)
+		items = a.analyzedProp(prop, asString)
 	case schema.DataTypeString:
 		hasFrequency = HasFrequency(dt)
 		asString, ok := value.(string)
 		if !ok {
 			return nil, fmt.Errorf("expected property %s to be of type string, but got %T", prop.Name, value)
 		}
-		items = a.String(asString)
+		items = a.analyzedProp(prop, asString)
 	case schema.DataTypeInt:
 		hasFrequency = HasFrequency(dt)
 		if asFloat, ok := value.(float64); ok {
@@ -202,9 +515,10 @@ func (a *Analyzer) analyzePrimitiveProp(prop *models.Property, value interface{}
 	}
 
 	return &Property{
-		Name:         prop.Name,
-		Items:        items,
-		HasFrequency: hasFrequency,
+		Name:            prop.Name,
+		Items:           items,
+		HasFrequency:    hasFrequency,
+		HasTrigramIndex: hasFrequency && prop.IndexTrigrams,
 	}, nil
 }
 
@@ -13,16 +13,18 @@ package inverted
 
 import (
 	"testing"
+	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestAnalyzeObject(t *testing.T) {
-	a := NewAnalyzer()
+	a := NewAnalyzer(nil)
 
 	t.Run("with multiple properties", func(t *testing.T) {
 		schema := map[string]interface{}{
@@ -256,4 +258,315 @@ func TestAnalyzeObject(t *testing.T) {
 			assert.ElementsMatch(t, expectedUUID, actualUUID, res)
 		})
 	})
+
+	t.Run("with nested objects", func(t *testing.T) {
+		schema := map[string]interface{}{
+			"addresses": []interface{}{
+				map[string]interface{}{
+					"city":   "Amsterdam",
+					"number": float64(7),
+				},
+				map[string]interface{}{
+					"city":   "Amsterdam",
+					"number": float64(7),
+					"location": map[string]interface{}{
+						"floor": int64(3),
+					},
+				},
+			},
+		}
+
+		uuid := "2609f1bc-7693-48f3-b531-6ddc52cd2501"
+		props := []*models.Property{
+			{
+				Name:     "addresses",
+				DataType: []string{"object[]"},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: []string{"string"}},
+					{Name: "number", DataType: []string{"int"}},
+					{Name: "street", DataType: []string{"string"}},
+					{
+						Name:     "location",
+						DataType: []string{"object"},
+						NestedProperties: []*models.NestedProperty{
+							{Name: "floor", DataType: []string{"int"}},
+						},
+					},
+				},
+			},
+		}
+		res, err := a.Object(schema, props, strfmt.UUID(uuid))
+		require.Nil(t, err)
+
+		seven, err := LexicographicallySortableInt64(7)
+		require.Nil(t, err)
+		three, err := LexicographicallySortableInt64(3)
+		require.Nil(t, err)
+
+		expected := map[string][]Countable{
+			"addresses.city": {
+				{Data: []byte("Amsterdam"), TermFrequency: 1},
+			},
+			"addresses.number":         {{Data: seven}},
+			"addresses.location.floor": {{Data: three}},
+			"_id":                      {{Data: []byte(uuid)}},
+		}
+
+		require.Len(t, res, len(expected))
+		for _, elem := range res {
+			expectedItems, ok := expected[elem.Name]
+			require.True(t, ok, "unexpected prop %q", elem.Name)
+			assert.ElementsMatch(t, expectedItems, elem.Items, elem.Name)
+		}
+	})
+
+	t.Run("with array props", func(t *testing.T) {
+		schema := map[string]interface{}{
+			"tags":   []interface{}{"green", "blue", "green"},
+			"scores": []interface{}{float64(7), int64(3)},
+			"dates":  []interface{}{"2021-01-01T00:00:00Z"},
+			"flags":  []interface{}{},
+		}
+
+		uuid := "2609f1bc-7693-48f3-b531-6ddc52cd2501"
+		props := []*models.Property{
+			{Name: "tags", DataType: []string{"string[]"}},
+			{Name: "scores", DataType: []string{"int[]"}},
+			{Name: "dates", DataType: []string{"date[]"}},
+			{Name: "flags", DataType: []string{"boolean[]"}},
+		}
+		res, err := a.Object(schema, props, strfmt.UUID(uuid))
+		require.Nil(t, err)
+
+		seven, err := LexicographicallySortableInt64(7)
+		require.Nil(t, err)
+		three, err := LexicographicallySortableInt64(3)
+		require.Nil(t, err)
+		date, err := LexicographicallySortableInt64(
+			time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC).UnixNano())
+		require.Nil(t, err)
+
+		expected := map[string][]Countable{
+			"tags": {
+				{Data: []byte("green"), TermFrequency: float64(2) / 3},
+				{Data: []byte("blue"), TermFrequency: float64(1) / 3},
+			},
+			"scores": {{Data: seven}, {Data: three}},
+			"dates":  {{Data: date}},
+			"_id":    {{Data: []byte(uuid)}},
+		}
+
+		require.Len(t, res, len(expected))
+		for _, elem := range res {
+			expectedItems, ok := expected[elem.Name]
+			require.True(t, ok, "unexpected prop %q", elem.Name)
+			assert.ElementsMatch(t, expectedItems, elem.Items, elem.Name)
+		}
+	})
+	t.Run("with tokenization", func(t *testing.T) {
+		schema := map[string]interface{}{
+			"title":  "The Lord of the Rings",
+			"author": "J.R.R. Tolkien",
+			"cities": []interface{}{"New York", "Berlin"},
+		}
+
+		uuid := "2609f1bc-7693-48f3-b531-6ddc52cd2501"
+		props := []*models.Property{
+			{Name: "title", DataType: []string{"text"}, Tokenization: "field"},
+			{Name: "author", DataType: []string{"string"}, Tokenization: "lowercase"},
+			{Name: "cities", DataType: []string{"text[]"}, Tokenization: "field"},
+		}
+		res, err := a.Object(schema, props, strfmt.UUID(uuid))
+		require.Nil(t, err)
+
+		expected := map[string][]Countable{
+			"title": {
+				{Data: []byte("The Lord of the Rings"), TermFrequency: 1},
+			},
+			"author": {
+				{Data: []byte("j.r.r."), TermFrequency: float64(1) / 2},
+				{Data: []byte("tolkien"), TermFrequency: float64(1) / 2},
+			},
+			"cities": {
+				{Data: []byte("New York"), TermFrequency: float64(1) / 2},
+				{Data: []byte("Berlin"), TermFrequency: float64(1) / 2},
+			},
+			"_id": {{Data: []byte(uuid)}},
+		}
+
+		require.Len(t, res, len(expected))
+		for _, elem := range res {
+			expectedItems, ok := expected[elem.Name]
+			require.True(t, ok, "unexpected prop %q", elem.Name)
+			assert.ElementsMatch(t, expectedItems, elem.Items, elem.Name)
+		}
+	})
+
+	t.Run("with analyzer chains", func(t *testing.T) {
+		chains := analysis.NewChains(&models.InvertedIndexConfig{
+			Analyzer: &models.AnalyzerConfig{
+				Stemmer: models.AnalyzerConfigStemmerEnglish,
+				Stopwords: &models.StopwordConfig{
+					Preset: models.StopwordConfigPresetEnglish,
+				},
+			},
+			PropertyAnalyzers: map[string]models.AnalyzerConfig{
+				"city": {ASCIIFolding: true},
+			},
+		})
+
+		schema := map[string]interface{}{
+			"description": "The dog is running with the dogs",
+			"city":        "Zürich",
+			"code":        "Running Dogs",
+		}
+
+		uuid := "2609f1bc-7693-48f3-b531-6ddc52cd2501"
+		props := []*models.Property{
+			{Name: "description", DataType: []string{"text"}},
+			{Name: "city", DataType: []string{"string"}, Tokenization: "lowercase"},
+			{Name: "code", DataType: []string{"text"}, Tokenization: "field"},
+		}
+		res, err := NewAnalyzer(chains).Object(schema, props, strfmt.UUID(uuid))
+		require.Nil(t, err)
+
+		expected := map[string][]Countable{
+			"description": {
+				{Data: []byte("dog"), TermFrequency: float64(2) / 3},
+				{Data: []byte("run"), TermFrequency: float64(1) / 3},
+			},
+			"city": {
+				{Data: []byte("zurich"), TermFrequency: 1},
+			},
+			"code": {
+				{Data: []byte("Running Dogs"), TermFrequency: 1},
+			},
+			"_id": {{Data: []byte(uuid)}},
+		}
+
+		require.Len(t, res, len(expected))
+		for _, elem := range res {
+			expectedItems, ok := expected[elem.Name]
+			require.True(t, ok, "unexpected prop %q", elem.Name)
+			assert.ElementsMatch(t, expectedItems, elem.Items, elem.Name)
+		}
+	})
+
+	t.Run("with null state", func(t *testing.T) {
+		noIndex := false
+		schema := map[string]interface{}{
+			"name":  "Jane",
+			"tags":  []interface{}{},
+			"empty": nil,
+		}
+
+		uuid := "2609f1bc-7693-48f3-b531-6ddc52cd2501"
+		props := []*models.Property{
+			{Name: "name", DataType: []string{"string"}, Tokenization: "field"},
+			{Name: "tags", DataType: []string{"string[]"}},
+			{Name: "empty", DataType: []string{"int"}},
+			{Name: "missing", DataType: []string{"number"}},
+			{Name: "notIndexed", DataType: []string{"int"}, IndexInverted: &noIndex},
+		}
+		res, err := NewAnalyzer(nil).WithNullState(true).
+			Object(schema, props, strfmt.UUID(uuid))
+		require.Nil(t, err)
+
+		isNull := []Countable{{Data: []byte{1}}}
+		isNotNull := []Countable{{Data: []byte{0}}}
+		expected := map[string][]Countable{
+			"name":                {{Data: []byte("Jane"), TermFrequency: 1}},
+			"tags":                {},
+			"name__null_state":    isNotNull,
+			"tags__null_state":    isNull,
+			"empty__null_state":   isNull,
+			"missing__null_state": isNull,
+			"_id":                 {{Data: []byte(uuid)}},
+		}
+
+		require.Len(t, res, len(expected))
+		for _, elem := range res {
+			expectedItems, ok := expected[elem.Name]
+			require.True(t, ok, "unexpected prop %q", elem.Name)
+			assert.ElementsMatch(t, expectedItems, elem.Items, elem.Name)
+		}
+	})
+
+	t.Run("with property length", func(t *testing.T) {
+		noIndex := false
+		schema := map[string]interface{}{
+			"name":    "Jürgen",
+			"tags":    []string{"a", "b", "c"},
+			"empty":   []interface{}{},
+			"ignored": "not indexed",
+		}
+
+		uuid := "2609f1bc-7693-48f3-b531-6ddc52cd2501"
+		props := []*models.Property{
+			{Name: "name", DataType: []string{"string"}, Tokenization: "field"},
+			{Name: "tags", DataType: []string{"string[]"}, Tokenization: "field"},
+			{Name: "empty", DataType: []string{"int[]"}},
+			{Name: "missing", DataType: []string{"text"}},
+			{Name: "ignored", DataType: []string{"string"}, IndexInverted: &noIndex},
+		}
+		res, err := NewAnalyzer(nil).WithPropertyLength(true).
+			Object(schema, props, strfmt.UUID(uuid))
+		require.Nil(t, err)
+
+		length := func(in uint64) []Countable {
+			data, err := LexicographicallySortableUint64(in)
+			require.Nil(t, err)
+			return []Countable{{Data: data}}
+		}
+
+		expected := map[string][]Countable{
+			"name": {{Data: []byte("Jürgen"), TermFrequency: 1}},
+			"tags": {
+				{Data: []byte("a"), TermFrequency: float64(1) / 3},
+				{Data: []byte("b"), TermFrequency: float64(1) / 3},
+				{Data: []byte("c"), TermFrequency: float64(1) / 3},
+			},
+			"name__property_len":    length(6),
+			"tags__property_len":    length(3),
+			"empty__property_len":   length(0),
+			"missing__property_len": length(0),
+			"_id":                   {{Data: []byte(uuid)}},
+		}
+
+		require.Len(t, res, len(expected))
+		for _, elem := range res {
+			expectedItems, ok := expected[elem.Name]
+			require.True(t, ok, "unexpected prop %q", elem.Name)
+			assert.ElementsMatch(t, expectedItems, elem.Items, elem.Name)
+		}
+	})
+}
+
+func TestAnalyzeTimestamps(t *testing.T) {
+	t.Run("without indexTimestamps", func(t *testing.T) {
+		res, err := NewAnalyzer(nil).Timestamps(1000, 2000)
+		require.Nil(t, err)
+		assert.Len(t, res, 0)
+	})
+
+	t.Run("with indexTimestamps", func(t *testing.T) {
+		res, err := NewAnalyzer(nil).WithTimestamps(true).Timestamps(1000, 2000)
+		require.Nil(t, err)
+
+		creation, err := LexicographicallySortableInt64(1000)
+		require.Nil(t, err)
+		lastUpdate, err := LexicographicallySortableInt64(2000)
+		require.Nil(t, err)
+
+		expected := []Property{{
+			Name:         "_creationTimeUnix",
+			Items:        []Countable{{Data: creation}},
+			HasFrequency: false,
+		}, {
+			Name:         "_lastUpdateTimeUnix",
+			Items:        []Countable{{Data: lastUpdate}},
+			HasFrequency: false,
+		}}
+		assert.Equal(t, expected, res)
+	})
 }
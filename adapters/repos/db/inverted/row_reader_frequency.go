@@ -15,6 +15,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"sort"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
@@ -27,6 +28,10 @@ type RowReaderFrequency struct {
 	value    []byte
 	bucket   *lsmkv.Bucket
 	operator filters.Operator
+
+	// trigrams is the optional trigram index of the prop, it is used to answer
+	// like filters which start with a wildcard without a full scan
+	trigrams *lsmkv.Bucket
 }
 
 func NewRowReaderFrequency(bucket *lsmkv.Bucket, value []byte,
@@ -185,6 +190,12 @@ func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFnFrequency)
 		return errors.Wrapf(err, "parse like value")
 	}
 
+	if !like.optimizable && rr.trigrams != nil {
+		if trigrams, ok := likeTrigrams(rr.value); ok {
+			return rr.likeWithTrigrams(ctx, like, trigrams, readFn)
+		}
+	}
+
 	c := rr.bucket.MapCursor()
 	defer c.Close()
 
@@ -233,3 +244,73 @@ func (rr *RowReaderFrequency) like(ctx context.Context, readFn ReadFnFrequency)
 
 	return nil
 }
+
+// likeWithTrigrams reads only the rows of the terms which contain all
+// trigrams of the like pattern. As a term can contain all trigrams without
+// matching the pattern, every candidate is still matched against the regexp.
+// The candidates are read in order, so the result is the same as the one of
+// a full scan.
+func (rr *RowReaderFrequency) likeWithTrigrams(ctx context.Context,
+	like *likeRegexp, trigrams [][]byte, readFn ReadFnFrequency) error {
+	var candidates map[string]struct{}
+	for _, trigram := range trigrams {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		terms, err := rr.trigrams.SetList(trigram)
+		if err != nil {
+			return errors.Wrapf(err, "read trigram '%s'", string(trigram))
+		}
+
+		next := make(map[string]struct{}, len(terms))
+		for _, term := range terms {
+			if _, ok := candidates[string(term)]; candidates == nil || ok {
+				next[string(term)] = struct{}{}
+			}
+		}
+
+		candidates = next
+		if len(candidates) == 0 {
+			return nil
+		}
+	}
+
+	terms := make([]string, 0, len(candidates))
+	for term := range candidates {
+		terms = append(terms, term)
+	}
+	sort.Strings(terms)
+
+	for _, term := range terms {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		k := []byte(term)
+		if !like.regexp.Match(k) {
+			continue
+		}
+
+		v, err := rr.bucket.MapList(k)
+		if err != nil {
+			return err
+		}
+
+		if len(v) == 0 {
+			// the term is no longer contained in any doc
+			continue
+		}
+
+		continueReading, err := readFn(k, v)
+		if err != nil {
+			return err
+		}
+
+		if !continueReading {
+			break
+		}
+	}
+
+	return nil
+}
@@ -16,14 +16,18 @@ import (
 	"context"
 	"encoding/binary"
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted/analysis"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/lsmkv"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/notimplemented"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/propertyspecific"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
 	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
@@ -174,6 +178,16 @@ func (fs *Searcher) extractPropValuePair(filter *filters.Clause,
 		return &out, nil
 	}
 
+	if filter.Operator == filters.OperatorContainsAny ||
+		filter.Operator == filters.OperatorContainsAll {
+		return fs.extractContains(filter, className)
+	}
+
+	if _, ok := filter.Value.Value.([]interface{}); ok {
+		return nil, fmt.Errorf("operator %s does not support a list of values, "+
+			"use ContainsAny or ContainsAll instead", filter.Operator.Name())
+	}
+
 	// on value or non-nested filter
 	props := filter.On.Slice()
 	if len(props) != 1 {
@@ -181,6 +195,20 @@ func (fs *Searcher) extractPropValuePair(filter *filters.Clause,
 	}
 	// we are on a value element
 
+	if filter.Operator == filters.OperatorIsNull {
+		return fs.extractIsNull(props[0], className, filter.Value)
+	}
+
+	if filters.IsTimestampProperty(props[0]) {
+		return fs.extractTimestamp(props[0], className, filter.Value,
+			filter.Operator)
+	}
+
+	if propName, ok := filters.PropertyLength(props[0]); ok {
+		return fs.extractPropertyLength(propName, className, filter.Value,
+			filter.Operator)
+	}
+
 	if fs.onRefProp(className, props[0]) && filter.Value.Type == schema.DataTypeInt {
 		// ref prop and int type is a special case, the user is looking for the
 		// reference count as opposed to the content
@@ -196,15 +224,60 @@ func (fs *Searcher) extractPropValuePair(filter *filters.Clause,
 		return fs.extractIDProp(filter.Value.Value, filter.Operator)
 	}
 
-	if fs.onMultiWordPropValue(filter.Operator, filter.Value.Value, filter.Value.Type) {
-		return fs.extractMultiWordProp(props[0], filter.Value.Type, filter.Value.Value,
-			filter.Operator)
+	if fs.onTokenizedPropValue(filter.Value.Type) {
+		tokenization := fs.propTokenization(className, props[0], filter.Value.Type)
+		chain := fs.propAnalyzerChain(className, props[0], tokenization)
+		return fs.extractTokenizedProp(props[0], tokenization, chain,
+			filter.Value.Value, filter.Operator)
 	}
 
 	return fs.extractPrimitiveProp(props[0], filter.Value.Type, filter.Value.Value,
 		filter.Operator)
 }
 
+// extractContains turns a ContainsAny or ContainsAll filter into one Equal
+// filter per value, combined with Or or And respectively. As every element of
+// an array prop is indexed individually, this matches the objects which
+// contain any or all of the values.
+func (fs *Searcher) extractContains(filter *filters.Clause,
+	className schema.ClassName) (*propValuePair, error) {
+	values, ok := filter.Value.Value.([]interface{})
+	if !ok {
+		// a single value is treated like a list with one element
+		values = []interface{}{filter.Value.Value}
+	}
+
+	if len(values) == 0 {
+		return nil, fmt.Errorf("operator %s requires at least one value",
+			filter.Operator.Name())
+	}
+
+	var out propValuePair
+	out.operator = filters.OperatorOr
+	if filter.Operator == filters.OperatorContainsAll {
+		out.operator = filters.OperatorAnd
+	}
+
+	out.children = make([]*propValuePair, len(values))
+	for i, value := range values {
+		child, err := fs.extractPropValuePair(&filters.Clause{
+			Operator: filters.OperatorEqual,
+			On:       filter.On,
+			Value: &filters.Value{
+				Type:  filter.Value.Type,
+				Value: value,
+			},
+		}, className)
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s value at pos %d",
+				filter.Operator.Name(), i)
+		}
+		out.children[i] = child
+	}
+
+	return &out, nil
+}
+
 func (fs *Searcher) extractReferenceFilter(filter *filters.Clause,
 	className schema.ClassName) (*propValuePair, error) {
 	ctx := context.TODO()
@@ -216,18 +289,6 @@ func (fs *Searcher) extractPrimitiveProp(propName string, dt schema.DataType,
 	var extractValueFn func(in interface{}) ([]byte, error)
 	var hasFrequency bool
 	switch dt {
-	case schema.DataTypeText:
-		if operator == filters.OperatorLike {
-			// if the operator is like, we cannot apply the regular text-splitting
-			// logic as it would remove all wildcard symbols
-			extractValueFn = fs.extractTextValueKeepWildcards
-		} else {
-			extractValueFn = fs.extractTextValue
-		}
-		hasFrequency = true
-	case schema.DataTypeString:
-		extractValueFn = fs.extractStringValue
-		hasFrequency = true
 	case schema.DataTypeBoolean:
 		extractValueFn = fs.extractBoolValue
 		hasFrequency = false
@@ -260,6 +321,145 @@ func (fs *Searcher) extractPrimitiveProp(propName string, dt schema.DataType,
 	}, nil
 }
 
+// extractIsNull turns an IsNull filter into a lookup of the null state of the
+// prop, which is only indexed if the class has indexNullState set
+func (fs *Searcher) extractIsNull(propName string, className schema.ClassName,
+	value *filters.Value) (*propValuePair, error) {
+	if value == nil || value.Type != schema.DataTypeBoolean {
+		return nil, fmt.Errorf("operator IsNull requires a boolean value, " +
+			"true to match null props or false to match props which are set")
+	}
+
+	c := fs.schema.FindClassByName(className)
+	if c == nil {
+		return nil, fmt.Errorf("class %q not found", className)
+	}
+
+	if c.InvertedIndexConfig == nil || !c.InvertedIndexConfig.IndexNullState {
+		return nil, fmt.Errorf("operator IsNull requires indexNullState to be "+
+			"enabled in the invertedIndexConfig of class %q", className)
+	}
+
+	byteValue, err := fs.extractBoolValue(value.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &propValuePair{
+		value:        byteValue,
+		hasFrequency: false,
+		prop:         helpers.NullStateProp(propName),
+		operator:     filters.OperatorEqual,
+	}, nil
+}
+
+// extractTimestamp turns a filter on the creation or last update timestamp
+// of objects into a lookup of the respective index. The timestamps are stored
+// as milliseconds since the epoch.
+func (fs *Searcher) extractTimestamp(propName string, className schema.ClassName,
+	value *filters.Value, operator filters.Operator) (*propValuePair, error) {
+	switch operator {
+	case filters.OperatorEqual, filters.OperatorNotEqual,
+		filters.OperatorGreaterThan, filters.OperatorGreaterThanEqual,
+		filters.OperatorLessThan, filters.OperatorLessThanEqual:
+	default:
+		return nil, fmt.Errorf("operator %s is not supported on %q",
+			operator.Name(), propName)
+	}
+
+	c := fs.schema.FindClassByName(className)
+	if c == nil {
+		return nil, fmt.Errorf("class %q not found", className)
+	}
+
+	if c.InvertedIndexConfig == nil || !c.InvertedIndexConfig.IndexTimestamps {
+		return nil, fmt.Errorf("filtering on %q requires indexTimestamps to be "+
+			"enabled in the invertedIndexConfig of class %q", propName, className)
+	}
+
+	if value == nil {
+		return nil, fmt.Errorf("filtering on %q requires a value", propName)
+	}
+
+	var timeUnix int64
+	switch v := value.Value.(type) {
+	case int:
+		timeUnix = int64(v)
+	case string:
+		parsed, err := strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected %q to be compared to milliseconds "+
+				"since the epoch, but got %q", propName, v)
+		}
+		timeUnix = parsed
+	case time.Time:
+		timeUnix = v.UnixNano() / int64(time.Millisecond)
+	default:
+		return nil, fmt.Errorf("expected %q to be compared to an int, a string "+
+			"or a date, but got %T", propName, value.Value)
+	}
+
+	byteValue, err := LexicographicallySortableInt64(timeUnix)
+	if err != nil {
+		return nil, err
+	}
+
+	return &propValuePair{
+		value:        byteValue,
+		hasFrequency: false,
+		prop:         propName,
+		operator:     operator,
+	}, nil
+}
+
+// extractPropertyLength turns a filter on the path len(propName) into a
+// lookup of the length index of the prop
+func (fs *Searcher) extractPropertyLength(propName string,
+	className schema.ClassName, value *filters.Value,
+	operator filters.Operator) (*propValuePair, error) {
+	switch operator {
+	case filters.OperatorEqual, filters.OperatorNotEqual,
+		filters.OperatorGreaterThan, filters.OperatorGreaterThanEqual,
+		filters.OperatorLessThan, filters.OperatorLessThanEqual:
+	default:
+		return nil, fmt.Errorf("operator %s is not supported on the length "+
+			"of prop %q", operator.Name(), propName)
+	}
+
+	if value == nil || value.Type != schema.DataTypeInt {
+		return nil, fmt.Errorf("the length of prop %q can only be compared "+
+			"to an int value", propName)
+	}
+
+	c := fs.schema.FindClassByName(className)
+	if c == nil {
+		return nil, fmt.Errorf("class %q not found", className)
+	}
+
+	if c.InvertedIndexConfig == nil || !c.InvertedIndexConfig.IndexPropertyLength {
+		return nil, fmt.Errorf("filtering on the length of prop %q requires "+
+			"indexPropertyLength to be enabled in the invertedIndexConfig of "+
+			"class %q", propName, className)
+	}
+
+	if length, ok := value.Value.(int); ok && length < 0 {
+		return nil, fmt.Errorf("the length of prop %q cannot be compared to "+
+			"the negative value %d", propName, length)
+	}
+
+	byteValue, err := fs.extractIntCountValue(value.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &propValuePair{
+		value:        byteValue,
+		hasFrequency: false,
+		prop:         helpers.PropertyLengthProp(propName),
+		operator:     operator,
+	}, nil
+}
+
 func (fs *Searcher) extractReferenceCount(propName string, value interface{},
 	operator filters.Operator) (*propValuePair, error) {
 	byteValue, err := fs.extractIntCountValue(value)
@@ -308,27 +508,56 @@ func (fs *Searcher) extractIDProp(value interface{},
 	}, nil
 }
 
-func (fs *Searcher) extractMultiWordProp(propName string, dt schema.DataType,
-	value interface{}, operator filters.Operator) (*propValuePair, error) {
-	var out propValuePair
+// extractTokenizedProp splits the value of a string or text filter into the
+// same terms the prop values were split into at index time, including the
+// analysis of the terms. If there is more than one term, all of them need to
+// match.
+func (fs *Searcher) extractTokenizedProp(propName string, tokenization string,
+	chain *analysis.Chain, value interface{},
+	operator filters.Operator) (*propValuePair, error) {
+	asString, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("expected value to be string, got %T", value)
+	}
+
 	var parts []string
-	switch dt {
-	case schema.DataTypeString:
-		parts = helpers.TokenizeString(value.(string))
-	case schema.DataTypeText:
-		parts = helpers.TokenizeText(value.(string))
-	default:
-		return nil, fmt.Errorf("expected value type to be string or text, got %T", dt)
+	if operator == filters.OperatorLike {
+		if tokenization == models.PropertyTokenizationTrigram {
+			return nil, fmt.Errorf("operator %s is not supported on prop %q with "+
+				"tokenization %q, use %s instead", operator.Name(), propName,
+				tokenization, filters.OperatorEqual.Name())
+		}
+
+		// if the operator is like, we cannot apply the regular text-splitting
+		// logic as it would remove all wildcard symbols
+		parts = chain.WildcardTerms(helpers.TokenizeWithWildcards(tokenization, asString))
+	} else {
+		parts = chain.Terms(helpers.Tokenize(tokenization, asString))
 	}
 
-	out.children = make([]*propValuePair, len(parts))
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("value %q of prop %q does not contain any search "+
+			"terms, they are either separators or stopwords", asString, propName)
+	}
+
+	if len(parts) == 1 {
+		return &propValuePair{
+			value:        []byte(parts[0]),
+			hasFrequency: true,
+			prop:         propName,
+			operator:     operator,
+		}, nil
+	}
 
+	var out propValuePair
+	out.children = make([]*propValuePair, len(parts))
 	for i, part := range parts {
-		child, err := fs.extractPrimitiveProp(propName, dt, part, operator)
-		if err != nil {
-			return nil, errors.Wrapf(err, "multi word at pos %d", i)
+		out.children[i] = &propValuePair{
+			value:        []byte(part),
+			hasFrequency: true,
+			prop:         propName,
+			operator:     operator,
 		}
-		out.children[i] = child
 	}
 	out.operator = filters.OperatorAnd
 
@@ -383,25 +612,37 @@ func (fs *Searcher) onIDProp(propName string) bool {
 	return propName == helpers.PropertyNameID
 }
 
-func (fs *Searcher) onMultiWordPropValue(operator filters.Operator,
-	value interface{}, valueType schema.DataType) bool {
-	switch valueType {
-	case schema.DataTypeString:
-		parts := helpers.TokenizeString(value.(string))
-		return len(parts) > 1
-	case schema.DataTypeText:
-		var parts []string
-		if operator == filters.OperatorLike {
-			// if the operator is like, we cannot apply the regular text-splitting
-			// logic as it would remove all wildcard symbols
-			parts = helpers.TokenizeTextKeepWildcards(value.(string))
-		} else {
-			parts = helpers.TokenizeText(value.(string))
+func (fs *Searcher) onTokenizedPropValue(valueType schema.DataType) bool {
+	return valueType == schema.DataTypeString || valueType == schema.DataTypeText
+}
+
+// propTokenization returns the tokenization the prop was explicitly
+// configured with. Otherwise the value is split as specified by the default
+// tokenization of the value type, as it was before the tokenization was
+// configurable.
+func (fs *Searcher) propTokenization(className schema.ClassName, propName string,
+	valueType schema.DataType) string {
+	if c := fs.schema.FindClassByName(className); c != nil {
+		for _, prop := range c.Properties {
+			if prop.Name == propName && prop.Tokenization != "" {
+				return prop.Tokenization
+			}
 		}
-		return len(parts) > 1
-	default:
-		return false
 	}
+
+	return schema.DefaultTokenization(valueType)
+}
+
+// propAnalyzerChain returns the analyzer chain configured for the prop in
+// the inverted index config of the class
+func (fs *Searcher) propAnalyzerChain(className schema.ClassName, propName string,
+	tokenization string) *analysis.Chain {
+	c := fs.schema.FindClassByName(className)
+	if c == nil {
+		return nil
+	}
+
+	return analysis.NewChains(c.InvertedIndexConfig).ForProperty(propName, tokenization)
 }
 
 type docPointers struct {
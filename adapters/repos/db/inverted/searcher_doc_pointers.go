@@ -94,6 +94,10 @@ func (fs *Searcher) docPointersInvertedNoFrequency(prop string, b *lsmkv.Bucket,
 func (fs *Searcher) docPointersInvertedFrequency(prop string, b *lsmkv.Bucket, limit int,
 	pv *propValuePair) (docPointers, error) {
 	rr := NewRowReaderFrequency(b, pv.value, pv.operator)
+	if pv.operator == filters.OperatorLike {
+		// nil, unless the prop has a trigram index
+		rr.trigrams = fs.store.Bucket(helpers.BucketFromPropNameLSM(helpers.TrigramProp(pv.prop)))
+	}
 
 	var pointers docPointers
 	var hashes [][]byte
@@ -0,0 +1,72 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"strings"
+)
+
+// trigramLength is the number of runes of a trigram
+const trigramLength = 3
+
+// TermTrigrams returns the distinct trigrams of a term in the order they
+// appear. Terms which are shorter than a trigram have none.
+func TermTrigrams(term []byte) [][]byte {
+	runes := []rune(string(term))
+	if len(runes) < trigramLength {
+		return nil
+	}
+
+	seen := map[string]struct{}{}
+	out := make([][]byte, 0, len(runes)-trigramLength+1)
+	for i := 0; i+trigramLength <= len(runes); i++ {
+		trigram := string(runes[i : i+trigramLength])
+		if _, ok := seen[trigram]; ok {
+			continue
+		}
+
+		seen[trigram] = struct{}{}
+		out = append(out, []byte(trigram))
+	}
+
+	return out
+}
+
+// likeTrigrams returns the trigrams every term matching the like pattern must
+// contain, i.e. the trigrams of the literal parts between the wildcards. It
+// returns false if the pattern has no literal part long enough to contain a
+// trigram, or if it contains characters which have a special meaning in the
+// regexp the pattern is turned into, as the literal parts are not
+// necessarily contained in the matching terms then.
+func likeTrigrams(pattern []byte) ([][]byte, bool) {
+	if strings.ContainsAny(string(pattern), `.+()[]{}|^$\`) {
+		return nil, false
+	}
+
+	seen := map[string]struct{}{}
+	var out [][]byte
+	literals := strings.FieldsFunc(string(pattern), func(r rune) bool {
+		return r < 128 && isWildcardCharacter(byte(r))
+	})
+	for _, literal := range literals {
+		for _, trigram := range TermTrigrams([]byte(literal)) {
+			if _, ok := seen[string(trigram)]; ok {
+				continue
+			}
+
+			seen[string(trigram)] = struct{}{}
+			out = append(out, trigram)
+		}
+	}
+
+	return out, len(out) > 0
+}
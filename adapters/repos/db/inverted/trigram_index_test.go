@@ -0,0 +1,106 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package inverted
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTermTrigrams(t *testing.T) {
+	type test struct {
+		name     string
+		term     string
+		expected []string
+	}
+
+	tests := []test{
+		{name: "shorter than a trigram", term: "ab", expected: nil},
+		{name: "exactly one trigram", term: "abc", expected: []string{"abc"}},
+		{
+			name:     "several trigrams",
+			term:     "sunny",
+			expected: []string{"sun", "unn", "nny"},
+		},
+		{
+			name:     "repeated trigrams",
+			term:     "aaaa",
+			expected: []string{"aaa"},
+		},
+		{
+			name:     "multi-byte characters",
+			term:     "grüße",
+			expected: []string{"grü", "rüß", "üße"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, asStrings(TermTrigrams([]byte(test.term))))
+		})
+	}
+}
+
+func TestLikeTrigrams(t *testing.T) {
+	type test struct {
+		name     string
+		pattern  string
+		expected []string
+		ok       bool
+	}
+
+	tests := []test{
+		{
+			name:     "leading and trailing wildcard",
+			pattern:  "*unny*",
+			expected: []string{"unn", "nny"},
+			ok:       true,
+		},
+		{
+			name:     "several literals",
+			pattern:  "*sun?y*day",
+			expected: []string{"sun", "day"},
+			ok:       true,
+		},
+		{
+			name:     "duplicate trigrams across literals",
+			pattern:  "*abc*abc",
+			expected: []string{"abc"},
+			ok:       true,
+		},
+		{name: "literals too short", pattern: "*ab*cd?", ok: false},
+		{name: "only wildcards", pattern: "*?*", ok: false},
+		{name: "regexp metacharacter", pattern: "*ab{0}c*", ok: false},
+		{name: "alternation", pattern: "*abc|def*", ok: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			trigrams, ok := likeTrigrams([]byte(test.pattern))
+			assert.Equal(t, test.ok, ok)
+			assert.Equal(t, test.expected, asStrings(trigrams))
+		})
+	}
+}
+
+func asStrings(in [][]byte) []string {
+	if in == nil {
+		return nil
+	}
+
+	out := make([]string, len(in))
+	for i := range in {
+		out[i] = string(in[i])
+	}
+	return out
+}
@@ -42,6 +42,16 @@ func WithSecondaryIndicies(count uint16) BucketOption {
 	}
 }
 
+// options returns the options the bucket was created with, so a bucket with
+// identical settings can be created
+func (b *Bucket) options() []BucketOption {
+	return []BucketOption{
+		WithStrategy(b.strategy),
+		WithMemtableThreshold(b.memTableThreshold),
+		WithSecondaryIndicies(b.secondaryIndices),
+	}
+}
+
 type secondaryIndexKeys [][]byte
 
 type SecondaryKeyOption func(s secondaryIndexKeys) error
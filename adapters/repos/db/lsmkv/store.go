@@ -15,6 +15,7 @@ import (
 	"context"
 	"os"
 	"path"
+	"sync"
 
 	"github.com/pkg/errors"
 	"github.com/sirupsen/logrus"
@@ -26,6 +27,10 @@ type Store struct {
 	rootDir       string
 	bucketsByName map[string]*Bucket
 	logger        logrus.FieldLogger
+
+	// bucketAccessLock protects bucketsByName, as buckets can be created and
+	// dropped while the store is in use
+	bucketAccessLock sync.RWMutex
 }
 
 func New(rootDir string, logger logrus.FieldLogger) (*Store, error) {
@@ -39,6 +44,9 @@ func New(rootDir string, logger logrus.FieldLogger) (*Store, error) {
 }
 
 func (s *Store) Bucket(name string) *Bucket {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
 	return s.bucketsByName[name]
 }
 
@@ -56,6 +64,9 @@ func (s *Store) bucketDir(bucketName string) string {
 
 func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	opts ...BucketOption) error {
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
 	if _, ok := s.bucketsByName[bucketName]; ok {
 		return nil
 	}
@@ -69,7 +80,78 @@ func (s *Store) CreateOrLoadBucket(ctx context.Context, bucketName string,
 	return nil
 }
 
+// DropBucket shuts down the bucket and removes all of its files. Dropping a
+// bucket which is not loaded only removes files which might have been left
+// on disk.
+func (s *Store) DropBucket(ctx context.Context, bucketName string) error {
+	s.bucketAccessLock.Lock()
+	b, ok := s.bucketsByName[bucketName]
+	delete(s.bucketsByName, bucketName)
+	s.bucketAccessLock.Unlock()
+
+	if ok {
+		if err := b.Shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "shutdown bucket %q", bucketName)
+		}
+	}
+
+	if err := os.RemoveAll(s.bucketDir(bucketName)); err != nil {
+		return errors.Wrapf(err, "remove files of bucket %q", bucketName)
+	}
+
+	return nil
+}
+
+// ReplaceBucket replaces the bucket with the contents of the replacement
+// bucket, which was built side-by-side. The replacement bucket is renamed and
+// keeps its options, such as the strategy. The bucket which is replaced does
+// not need to exist. It is shut down, so the caller has to make sure it is no
+// longer read.
+func (s *Store) ReplaceBucket(ctx context.Context, bucketName string,
+	replacementBucketName string) error {
+	s.bucketAccessLock.Lock()
+	defer s.bucketAccessLock.Unlock()
+
+	replacement, ok := s.bucketsByName[replacementBucketName]
+	if !ok {
+		return errors.Errorf("replacement bucket %q not found", replacementBucketName)
+	}
+
+	if err := replacement.Shutdown(ctx); err != nil {
+		return errors.Wrapf(err, "shutdown bucket %q", replacementBucketName)
+	}
+	delete(s.bucketsByName, replacementBucketName)
+
+	if b, ok := s.bucketsByName[bucketName]; ok {
+		if err := b.Shutdown(ctx); err != nil {
+			return errors.Wrapf(err, "shutdown bucket %q", bucketName)
+		}
+		delete(s.bucketsByName, bucketName)
+	}
+
+	if err := os.RemoveAll(s.bucketDir(bucketName)); err != nil {
+		return errors.Wrapf(err, "remove files of bucket %q", bucketName)
+	}
+
+	if err := os.Rename(s.bucketDir(replacementBucketName),
+		s.bucketDir(bucketName)); err != nil {
+		return errors.Wrapf(err, "rename bucket %q", replacementBucketName)
+	}
+
+	b, err := NewBucket(ctx, s.bucketDir(bucketName), s.logger,
+		replacement.options()...)
+	if err != nil {
+		return err
+	}
+
+	s.bucketsByName[bucketName] = b
+	return nil
+}
+
 func (s *Store) Shutdown(ctx context.Context) error {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
 	for name, bucket := range s.bucketsByName {
 		if err := bucket.Shutdown(ctx); err != nil {
 			return errors.Wrapf(err, "shtudown bucket %q", name)
@@ -80,6 +162,9 @@ func (s *Store) Shutdown(ctx context.Context) error {
 }
 
 func (s *Store) WriteWALs() error {
+	s.bucketAccessLock.RLock()
+	defer s.bucketAccessLock.RUnlock()
+
 	for name, bucket := range s.bucketsByName {
 		if err := bucket.WriteWAL(); err != nil {
 			return errors.Wrapf(err, "bucket %q", name)
@@ -89,3 +89,123 @@ func TestStoreLifecycle(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+func TestStoreDropBucket(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	store, err := New(dirName, nullLogger())
+	require.Nil(t, err)
+
+	t.Run("dropping a bucket removes its data", func(t *testing.T) {
+		err = store.CreateOrLoadBucket(testCtx(), "bucket1", WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+
+		err = store.Bucket("bucket1").Put([]byte("name"), []byte("Jane Doe"))
+		require.Nil(t, err)
+
+		err = store.DropBucket(context.Background(), "bucket1")
+		require.Nil(t, err)
+		assert.Nil(t, store.Bucket("bucket1"))
+
+		_, err = os.Stat(store.bucketDir("bucket1"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("recreating the bucket starts out empty", func(t *testing.T) {
+		err = store.CreateOrLoadBucket(testCtx(), "bucket1", WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+
+		res, err := store.Bucket("bucket1").Get([]byte("name"))
+		require.Nil(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("dropping a non-existing bucket", func(t *testing.T) {
+		err = store.DropBucket(context.Background(), "bucket2")
+		assert.Nil(t, err)
+	})
+
+	require.Nil(t, store.Shutdown(context.Background()))
+}
+
+func TestStoreReplaceBucket(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	dirName := fmt.Sprintf("./testdata/%d", rand.Intn(10000000))
+	os.MkdirAll(dirName, 0o777)
+	defer func() {
+		err := os.RemoveAll(dirName)
+		fmt.Println(err)
+	}()
+
+	store, err := New(dirName, nullLogger())
+	require.Nil(t, err)
+
+	t.Run("import into the original and the replacement bucket", func(t *testing.T) {
+		err = store.CreateOrLoadBucket(testCtx(), "bucket1",
+			WithStrategy(StrategySetCollection))
+		require.Nil(t, err)
+
+		err = store.CreateOrLoadBucket(testCtx(), "bucket1_replacement",
+			WithStrategy(StrategySetCollection))
+		require.Nil(t, err)
+
+		err = store.Bucket("bucket1").SetAdd([]byte("key"),
+			[][]byte{[]byte("original")})
+		require.Nil(t, err)
+
+		err = store.Bucket("bucket1_replacement").SetAdd([]byte("key"),
+			[][]byte{[]byte("replaced")})
+		require.Nil(t, err)
+	})
+
+	t.Run("replace the bucket", func(t *testing.T) {
+		err = store.ReplaceBucket(context.Background(), "bucket1",
+			"bucket1_replacement")
+		require.Nil(t, err)
+
+		assert.Nil(t, store.Bucket("bucket1_replacement"))
+		_, err = os.Stat(store.bucketDir("bucket1_replacement"))
+		assert.True(t, os.IsNotExist(err))
+	})
+
+	t.Run("the bucket contains the replaced contents", func(t *testing.T) {
+		b := store.Bucket("bucket1")
+		require.NotNil(t, b)
+		assert.Equal(t, StrategySetCollection, b.Strategy())
+
+		res, err := b.SetList([]byte("key"))
+		require.Nil(t, err)
+		assert.Equal(t, [][]byte{[]byte("replaced")}, res)
+	})
+
+	t.Run("replace a bucket which does not exist", func(t *testing.T) {
+		err = store.CreateOrLoadBucket(testCtx(), "bucket2_replacement",
+			WithStrategy(StrategyReplace))
+		require.Nil(t, err)
+
+		err = store.Bucket("bucket2_replacement").Put([]byte("name"),
+			[]byte("Jane Doe"))
+		require.Nil(t, err)
+
+		err = store.ReplaceBucket(context.Background(), "bucket2",
+			"bucket2_replacement")
+		require.Nil(t, err)
+
+		res, err := store.Bucket("bucket2").Get([]byte("name"))
+		require.Nil(t, err)
+		assert.Equal(t, []byte("Jane Doe"), res)
+	})
+
+	t.Run("replace with a non-existing bucket", func(t *testing.T) {
+		err = store.ReplaceBucket(context.Background(), "bucket1", "bucket3")
+		assert.NotNil(t, err)
+	})
+
+	require.Nil(t, store.Shutdown(context.Background()))
+}
@@ -164,7 +164,7 @@ func Test_MergingObjects(t *testing.T) {
 	})
 
 	t.Run("check that the object was successfully merged", func(t *testing.T) {
-		source, err := repo.ObjectByID(context.Background(), sourceID, nil, traverser.AdditionalProperties{})
+		source, err := repo.ObjectByID(context.Background(), sourceID, nil, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 
 		schema := source.Object().Properties.(map[string]interface{})
@@ -226,7 +226,7 @@ func Test_MergingObjects(t *testing.T) {
 	})
 
 	t.Run("check that the object was successfully merged", func(t *testing.T) {
-		source, err := repo.ObjectByID(context.Background(), sourceID, nil, traverser.AdditionalProperties{})
+		source, err := repo.ObjectByID(context.Background(), sourceID, nil, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 
 		ref, err := crossref.Parse(fmt.Sprintf("weaviate://localhost/%s", target1))
@@ -276,7 +276,7 @@ func Test_MergingObjects(t *testing.T) {
 	})
 
 	t.Run("check all references are now present", func(t *testing.T) {
-		source, err := repo.ObjectByID(context.Background(), sourceID, nil, traverser.AdditionalProperties{})
+		source, err := repo.ObjectByID(context.Background(), sourceID, nil, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 
 		refs := source.Object().Properties.(map[string]interface{})["toTarget"]
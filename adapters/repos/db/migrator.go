@@ -13,11 +13,13 @@ package db
 
 import (
 	"context"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/schema/migrate"
 	"github.com/sirupsen/logrus"
 )
 
@@ -29,8 +31,10 @@ type Migrator struct {
 func (m *Migrator) AddClass(ctx context.Context, class *models.Class) error {
 	idx, err := NewIndex(ctx,
 		IndexConfig{
-			ClassName: schema.ClassName(class.Class),
-			RootPath:  m.db.config.RootPath,
+			ClassName:    schema.ClassName(class.Class),
+			RootPath:     m.db.config.RootPath,
+			NamedVectors: class.NamedVectors,
+			MultiTenancy: schema.MultiTenancyEnabled(class),
 		},
 		// no backward-compatibility check required, since newly added classes will
 		// always have the field set
@@ -46,6 +50,11 @@ func (m *Migrator) AddClass(ctx context.Context, class *models.Class) error {
 		return errors.Wrapf(err, "extend idx '%s' with uuid property", idx.ID())
 	}
 
+	err = idx.addTimestampProperties(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "extend idx '%s' with timestamp properties", idx.ID())
+	}
+
 	for _, prop := range class.Properties {
 		if prop.IndexInverted != nil && !*prop.IndexInverted {
 			continue
@@ -84,13 +93,24 @@ func (m *Migrator) AddProperty(ctx context.Context, className string, prop *mode
 		return errors.Errorf("cannot add property to a non-existing index for %s", className)
 	}
 
-	return idx.addProperty(ctx, prop)
+	return idx.addNewProperty(ctx, prop)
 }
 
-// DropProperty is ignored, API compliant change
+// DropProperty removes the indices of the property right away and strips
+// its values from the stored objects in the background. It must be called
+// while the property is still part of the schema.
 func (m *Migrator) DropProperty(ctx context.Context, className string, propertyName string) error {
-	// ignore but don't error
-	return nil
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot drop property of a non-existing index for %s", className)
+	}
+
+	prop, err := m.propertyFromSchema(className, propertyName)
+	if err != nil {
+		return err
+	}
+
+	return idx.dropProperty(ctx, prop)
 }
 
 func (m *Migrator) UpdateProperty(ctx context.Context, className string, propName string, newName *string) error {
@@ -107,6 +127,40 @@ func (m *Migrator) UpdatePropertyAddDataType(ctx context.Context, className stri
 	return nil
 }
 
+// UpdatePropertyDataType rebuilds the inverted index of the property in the
+// background. It must be called after the schema has been updated, as the
+// property is read from the schema.
+func (m *Migrator) UpdatePropertyDataType(ctx context.Context, className string,
+	propName string, newDataType []string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update property of a non-existing index for %s", className)
+	}
+
+	prop, err := m.propertyFromSchema(className, propName)
+	if err != nil {
+		return err
+	}
+
+	if strings.Join(prop.DataType, ",") != strings.Join(newDataType, ",") {
+		return errors.Errorf("data type of property %q is %v in the schema, expected %v",
+			propName, prop.DataType, newDataType)
+	}
+
+	return idx.updatePropertyDataType(ctx, prop)
+}
+
+func (m *Migrator) propertyFromSchema(className,
+	propName string) (*models.Property, error) {
+	sch := m.db.schemaGetter.GetSchemaSkipAuth()
+	class := sch.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		return nil, errors.Errorf("class %s not found in schema", className)
+	}
+
+	return schema.GetPropertyByName(class, propName)
+}
+
 func NewMigrator(db *DB, logger logrus.FieldLogger) *Migrator {
 	return &Migrator{db: db, logger: logger}
 }
@@ -128,3 +182,43 @@ func (m *Migrator) ValidateVectorIndexConfigUpdate(ctx context.Context,
 	// used for now.
 	return hnsw.ValidateUserConfigUpdate(old, updated)
 }
+
+func (m *Migrator) NewTenants(ctx context.Context, className string,
+	tenants []*models.Tenant) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot add tenants to a non-existing index for %s", className)
+	}
+
+	return idx.addTenants(ctx, tenants)
+}
+
+func (m *Migrator) UpdateTenants(ctx context.Context, className string,
+	tenants []*models.Tenant) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot update tenants of a non-existing index for %s", className)
+	}
+
+	return idx.updateTenants(ctx, tenants)
+}
+
+func (m *Migrator) DeleteTenants(ctx context.Context, className string,
+	tenants []string) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot delete tenants of a non-existing index for %s", className)
+	}
+
+	return idx.deleteTenants(ctx, tenants)
+}
+
+func (m *Migrator) Reindex(ctx context.Context, className string,
+	params migrate.ReindexParams, commit func() error) error {
+	idx := m.db.GetIndex(schema.ClassName(className))
+	if idx == nil {
+		return errors.Errorf("cannot reindex a non-existing index for %s", className)
+	}
+
+	return idx.reindex(ctx, params, commit)
+}
@@ -26,14 +26,18 @@ import (
 )
 
 type repo interface {
-	MultiGet(ctx context.Context, query []multi.Identifier, additional traverser.AdditionalProperties) ([]search.Result, error)
+	MultiGet(ctx context.Context, query []multi.Identifier,
+		additional traverser.AdditionalProperties, tenant string) ([]search.Result, error)
 }
 
-func NewCacher(repo repo, logger logrus.FieldLogger) *Cacher {
+// NewCacher resolves references within the given tenant. Use an empty tenant
+// if the references are not resolved on behalf of a tenant.
+func NewCacher(repo repo, logger logrus.FieldLogger, tenant string) *Cacher {
 	return &Cacher{
 		logger: logger,
 		repo:   repo,
 		store:  map[multi.Identifier]search.Result{},
+		tenant: tenant,
 	}
 }
 
@@ -50,6 +54,7 @@ type Cacher struct {
 	repo       repo
 	store      map[multi.Identifier]search.Result
 	additional traverser.AdditionalProperties // meta is immutable for the lifetime of the request cacher, so we can safely store it
+	tenant     string
 }
 
 func (c *Cacher) Get(si multi.Identifier) (search.Result, bool) {
@@ -288,7 +293,7 @@ func (c *Cacher) fetchJobs(ctx context.Context) error {
 	}
 
 	query := jobListToMultiGetQuery(jobs)
-	res, err := c.repo.MultiGet(ctx, query, c.additional)
+	res, err := c.repo.MultiGet(ctx, query, c.additional, c.tenant)
 	if err != nil {
 		return errors.Wrap(err, "fetch job list")
 	}
@@ -34,7 +34,7 @@ func TestCacher(t *testing.T) {
 	t.Run("with empty results", func(t *testing.T) {
 		repo := newFakeRepo()
 		logger, _ := test.NewNullLogger()
-		cr := NewCacher(repo, logger)
+		cr := NewCacher(repo, logger, "")
 		err := cr.Build(context.Background(), nil, nil, traverser.AdditionalProperties{})
 		assert.Nil(t, err)
 	})
@@ -42,7 +42,7 @@ func TestCacher(t *testing.T) {
 	t.Run("with results with nil-schemas", func(t *testing.T) {
 		repo := newFakeRepo()
 		logger, _ := test.NewNullLogger()
-		cr := NewCacher(repo, logger)
+		cr := NewCacher(repo, logger, "")
 		input := []search.Result{
 			search.Result{
 				ID:        "foo",
@@ -56,7 +56,7 @@ func TestCacher(t *testing.T) {
 	t.Run("with results without refs in the schema", func(t *testing.T) {
 		repo := newFakeRepo()
 		logger, _ := test.NewNullLogger()
-		cr := NewCacher(repo, logger)
+		cr := NewCacher(repo, logger, "")
 		input := []search.Result{
 			search.Result{
 				ID:        "foo",
@@ -74,7 +74,7 @@ func TestCacher(t *testing.T) {
 	t.Run("with a single ref, but no selectprops", func(t *testing.T) {
 		repo := newFakeRepo()
 		logger, _ := test.NewNullLogger()
-		cr := NewCacher(repo, logger)
+		cr := NewCacher(repo, logger, "")
 		input := []search.Result{
 			search.Result{
 				ID:        "foo",
@@ -104,7 +104,7 @@ func TestCacher(t *testing.T) {
 			},
 		}
 		logger, _ := test.NewNullLogger()
-		cr := NewCacher(repo, logger)
+		cr := NewCacher(repo, logger, "")
 		input := []search.Result{
 			search.Result{
 				ID:        "foo",
@@ -178,7 +178,7 @@ func TestCacher(t *testing.T) {
 			},
 		}
 		logger, _ := test.NewNullLogger()
-		cr := NewCacher(repo, logger)
+		cr := NewCacher(repo, logger, "")
 		input := []search.Result{
 			search.Result{
 				ID:        "foo",
@@ -286,7 +286,7 @@ func TestCacher(t *testing.T) {
 			},
 		}
 		logger, _ := test.NewNullLogger()
-		cr := NewCacher(repo, logger)
+		cr := NewCacher(repo, logger, "")
 
 		// contains three items, all pointing to the same inner class
 		input := []search.Result{
@@ -444,7 +444,7 @@ func TestCacher(t *testing.T) {
 			},
 		}
 		logger, _ := test.NewNullLogger()
-		cr := NewCacher(repo, logger)
+		cr := NewCacher(repo, logger, "")
 		input := []search.Result{
 			search.Result{
 				ID:        "foo",
@@ -614,7 +614,7 @@ func newFakeRepo() *fakeRepo {
 	}
 }
 
-func (f *fakeRepo) MultiGet(ctx context.Context, query []multi.Identifier, additional traverser.AdditionalProperties) ([]search.Result, error) {
+func (f *fakeRepo) MultiGet(ctx context.Context, query []multi.Identifier, additional traverser.AdditionalProperties, tenant string) ([]search.Result, error) {
 	f.counter++
 	f.objectCounter += len(query)
 	out := make([]search.Result, len(query))
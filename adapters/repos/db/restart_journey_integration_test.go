@@ -96,7 +96,7 @@ func TestRestartJourney(t *testing.T) {
 	t.Run("control", func(t *testing.T) {
 		t.Run("verify object by id", func(t *testing.T) {
 			res, err := repo.ObjectByID(context.Background(),
-				"46ebcce8-fb77-413b-ade6-26c427af3f33", nil, traverser.AdditionalProperties{})
+				"46ebcce8-fb77-413b-ade6-26c427af3f33", nil, traverser.AdditionalProperties{}, "")
 			require.Nil(t, err)
 			require.NotNil(t, res)
 			assert.Equal(t, "oh by the way, which one's pink?",
@@ -117,7 +117,7 @@ func TestRestartJourney(t *testing.T) {
 							Property: "id",
 						},
 					},
-				}, traverser.AdditionalProperties{})
+				}, traverser.AdditionalProperties{}, "")
 			require.Nil(t, err)
 			require.Len(t, res, 1)
 			assert.Equal(t, "the band is just fantastic that is really what I think",
@@ -138,7 +138,7 @@ func TestRestartJourney(t *testing.T) {
 							Property: "description",
 						},
 					},
-				}, traverser.AdditionalProperties{})
+				}, traverser.AdditionalProperties{}, "")
 			require.Nil(t, err)
 			require.Len(t, res, 1)
 			assert.Equal(t, "oh by the way, which one's pink?",
@@ -175,7 +175,7 @@ func TestRestartJourney(t *testing.T) {
 	t.Run("verify after restart", func(t *testing.T) {
 		t.Run("verify object by id", func(t *testing.T) {
 			res, err := newRepo.ObjectByID(context.Background(),
-				"46ebcce8-fb77-413b-ade6-26c427af3f33", nil, traverser.AdditionalProperties{})
+				"46ebcce8-fb77-413b-ade6-26c427af3f33", nil, traverser.AdditionalProperties{}, "")
 			require.Nil(t, err)
 			require.NotNil(t, res)
 			assert.Equal(t, "oh by the way, which one's pink?",
@@ -196,7 +196,7 @@ func TestRestartJourney(t *testing.T) {
 							Property: "id",
 						},
 					},
-				}, traverser.AdditionalProperties{})
+				}, traverser.AdditionalProperties{}, "")
 			require.Nil(t, err)
 			require.Len(t, res, 1)
 			assert.Equal(t, "the band is just fantastic that is really what I think",
@@ -217,7 +217,7 @@ func TestRestartJourney(t *testing.T) {
 							Property: "description",
 						},
 					},
-				}, traverser.AdditionalProperties{})
+				}, traverser.AdditionalProperties{}, "")
 			require.Nil(t, err)
 			require.Len(t, res, 1)
 			assert.Equal(t, "oh by the way, which one's pink?",
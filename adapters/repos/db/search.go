@@ -48,13 +48,14 @@ func (db *DB) ClassSearch(ctx context.Context,
 		return nil, fmt.Errorf("invalid params, pagination object is nil")
 	}
 
-	res, err := idx.objectSearch(ctx, params.Pagination.Limit, params.Filters, params.AdditionalProperties)
+	res, err := idx.objectSearch(ctx, params.Pagination.Limit, params.Filters,
+		params.AdditionalProperties, params.Tenant)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object search at index %s", idx.ID())
 	}
 
 	return db.enrichRefsForList(ctx, storobj.SearchResults(res, params.AdditionalProperties),
-		params.Properties, params.AdditionalProperties)
+		params.Properties, params.AdditionalProperties, params.Tenant)
 }
 
 func (db *DB) VectorClassSearch(ctx context.Context,
@@ -68,14 +69,20 @@ func (db *DB) VectorClassSearch(ctx context.Context,
 		return nil, fmt.Errorf("tried to browse non-existing index for %s", params.ClassName)
 	}
 
-	res, err := idx.objectVectorSearch(ctx, params.SearchVector,
-		params.Pagination.Limit, params.Filters, params.AdditionalProperties)
+	targetVector := ""
+	if params.NearVector != nil {
+		targetVector = params.NearVector.TargetVector
+	}
+
+	res, err := idx.objectVectorSearch(ctx, targetVector, params.SearchVector,
+		params.Pagination.Limit, params.Filters, params.AdditionalProperties,
+		params.Tenant)
 	if err != nil {
 		return nil, errors.Wrapf(err, "object vector search at index %s", idx.ID())
 	}
 
 	return db.enrichRefsForList(ctx, storobj.SearchResults(res, params.AdditionalProperties),
-		params.Properties, params.AdditionalProperties)
+		params.Properties, params.AdditionalProperties, params.Tenant)
 }
 
 func (db *DB) VectorSearch(ctx context.Context, vector []float32, limit int,
@@ -88,12 +95,18 @@ func (db *DB) VectorSearch(ctx context.Context, vector []float32, limit int,
 
 	emptyAdditional := traverser.AdditionalProperties{}
 	for _, index := range db.indices {
+		// a search across all classes is not scoped to a tenant
+		if !index.servesTenant("") {
+			continue
+		}
+
 		wg.Add(1)
 		go func(index *Index, wg *sync.WaitGroup) {
 			defer wg.Done()
 
 			// TODO support all additional props
-			res, err := index.objectVectorSearch(ctx, vector, limit, filters, emptyAdditional)
+			res, err := index.objectVectorSearch(ctx, "", vector, limit, filters,
+				emptyAdditional, "")
 			if err != nil {
 				mutex.Lock()
 				searchErrors = append(searchErrors, errors.Wrapf(err, "search index %s", index.ID()))
@@ -134,20 +147,24 @@ func (db *DB) VectorSearch(ctx context.Context, vector []float32, limit int,
 }
 
 func (d *DB) ObjectSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
-	additional traverser.AdditionalProperties) (search.Results, error) {
-	return d.objectSearch(ctx, limit, filters, additional)
+	additional traverser.AdditionalProperties, tenant string) (search.Results, error) {
+	return d.objectSearch(ctx, limit, filters, additional, tenant)
 }
 
 func (d *DB) objectSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter,
-	additional traverser.AdditionalProperties) (search.Results, error) {
+	filters *filters.LocalFilter, additional traverser.AdditionalProperties,
+	tenant string) (search.Results, error) {
 	var found search.Results
 
 	// TODO: Search in parallel, rather than sequentially or this will be
 	// painfully slow on large schemas
 	for _, index := range d.indices {
+		if !index.servesTenant(tenant) {
+			continue
+		}
+
 		// TODO support all additional props
-		res, err := index.objectSearch(ctx, limit, filters, additional)
+		res, err := index.objectSearch(ctx, limit, filters, additional, tenant)
 		if err != nil {
 			return nil, errors.Wrapf(err, "search index %s", index.ID())
 		}
@@ -167,8 +184,9 @@ func (d *DB) objectSearch(ctx context.Context, limit int,
 }
 
 func (d *DB) enrichRefsForList(ctx context.Context, objs search.Results,
-	props traverser.SelectProperties, additional traverser.AdditionalProperties) (search.Results, error) {
-	res, err := refcache.NewResolver(refcache.NewCacher(d, d.logger)).
+	props traverser.SelectProperties, additional traverser.AdditionalProperties,
+	tenant string) (search.Results, error) {
+	res, err := refcache.NewResolver(refcache.NewCacher(d, d.logger, tenant)).
 		Do(ctx, objs, props, additional)
 	if err != nil {
 		return nil, errors.Wrap(err, "resolve cross-refs")
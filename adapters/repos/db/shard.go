@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -41,12 +42,29 @@ type Shard struct {
 	store            *lsmkv.Store
 	counter          *indexcounter.Counter
 	vectorIndex      VectorIndex
+	namedVectors     map[string]VectorIndex
 	invertedRowCache *inverted.RowCacher
 	metrics          *Metrics
 	propertyIndices  propertyspecific.Indices
 	deletedDocIDs    *docid.InMemDeletedTracker
 	cleanupInterval  time.Duration
 	cleanupCancel    chan struct{}
+
+	// background migrations, such as stripping the values of a deleted
+	// property or rebuilding an index, are stopped when the shard is shut down
+	migrationsCtx    context.Context
+	migrationsCancel context.CancelFunc
+	migrations       *sync.WaitGroup
+
+	// writes and searches hold a read lock on reindexLock, a reindex job holds
+	// the write lock while it extends the index it builds side-by-side and
+	// while it replaces the live index with it
+	reindexLock *sync.RWMutex
+	reindex     *shardReindex
+
+	// requests which were handed the shard by the index, it is only unloaded
+	// or deleted once they have completed
+	inUse *sync.WaitGroup
 }
 
 func NewShard(ctx context.Context, shardName string, index *Index) (*Shard, error) {
@@ -59,7 +77,12 @@ func NewShard(ctx context.Context, shardName string, index *Index) (*Shard, erro
 		cleanupInterval: time.Duration(index.invertedIndexConfig.
 			CleanupIntervalSeconds) * time.Second,
 		cleanupCancel: make(chan struct{}),
+		migrations:    &sync.WaitGroup{},
+		reindexLock:   &sync.RWMutex{},
+		inUse:         &sync.WaitGroup{},
 	}
+	s.migrationsCtx, s.migrationsCancel = context.WithCancel(
+		context.Background())
 
 	hnswUserConfig, ok := index.vectorIndexUserConfig.(hnsw.UserConfig)
 	if !ok {
@@ -70,17 +93,7 @@ func NewShard(ctx context.Context, shardName string, index *Index) (*Shard, erro
 	if hnswUserConfig.Skip {
 		s.vectorIndex = noop.NewIndex()
 	} else {
-		vi, err := hnsw.New(hnsw.Config{
-			Logger:   index.logger,
-			RootPath: s.index.Config.RootPath,
-			ID:       s.ID(),
-			MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
-				return hnsw.NewCommitLogger(s.index.Config.RootPath, s.ID(), 10*time.Second,
-					index.logger)
-			},
-			VectorForIDThunk: s.vectorByIndexID,
-			DistanceProvider: distancer.NewDotProductProvider(),
-		}, hnswUserConfig)
+		vi, err := s.newHnswIndex(s.ID(), s.vectorByIndexID, hnswUserConfig)
 		if err != nil {
 			return nil, errors.Wrapf(err, "init shard %q: hnsw index", s.ID())
 		}
@@ -89,6 +102,15 @@ func NewShard(ctx context.Context, shardName string, index *Index) (*Shard, erro
 		defer vi.PostStartup()
 	}
 
+	if err := s.initNamedVectors(hnswUserConfig); err != nil {
+		return nil, errors.Wrapf(err, "init shard %q: named vector indices", s.ID())
+	}
+	for _, vi := range s.namedVectors {
+		if startable, ok := vi.(startableVectorIndex); ok {
+			defer startable.PostStartup()
+		}
+	}
+
 	err := s.initDBFile(ctx)
 	if err != nil {
 		return nil, errors.Wrapf(err, "init shard %q: shard db", s.ID())
@@ -108,6 +130,39 @@ func NewShard(ctx context.Context, shardName string, index *Index) (*Shard, erro
 	return s, nil
 }
 
+// startableVectorIndex is a VectorIndex which needs to be notified once the
+// shard has completed its startup, such as the hnsw index
+type startableVectorIndex interface {
+	VectorIndex
+	PostStartup()
+}
+
+func (s *Shard) newHnswIndex(id string, vectorForID hnsw.VectorForID,
+	cfg hnsw.UserConfig) (startableVectorIndex, error) {
+	return hnsw.New(hnsw.Config{
+		Logger:   s.index.logger,
+		RootPath: s.index.Config.RootPath,
+		ID:       id,
+		MakeCommitLoggerThunk: func() (hnsw.CommitLogger, error) {
+			return hnsw.NewCommitLogger(s.index.Config.RootPath, id, 10*time.Second,
+				s.index.logger)
+		},
+		VectorForIDThunk: vectorForID,
+		DistanceProvider: distancer.NewDotProductProvider(),
+	}, cfg)
+}
+
+// acquire marks the shard as in use. It must be called while the index holds
+// the shardsLock, so the shard can not be removed from the index in between.
+func (s *Shard) acquire() *Shard {
+	s.inUse.Add(1)
+	return s
+}
+
+func (s *Shard) release() {
+	s.inUse.Done()
+}
+
 func (s *Shard) ID() string {
 	return fmt.Sprintf("%s_%s", s.index.ID(), s.name)
 }
@@ -140,9 +195,22 @@ func (s *Shard) initDBFile(ctx context.Context) error {
 }
 
 func (s *Shard) drop() error {
+	s.stopMigrations()
+
 	ctx, cancel := context.WithTimeout(context.TODO(), 5*time.Second)
 	defer cancel()
 
+	// the vector indices read vectors from the store in the background, so
+	// they are removed first
+	err := s.vectorIndex.Drop()
+	if err != nil {
+		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
+	}
+	err = s.dropNamedVectors()
+	if err != nil {
+		return errors.Wrapf(err, "remove named vector indices at %s", s.DBPathLSM())
+	}
+
 	if err := s.store.Shutdown(ctx); err != nil {
 		return errors.Wrap(err, "stop lsmkv store")
 	}
@@ -154,15 +222,10 @@ func (s *Shard) drop() error {
 		}
 	}
 	// delete indexcount
-	err := s.counter.Drop()
+	err = s.counter.Drop()
 	if err != nil {
 		return errors.Wrapf(err, "remove indexcount at %s", s.DBPathLSM())
 	}
-	// remove vector index
-	err = s.vectorIndex.Drop()
-	if err != nil {
-		return errors.Wrapf(err, "remove vector index at %s", s.DBPathLSM())
-	}
 	// TODO: can we remove this?
 	s.deletedDocIDs.BulkRemove(s.deletedDocIDs.GetAll())
 
@@ -187,7 +250,38 @@ func (s *Shard) addIDProperty(ctx context.Context) error {
 	return nil
 }
 
+// addTimestampProperties creates the buckets for the creation and last update
+// timestamps of the objects, if the class has indexTimestamps set
+func (s *Shard) addTimestampProperties(ctx context.Context) error {
+	if !s.index.invertedIndexConfig.IndexTimestamps {
+		return nil
+	}
+
+	for _, name := range []string{
+		helpers.PropertyNameCreationTimeUnix,
+		helpers.PropertyNameLastUpdateTimeUnix,
+	} {
+		err := s.store.CreateOrLoadBucket(ctx, helpers.BucketFromPropNameLSM(name),
+			lsmkv.WithStrategy(lsmkv.StrategySetCollection))
+		if err != nil {
+			return err
+		}
+
+		err = s.store.CreateOrLoadBucket(ctx, helpers.HashBucketFromPropNameLSM(name),
+			lsmkv.WithStrategy(lsmkv.StrategyReplace))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (s *Shard) addProperty(ctx context.Context, prop *models.Property) error {
+	if err := s.addOptionalMetaProperties(ctx, prop); err != nil {
+		return err
+	}
+
 	if schema.IsRefDataType(prop.DataType) {
 		err := s.store.CreateOrLoadBucket(ctx,
 			helpers.BucketFromPropNameLSM(helpers.MetaCountProp(prop.Name)),
@@ -208,6 +302,16 @@ func (s *Shard) addProperty(ctx context.Context, prop *models.Property) error {
 		return s.initGeoProp(prop)
 	}
 
+	if schema.IsNestedDataType(prop.DataType) {
+		// nested objects are indexed by their nested primitive props
+		for _, nestedProp := range schema.FlattenNestedProperties(prop) {
+			if err := s.addProperty(ctx, nestedProp); err != nil {
+				return errors.Wrapf(err, "nested prop %q", nestedProp.Name)
+			}
+		}
+		return nil
+	}
+
 	strategy := lsmkv.StrategySetCollection
 	if inverted.HasFrequency(schema.DataType(prop.DataType[0])) {
 		strategy = lsmkv.StrategyMapCollection
@@ -225,14 +329,89 @@ func (s *Shard) addProperty(ctx context.Context, prop *models.Property) error {
 		return err
 	}
 
+	if prop.IndexTrigrams {
+		// trigrams point to terms, not to docs, so there are no frequencies
+		err = s.store.CreateOrLoadBucket(ctx,
+			helpers.BucketFromPropNameLSM(helpers.TrigramProp(prop.Name)),
+			lsmkv.WithStrategy(lsmkv.StrategySetCollection))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// optionalMetaProps returns the names of the indices which are built for the
+// prop in addition to its own index, depending on the inverted index config of
+// the class, such as its null state or its length
+func (s *Shard) optionalMetaProps(prop *models.Property) []string {
+	if prop.IndexInverted != nil && !*prop.IndexInverted {
+		return nil
+	}
+
+	var names []string
+	if s.index.invertedIndexConfig.IndexNullState && !schema.IsBlobDataType(prop.DataType) {
+		names = append(names, helpers.NullStateProp(prop.Name))
+	}
+
+	if s.index.invertedIndexConfig.IndexPropertyLength &&
+		inverted.HasPropertyLength(schema.DataType(prop.DataType[0])) {
+		names = append(names, helpers.PropertyLengthProp(prop.Name))
+	}
+
+	return names
+}
+
+// addOptionalMetaProperties creates the buckets for the optional meta indices
+// of the prop, see optionalMetaProps
+func (s *Shard) addOptionalMetaProperties(ctx context.Context,
+	prop *models.Property) error {
+	for _, name := range s.optionalMetaProps(prop) {
+		// meta props do not have frequencies -> Set
+		err := s.store.CreateOrLoadBucket(ctx, helpers.BucketFromPropNameLSM(name),
+			lsmkv.WithStrategy(lsmkv.StrategySetCollection))
+		if err != nil {
+			return err
+		}
+
+		err = s.store.CreateOrLoadBucket(ctx, helpers.HashBucketFromPropNameLSM(name),
+			lsmkv.WithStrategy(lsmkv.StrategyReplace))
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (s *Shard) updateVectorIndexConfig(ctx context.Context,
 	updated schema.VectorIndexConfig) error {
-	return s.vectorIndex.UpdateUserConfig(updated)
+	if err := s.vectorIndex.UpdateUserConfig(updated); err != nil {
+		return err
+	}
+
+	return s.updateNamedVectorsConfig(updated)
 }
 
+// shutdown stops all background jobs of the shard and releases its files,
+// but keeps everything on disk, so the shard can be loaded again later
 func (s *Shard) shutdown(ctx context.Context) error {
-	return s.store.Shutdown(ctx)
+	s.stopMigrations()
+
+	// the vector indices read vectors from the store in the background, so
+	// they are shut down first
+	if err := s.vectorIndex.Shutdown(); err != nil {
+		return errors.Wrap(err, "shutdown vector index")
+	}
+
+	if err := s.shutdownNamedVectors(); err != nil {
+		return errors.Wrap(err, "shutdown named vector indices")
+	}
+
+	if err := s.store.Shutdown(ctx); err != nil {
+		return errors.Wrap(err, "stop lsmkv store")
+	}
+
+	return s.counter.Close()
 }
@@ -21,6 +21,9 @@ import (
 
 func (s *Shard) aggregate(ctx context.Context,
 	params traverser.AggregateParams) (*aggregation.Result, error) {
+	ctx, unlock := s.lockForSearch(ctx)
+	defer unlock()
+
 	return aggregator.New(s.store, params, s.index.getSchema, s.invertedRowCache,
-		s.index.classSearcher, s.deletedDocIDs).Do(ctx)
+		s.index.classSearcher, s.deletedDocIDs, s.searchByVector).Do(ctx)
 }
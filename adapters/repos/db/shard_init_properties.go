@@ -36,6 +36,10 @@ func (s *Shard) initProperties() error {
 			if err := s.initGeoProp(prop); err != nil {
 				return errors.Wrapf(err, "init property %s", prop.Name)
 			}
+
+			if err := s.addOptionalMetaProperties(context.TODO(), prop); err != nil {
+				return errors.Wrapf(err, "init property %s", prop.Name)
+			}
 		} else {
 			// served by the inverted index, init the buckets there
 			if err := s.addProperty(context.TODO(), prop); err != nil {
@@ -47,5 +51,9 @@ func (s *Shard) initProperties() error {
 	if err := s.addIDProperty(context.TODO()); err != nil {
 		return errors.Wrap(err, "init id property")
 	}
+
+	if err := s.addTimestampProperties(context.TODO()); err != nil {
+		return errors.Wrap(err, "init timestamp properties")
+	}
 	return nil
 }
@@ -0,0 +1,172 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/noop"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// initNamedVectors creates one vector index per named vector of the class.
+// All named vector indices share the user config of the main vector index.
+func (s *Shard) initNamedVectors(cfg hnsw.UserConfig) error {
+	s.namedVectors = map[string]VectorIndex{}
+
+	for _, name := range s.index.Config.NamedVectors {
+		if cfg.Skip {
+			s.namedVectors[name] = noop.NewIndex()
+			continue
+		}
+
+		vi, err := s.newHnswIndex(namedVectorIndexID(s.ID(), name),
+			s.makeNamedVectorForID(name), cfg)
+		if err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+
+		s.namedVectors[name] = vi
+	}
+
+	return nil
+}
+
+func namedVectorIndexID(shardID string, name string) string {
+	return fmt.Sprintf("%s_vector_%s", shardID, name)
+}
+
+func (s *Shard) makeNamedVectorForID(name string) hnsw.VectorForID {
+	return func(ctx context.Context, id uint64) ([]float32, error) {
+		obj, err := s.objectByIndexID(ctx, id, true)
+		if err != nil {
+			return nil, errors.Wrap(err, "retrieve object")
+		}
+
+		vector, ok := obj.NamedVectors()[name]
+		if !ok {
+			return nil, storobj.NewErrNotFoundf(id,
+				"object has no named vector %q", name)
+		}
+
+		return vector, nil
+	}
+}
+
+func (s *Shard) namedVectorIndex(name string) (VectorIndex, error) {
+	vi, ok := s.namedVectors[name]
+	if !ok {
+		return nil, errors.Errorf("class %s has no named vector %q",
+			s.index.Config.ClassName, name)
+	}
+
+	return vi, nil
+}
+
+func (s *Shard) updateNamedVectorIndices(object *storobj.Object,
+	status objectInsertStatus) error {
+	for name, vi := range s.namedVectors {
+		if status.docIDChanged {
+			if err := vi.Delete(status.oldDocID); err != nil {
+				return errors.Wrapf(err, "delete doc id %d from named vector %q",
+					status.oldDocID, name)
+			}
+		}
+
+		vector, ok := object.NamedVectors()[name]
+		if !ok || len(vector) == 0 {
+			// named vectors are optional, an object does not need to have all of
+			// them
+			continue
+		}
+
+		if err := vi.Add(status.docID, vector); err != nil {
+			return errors.Wrapf(err, "insert doc id %d to named vector %q",
+				status.docID, name)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) deleteFromNamedVectorIndices(docID uint64) error {
+	for name, vi := range s.namedVectors {
+		if err := vi.Delete(docID); err != nil {
+			return errors.Wrapf(err, "delete doc id %d from named vector %q",
+				docID, name)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) flushNamedVectorIndices() error {
+	for name, vi := range s.namedVectors {
+		if err := vi.Flush(); err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) dropNamedVectors() error {
+	for name, vi := range s.namedVectors {
+		if err := vi.Drop(); err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) shutdownNamedVectors() error {
+	for name, vi := range s.namedVectors {
+		if err := vi.Shutdown(); err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) updateNamedVectorsConfig(updated schema.VectorIndexConfig) error {
+	for name, vi := range s.namedVectors {
+		if err := vi.UpdateUserConfig(updated); err != nil {
+			return errors.Wrapf(err, "named vector %q", name)
+		}
+	}
+
+	return nil
+}
+
+// searchByVector searches the vector index or a named vector index. The
+// caller must hold the read lock of reindexLock, as the vector index is
+// replaced when a reindex job completes.
+func (s *Shard) searchByVector(targetVector string, searchVector []float32,
+	limit int, allowList helpers.AllowList) ([]uint64, error) {
+	if targetVector == "" {
+		return s.vectorIndex.SearchByVector(searchVector, limit, allowList)
+	}
+
+	vi, err := s.namedVectorIndex(targetVector)
+	if err != nil {
+		return nil, err
+	}
+
+	return vi.SearchByVector(searchVector, limit, allowList)
+}
@@ -0,0 +1,301 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/sirupsen/logrus"
+)
+
+// dropProperty removes the inverted (or property-specific) indices of the
+// property right away. The values of the property are stripped from the
+// stored objects in the background.
+func (s *Shard) dropProperty(ctx context.Context, prop *models.Property) error {
+	if err := s.dropPropertyIndices(ctx, prop); err != nil {
+		return err
+	}
+
+	s.startPropertyMigration("strip_property", prop, s.stripProperty)
+	return nil
+}
+
+// updatePropertyDataType replaces the inverted index of the property with an
+// empty one matching the new data type. The index is rebuilt from the stored
+// objects in the background, new writes are indexed right away. Until the
+// rebuild is completed, filters on the property may miss older objects.
+func (s *Shard) updatePropertyDataType(ctx context.Context,
+	prop *models.Property) error {
+	if prop.IndexInverted != nil && !*prop.IndexInverted {
+		// nothing is indexed, so there is nothing to rebuild
+		return nil
+	}
+
+	if err := s.dropPropertyIndices(ctx, prop); err != nil {
+		return err
+	}
+
+	if err := s.addProperty(ctx, prop); err != nil {
+		return errors.Wrap(err, "recreate property indices")
+	}
+
+	s.startPropertyMigration("reindex_property", prop, s.reindexProperty)
+	return nil
+}
+
+// indexMetaPropsOfNewProperty indexes the optional meta props, such as the
+// null state or the length, of a property which has just been added for all
+// existing objects, none of which can have a value for it yet
+func (s *Shard) indexMetaPropsOfNewProperty(prop *models.Property) {
+	if len(s.optionalMetaProps(prop)) == 0 {
+		return
+	}
+
+	s.startPropertyMigration("index_meta_props", prop, s.reindexMetaProps)
+}
+
+func (s *Shard) dropPropertyIndices(ctx context.Context,
+	prop *models.Property) error {
+	for _, bucket := range []string{
+		helpers.BucketFromPropNameLSM(helpers.NullStateProp(prop.Name)),
+		helpers.HashBucketFromPropNameLSM(helpers.NullStateProp(prop.Name)),
+		helpers.BucketFromPropNameLSM(helpers.PropertyLengthProp(prop.Name)),
+		helpers.HashBucketFromPropNameLSM(helpers.PropertyLengthProp(prop.Name)),
+	} {
+		if err := s.store.DropBucket(ctx, bucket); err != nil {
+			return errors.Wrapf(err, "drop bucket %q", bucket)
+		}
+	}
+
+	if schema.DataType(prop.DataType[0]) == schema.DataTypeGeoCoordinates {
+		index, ok := s.propertyIndices[prop.Name]
+		if !ok {
+			return nil
+		}
+
+		delete(s.propertyIndices, prop.Name)
+		if err := index.GeoIndex.Drop(); err != nil {
+			return errors.Wrapf(err, "drop geo index of prop %q", prop.Name)
+		}
+
+		return nil
+	}
+
+	if schema.IsNestedDataType(prop.DataType) {
+		for _, nestedProp := range schema.FlattenNestedProperties(prop) {
+			if err := s.dropPropertyIndices(ctx, nestedProp); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	buckets := []string{
+		helpers.BucketFromPropNameLSM(prop.Name),
+		helpers.HashBucketFromPropNameLSM(prop.Name),
+	}
+	if schema.IsRefDataType(prop.DataType) {
+		buckets = append(buckets,
+			helpers.BucketFromPropNameLSM(helpers.MetaCountProp(prop.Name)),
+			helpers.HashBucketFromPropNameLSM(helpers.MetaCountProp(prop.Name)))
+	}
+	if prop.IndexTrigrams {
+		buckets = append(buckets,
+			helpers.BucketFromPropNameLSM(helpers.TrigramProp(prop.Name)))
+	}
+
+	for _, bucket := range buckets {
+		if err := s.store.DropBucket(ctx, bucket); err != nil {
+			return errors.Wrapf(err, "drop bucket %q", bucket)
+		}
+	}
+
+	return nil
+}
+
+type propertyMigrationFunc func(ctx context.Context, prop *models.Property,
+	obj *storobj.Object) error
+
+// startPropertyMigration applies the migration to every object which exists
+// at the time the migration is started. It runs in the background and is
+// stopped if the shard is shut down.
+func (s *Shard) startPropertyMigration(action string, prop *models.Property,
+	migrate propertyMigrationFunc) {
+	s.migrations.Add(1)
+	go func() {
+		defer s.migrations.Done()
+
+		logger := s.index.logger.WithFields(logrus.Fields{
+			"action":   action,
+			"shard":    s.ID(),
+			"property": prop.Name,
+		})
+
+		before := time.Now()
+		count, err := s.migrateProperty(s.migrationsCtx, prop, migrate)
+		if err != nil {
+			logger.WithError(err).Error("property migration failed")
+			return
+		}
+
+		logger.WithField("took", time.Since(before)).
+			Debugf("property migration completed for %d objects", count)
+	}()
+}
+
+func (s *Shard) stopMigrations() {
+	s.migrationsCancel()
+	s.migrations.Wait()
+}
+
+func (s *Shard) migrateProperty(ctx context.Context, prop *models.Property,
+	migrate propertyMigrationFunc) (int, error) {
+	// collect the keys upfront, so the cursor is not held open while the
+	// objects are written
+	var keys [][]byte
+	cursor := s.store.Bucket(helpers.ObjectsBucketLSM).Cursor()
+	for k, _ := cursor.First(); k != nil; k, _ = cursor.Next() {
+		key := make([]byte, len(k))
+		copy(key, k)
+		keys = append(keys, key)
+	}
+	cursor.Close()
+
+	for i, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return i, errors.Wrap(err, "stopped")
+		}
+
+		if err := s.migrateObject(ctx, key, prop, migrate); err != nil {
+			return i, err
+		}
+	}
+
+	if err := s.store.WriteWALs(); err != nil {
+		return len(keys), errors.Wrap(err, "flush all buffered WALs")
+	}
+
+	return len(keys), nil
+}
+
+// migrateObject reads and migrates a single object while holding the write
+// lock of reindexLock. Writes hold the read lock, so the object can not be
+// replaced or deleted between it being read and the migration writing it back.
+func (s *Shard) migrateObject(ctx context.Context, key []byte,
+	prop *models.Property, migrate propertyMigrationFunc) error {
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	data, err := s.store.Bucket(helpers.ObjectsBucketLSM).Get(key)
+	if err != nil {
+		return errors.Wrap(err, "get object")
+	}
+
+	if data == nil {
+		// deleted in the meantime
+		return nil
+	}
+
+	obj, err := storobj.FromBinary(data)
+	if err != nil {
+		return errors.Wrap(err, "unmarshal object")
+	}
+
+	if err := migrate(ctx, prop, obj); err != nil {
+		return errors.Wrapf(err, "object %s", obj.ID())
+	}
+
+	return nil
+}
+
+// stripProperty removes the value of a deleted property from the stored
+// object. The doc id is not altered, as none of the remaining indices are
+// affected. It is called under the write lock of reindexLock, so the object
+// is still the current version when it is written back.
+func (s *Shard) stripProperty(ctx context.Context, prop *models.Property,
+	obj *storobj.Object) error {
+	props, ok := obj.Properties().(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	if _, ok := props[prop.Name]; !ok {
+		return nil
+	}
+
+	delete(props, prop.Name)
+	obj.SetProperties(props)
+
+	data, err := obj.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "marshal object")
+	}
+
+	idBytes, err := uuid.MustParse(obj.ID().String()).MarshalBinary()
+	if err != nil {
+		return err
+	}
+
+	return s.upsertObjectDataLSM(s.store.Bucket(helpers.ObjectsBucketLSM),
+		idBytes, data, obj.DocID())
+}
+
+// reindexProperty adds the value of the property to the rebuilt inverted
+// index. Adding an item which has already been indexed by a concurrent write
+// is idempotent.
+func (s *Shard) reindexProperty(ctx context.Context, prop *models.Property,
+	obj *storobj.Object) error {
+	return s.reindexPropertyIndices(prop, obj,
+		append([]string{prop.Name}, s.optionalMetaProps(prop)...)...)
+}
+
+// reindexMetaProps adds the optional meta props of the property, such as its
+// null state or its length, to their indices
+func (s *Shard) reindexMetaProps(ctx context.Context, prop *models.Property,
+	obj *storobj.Object) error {
+	return s.reindexPropertyIndices(prop, obj, s.optionalMetaProps(prop)...)
+}
+
+// reindexPropertyIndices analyzes the property of the object and adds it to
+// the given inverted indices of the property only
+func (s *Shard) reindexPropertyIndices(prop *models.Property, obj *storobj.Object,
+	names ...string) error {
+	props, ok := obj.Properties().(map[string]interface{})
+	if !ok {
+		props = map[string]interface{}{}
+	}
+
+	analyzed, err := s.index.analyzer().Object(props,
+		[]*models.Property{prop}, obj.ID())
+	if err != nil {
+		return errors.Wrap(err, "analyze object")
+	}
+
+	selected := analyzed[:0]
+	for _, p := range analyzed {
+		for _, name := range names {
+			if p.Name == name {
+				selected = append(selected, p)
+				break
+			}
+		}
+	}
+
+	return s.extendInvertedIndicesLSM(selected, obj.DocID())
+}
@@ -171,14 +171,20 @@ func (s *Shard) objectSearch(ctx context.Context, limit int,
 		return s.objectList(ctx, limit, additional)
 	}
 
+	ctx, unlock := s.lockForSearch(ctx)
+	defer unlock()
+
 	return inverted.NewSearcher(s.store, s.index.getSchema.GetSchemaSkipAuth(),
 		s.invertedRowCache, s.propertyIndices, s.index.classSearcher,
 		s.deletedDocIDs).
 		Object(ctx, limit, filters, additional, s.index.Config.ClassName)
 }
 
-func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
-	limit int, filters *filters.LocalFilter, additional traverser.AdditionalProperties) ([]*storobj.Object, error) {
+func (s *Shard) objectVectorSearch(ctx context.Context, targetVector string,
+	searchVector []float32, limit int, filters *filters.LocalFilter, additional traverser.AdditionalProperties) ([]*storobj.Object, error) {
+	ctx, unlock := s.lockForSearch(ctx)
+	defer unlock()
+
 	var allowList helpers.AllowList
 	if filters != nil {
 		list, err := inverted.NewSearcher(s.store, s.index.getSchema.GetSchemaSkipAuth(),
@@ -191,7 +197,7 @@ func (s *Shard) objectVectorSearch(ctx context.Context, searchVector []float32,
 
 		allowList = list
 	}
-	ids, err := s.vectorIndex.SearchByVector(searchVector, limit, allowList)
+	ids, err := s.searchByVector(targetVector, searchVector, limit, allowList)
 	if err != nil {
 		return nil, errors.Wrap(err, "vector search")
 	}
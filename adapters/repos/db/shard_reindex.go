@@ -0,0 +1,506 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/docid"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/helpers"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/inverted"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/storobj"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/noop"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/schema/migrate"
+)
+
+// reindexChunkSize is the number of doc ids which are added to the
+// side-by-side indices at once. Writes are paused while a chunk is added.
+const reindexChunkSize = 100
+
+// shardReindex holds the indices of a reindex job which are built
+// side-by-side to the live indices of the shard. Either the inverted
+// indices of properties or a vector index are rebuilt.
+type shardReindex struct {
+	// props are rebuilt, propNames maps the names of the analyzed properties
+	// to the names used for their side-by-side buckets
+	props     []*models.Property
+	propNames map[string]string
+
+	// dropProps are no longer indexed, their buckets are removed once the
+	// reindex job completes
+	dropProps []*models.Property
+
+	vectorIndex       VectorIndex
+	vectorIndexConfig hnsw.UserConfig
+}
+
+// reindexReadLock marks a context in which the read lock of the shard's
+// reindexLock is held
+type reindexReadLock struct {
+	shard *Shard
+}
+
+// lockForSearch takes the read lock of reindexLock for the duration of a
+// search, so the indices can not be swapped while they are read. Searches
+// can be nested, e.g. a reference filter on a class which references itself,
+// and a nested read lock would deadlock with a waiting swap. The lock is
+// therefore only taken once per shard, the returned context marks it as held.
+func (s *Shard) lockForSearch(ctx context.Context) (context.Context, func()) {
+	if ctx.Value(reindexReadLock{shard: s}) != nil {
+		return ctx, func() {}
+	}
+
+	s.reindexLock.RLock()
+	return context.WithValue(ctx, reindexReadLock{shard: s}, true),
+		s.reindexLock.RUnlock
+}
+
+func reindexVectorIndexID(shardID string) string {
+	return fmt.Sprintf("%s_reindex", shardID)
+}
+
+// prepareReindex creates the side-by-side indices. From here on every write
+// is applied to them as well. All doc ids smaller than the returned one were
+// handed out before and need to be added by buildReindex.
+func (s *Shard) prepareReindex(ctx context.Context,
+	params migrate.ReindexParams) (uint64, error) {
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	if s.reindex != nil {
+		return 0, errors.Errorf("shard %s is already being reindexed", s.ID())
+	}
+
+	r := &shardReindex{}
+	if params.VectorIndexConfig != nil {
+		if err := s.prepareVectorReindex(r, params.VectorIndexConfig); err != nil {
+			return 0, errors.Wrap(err, "prepare vector index")
+		}
+	} else {
+		if err := s.prepareInvertedReindex(ctx, r, params.Properties); err != nil {
+			return 0, errors.Wrap(err, "prepare inverted indices")
+		}
+	}
+
+	s.reindex = r
+	return s.counter.Get(), nil
+}
+
+func (s *Shard) prepareInvertedReindex(ctx context.Context, r *shardReindex,
+	props []*models.Property) error {
+	r.propNames = map[string]string{}
+
+	for _, prop := range props {
+		if schema.DataType(prop.DataType[0]) == schema.DataTypeGeoCoordinates {
+			return errors.Errorf("prop %q: geo properties have no inverted index",
+				prop.Name)
+		}
+
+		if prop.IndexInverted != nil && !*prop.IndexInverted {
+			r.dropProps = append(r.dropProps, prop)
+			continue
+		}
+
+		reindexProp := *prop
+		reindexProp.Name = helpers.ReindexProp(prop.Name)
+
+		// remove anything left behind by a previous reindex job which did not
+		// complete
+		if err := s.dropPropertyIndices(ctx, &reindexProp); err != nil {
+			return errors.Wrapf(err, "prop %q", prop.Name)
+		}
+
+		if err := s.addProperty(ctx, &reindexProp); err != nil {
+			return errors.Wrapf(err, "prop %q", prop.Name)
+		}
+
+		r.props = append(r.props, prop)
+		r.propNames[prop.Name] = reindexProp.Name
+		r.propNames[helpers.MetaCountProp(prop.Name)] =
+			helpers.MetaCountProp(reindexProp.Name)
+		r.propNames[helpers.NullStateProp(prop.Name)] =
+			helpers.NullStateProp(reindexProp.Name)
+		r.propNames[helpers.PropertyLengthProp(prop.Name)] =
+			helpers.PropertyLengthProp(reindexProp.Name)
+
+		nestedProps := schema.FlattenNestedProperties(prop)
+		for i, nestedProp := range schema.FlattenNestedProperties(&reindexProp) {
+			r.propNames[nestedProps[i].Name] = nestedProp.Name
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) prepareVectorReindex(r *shardReindex,
+	config schema.VectorIndexConfig) error {
+	cfg, ok := config.(hnsw.UserConfig)
+	if !ok {
+		return errors.Errorf("config is not hnsw.UserConfig: %T", config)
+	}
+	r.vectorIndexConfig = cfg
+
+	if cfg.Skip {
+		r.vectorIndex = noop.NewIndex()
+		return nil
+	}
+
+	// remove anything left behind by a previous reindex job which did not
+	// complete
+	id := reindexVectorIndexID(s.ID())
+	if err := hnsw.RemoveCommitLogs(s.index.Config.RootPath, id); err != nil {
+		return err
+	}
+
+	vi, err := s.newHnswIndex(id, s.vectorByIndexID, cfg)
+	if err != nil {
+		return err
+	}
+
+	r.vectorIndex = vi
+	return nil
+}
+
+// buildReindex adds all objects which were stored before the reindex job was
+// prepared to the side-by-side indices. This happens in chunks, so writes are
+// only paused for a short time.
+func (s *Shard) buildReindex(ctx context.Context, upTo uint64) error {
+	for from := uint64(0); from < upTo; from += reindexChunkSize {
+		to := from + reindexChunkSize
+		if to > upTo {
+			to = upTo
+		}
+
+		if err := s.buildReindexChunk(ctx, from, to); err != nil {
+			return errors.Wrapf(err, "doc ids %d to %d", from, to)
+		}
+	}
+
+	if s.reindex.vectorIndex != nil {
+		return s.reindex.vectorIndex.Flush()
+	}
+
+	return s.store.WriteWALs()
+}
+
+func (s *Shard) buildReindexChunk(ctx context.Context, from, to uint64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if err := s.migrationsCtx.Err(); err != nil {
+		return errors.Wrap(err, "shard is shut down")
+	}
+
+	pointers := make([]uint64, 0, to-from)
+	for id := from; id < to; id++ {
+		pointers = append(pointers, id)
+	}
+
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	seen := map[uint64]struct{}{}
+	return docid.ScanObjectsLSM(s.store, pointers,
+		func(obj *storobj.Object) (bool, error) {
+			// a doc id can resolve to a later version of the object, which is
+			// either part of another chunk or was already added by the write itself
+			docID := obj.DocID()
+			if docID < from || docID >= to {
+				return true, nil
+			}
+
+			if _, ok := seen[docID]; ok {
+				return true, nil
+			}
+			seen[docID] = struct{}{}
+
+			current, err := s.isCurrentObject(obj)
+			if err != nil {
+				return false, err
+			}
+
+			if !current {
+				return true, nil
+			}
+
+			return true, s.addToReindex(obj)
+		})
+}
+
+// isCurrentObject checks that the object was neither updated nor deleted
+func (s *Shard) isCurrentObject(obj *storobj.Object) (bool, error) {
+	idBytes, err := uuid.MustParse(obj.ID().String()).MarshalBinary()
+	if err != nil {
+		return false, err
+	}
+
+	current, err := s.store.Bucket(helpers.ObjectsBucketLSM).Get(idBytes)
+	if err != nil {
+		return false, errors.Wrap(err, "get current object")
+	}
+
+	if current == nil {
+		return false, nil
+	}
+
+	docID, err := storobj.DocIDFromBinary(current)
+	if err != nil {
+		return false, errors.Wrap(err, "get current doc id from object binary")
+	}
+
+	return docID == obj.DocID(), nil
+}
+
+// updateReindex applies a write to the side-by-side indices of a running
+// reindex job. The previous object is nil if the object did not exist
+// before, the next object is nil if it was deleted. The caller must hold the
+// reindex read lock.
+func (s *Shard) updateReindex(previous, next *storobj.Object) error {
+	if s.reindex == nil {
+		return nil
+	}
+
+	if s.reindex.vectorIndex != nil {
+		return s.updateVectorReindex(previous, next)
+	}
+
+	if previous != nil {
+		props, err := s.analyzeForReindex(previous)
+		if err != nil {
+			return errors.Wrap(err, "analyze previous object")
+		}
+
+		if err := s.deleteFromInvertedIndicesLSM(props, previous.DocID()); err != nil {
+			return errors.Wrap(err, "delete previous object")
+		}
+	}
+
+	if next == nil {
+		return nil
+	}
+
+	return s.addToReindex(next)
+}
+
+// updateReindexBinary is updateReindex for a previous object in its binary
+// representation, which is only unmarshalled if a reindex job is running
+func (s *Shard) updateReindexBinary(previous []byte,
+	next *storobj.Object) error {
+	if s.reindex == nil {
+		return nil
+	}
+
+	var previousObj *storobj.Object
+	if previous != nil {
+		p, err := storobj.FromBinary(previous)
+		if err != nil {
+			return errors.Wrap(err, "unmarshal previous object")
+		}
+		previousObj = p
+	}
+
+	return s.updateReindex(previousObj, next)
+}
+
+func (s *Shard) updateVectorReindex(previous, next *storobj.Object) error {
+	if previous != nil && next != nil && previous.DocID() == next.DocID() {
+		// the vector can not change without a new doc id
+		return nil
+	}
+
+	if previous != nil {
+		if err := s.reindex.vectorIndex.Delete(previous.DocID()); err != nil {
+			return errors.Wrapf(err, "delete doc id %d", previous.DocID())
+		}
+	}
+
+	if next == nil {
+		return nil
+	}
+
+	return s.addToReindex(next)
+}
+
+func (s *Shard) addToReindex(obj *storobj.Object) error {
+	if s.reindex.vectorIndex != nil {
+		if len(obj.Vector) == 0 {
+			return nil
+		}
+
+		return s.reindex.vectorIndex.Add(obj.DocID(), obj.Vector)
+	}
+
+	props, err := s.analyzeForReindex(obj)
+	if err != nil {
+		return errors.Wrap(err, "analyze object")
+	}
+
+	return s.extendInvertedIndicesLSM(props, obj.DocID())
+}
+
+// analyzeForReindex analyzes the object according to the property
+// definitions of the reindex job. The analyzed properties are renamed, so
+// they are written to the side-by-side buckets.
+func (s *Shard) analyzeForReindex(obj *storobj.Object) ([]inverted.Property, error) {
+	if len(s.reindex.props) == 0 {
+		return nil, nil
+	}
+
+	props, ok := obj.Properties().(map[string]interface{})
+	if !ok {
+		// the null state of the props is still indexed
+		props = map[string]interface{}{}
+	}
+
+	analyzed, err := s.index.analyzer().Object(props, s.reindex.props, obj.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	out := analyzed[:0]
+	for _, prop := range analyzed {
+		name, ok := s.reindex.propNames[prop.Name]
+		if !ok {
+			// such as the id property, which is not rebuilt
+			continue
+		}
+
+		prop.Name = name
+		out = append(out, prop)
+	}
+
+	return out, nil
+}
+
+// swapReindex replaces the live indices with the side-by-side indices of the
+// reindex job. The caller must hold the reindex write lock.
+func (s *Shard) swapReindex(ctx context.Context) error {
+	r := s.reindex
+	s.reindex = nil
+
+	if r.vectorIndex != nil {
+		return s.swapVectorReindex(r)
+	}
+
+	for _, prop := range r.dropProps {
+		if err := s.dropPropertyIndices(ctx, prop); err != nil {
+			return errors.Wrapf(err, "prop %q", prop.Name)
+		}
+	}
+
+	for _, prop := range r.props {
+		names := []string{prop.Name}
+		if schema.IsRefDataType(prop.DataType) {
+			names = append(names, helpers.MetaCountProp(prop.Name))
+		}
+		if schema.IsNestedDataType(prop.DataType) {
+			// the nested props are indexed instead of the object prop itself
+			names = names[:0]
+			for _, nestedProp := range schema.FlattenNestedProperties(prop) {
+				names = append(names, nestedProp.Name)
+			}
+		}
+		names = append(names, s.optionalMetaProps(prop)...)
+
+		for _, name := range names {
+			if err := s.store.ReplaceBucket(ctx, helpers.BucketFromPropNameLSM(name),
+				helpers.BucketFromPropNameLSM(r.propNames[name])); err != nil {
+				return errors.Wrapf(err, "prop %q", prop.Name)
+			}
+
+			if err := s.store.ReplaceBucket(ctx, helpers.HashBucketFromPropNameLSM(name),
+				helpers.HashBucketFromPropNameLSM(r.propNames[name])); err != nil {
+				return errors.Wrapf(err, "prop %q", prop.Name)
+			}
+		}
+
+		if prop.IndexTrigrams {
+			if err := s.store.ReplaceBucket(ctx,
+				helpers.BucketFromPropNameLSM(helpers.TrigramProp(prop.Name)),
+				helpers.BucketFromPropNameLSM(helpers.TrigramProp(r.propNames[prop.Name]))); err != nil {
+				return errors.Wrapf(err, "trigrams of prop %q", prop.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Shard) swapVectorReindex(r *shardReindex) error {
+	if err := s.vectorIndex.Drop(); err != nil {
+		return errors.Wrap(err, "drop previous vector index")
+	}
+
+	if r.vectorIndexConfig.Skip {
+		s.vectorIndex = r.vectorIndex
+		return nil
+	}
+
+	// the index is loaded again from its commit logs, so all future commit
+	// logs are written under the name of the shard's vector index
+	if err := r.vectorIndex.Shutdown(); err != nil {
+		return errors.Wrap(err, "shutdown rebuilt vector index")
+	}
+
+	if err := hnsw.RenameCommitLogs(s.index.Config.RootPath,
+		reindexVectorIndexID(s.ID()), s.ID()); err != nil {
+		return errors.Wrap(err, "rename rebuilt vector index")
+	}
+
+	vi, err := s.newHnswIndex(s.ID(), s.vectorByIndexID, r.vectorIndexConfig)
+	if err != nil {
+		return errors.Wrap(err, "load rebuilt vector index")
+	}
+	vi.PostStartup()
+
+	s.vectorIndex = vi
+	return nil
+}
+
+// abortReindex removes the side-by-side indices of a reindex job, the live
+// indices are not affected
+func (s *Shard) abortReindex(ctx context.Context) error {
+	s.reindexLock.Lock()
+	defer s.reindexLock.Unlock()
+
+	r := s.reindex
+	s.reindex = nil
+	if r == nil {
+		return nil
+	}
+
+	if r.vectorIndex != nil {
+		if err := r.vectorIndex.Shutdown(); err != nil {
+			return errors.Wrap(err, "shutdown vector index")
+		}
+
+		return hnsw.RemoveCommitLogs(s.index.Config.RootPath,
+			reindexVectorIndexID(s.ID()))
+	}
+
+	for _, prop := range r.props {
+		reindexProp := *prop
+		reindexProp.Name = helpers.ReindexProp(prop.Name)
+		if err := s.dropPropertyIndices(ctx, &reindexProp); err != nil {
+			return errors.Wrapf(err, "prop %q", prop.Name)
+		}
+	}
+
+	return nil
+}
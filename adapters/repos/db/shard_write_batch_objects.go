@@ -25,6 +25,9 @@ import (
 // return value map[int]error gives the error for the index as it received it
 func (s *Shard) putObjectBatch(ctx context.Context,
 	objects []*storobj.Object) map[int]error {
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	return newObjectsBatcher(s).Objects(ctx, objects)
 }
 
@@ -203,6 +206,11 @@ func (b *objectsBatcher) storeSingleObjectInAdditionalStorage(ctx context.Contex
 		return
 	}
 
+	if err := b.shard.updateNamedVectorIndices(object, status); err != nil {
+		b.setErrorAtIndex(errors.Wrap(err, "insert to named vector indices"), index)
+		return
+	}
+
 	if err := b.shard.updatePropertySpecificIndices(object, status); err != nil {
 		b.setErrorAtIndex(errors.Wrap(err, "update prop-specific indices"), index)
 		return
@@ -260,6 +268,12 @@ func (b *objectsBatcher) flushWALs(ctx context.Context) {
 			b.setErrorAtIndex(err, i)
 		}
 	}
+
+	if err := b.shard.flushNamedVectorIndices(); err != nil {
+		for i := range b.objects {
+			b.setErrorAtIndex(err, i)
+		}
+	}
 }
 
 // returns the originalIndexIDs to be ignored
@@ -28,6 +28,9 @@ import (
 // return value map[int]error gives the error for the index as it received it
 func (s *Shard) addReferencesBatch(ctx context.Context,
 	refs objects.BatchReferences) map[int]error {
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	return newReferencesBatcher(s).References(ctx, refs)
 }
 
@@ -261,7 +264,7 @@ func (b *referencesBatcher) analyzeRef(obj *storobj.Object,
 		refs = parsed
 	}
 
-	a := inverted.NewAnalyzer()
+	a := inverted.NewAnalyzer(nil)
 
 	countItems, err := a.RefCount(refs)
 	if err != nil {
@@ -22,6 +22,9 @@ import (
 )
 
 func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	idBytes, err := uuid.MustParse(id.String()).MarshalBinary()
 	if err != nil {
 		return err
@@ -51,6 +54,10 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "delete object from bucket")
 	}
 
+	if err := s.updateReindexBinary(existing, nil); err != nil {
+		return errors.Wrap(err, "update indices of reindex job")
+	}
+
 	// in-mem
 	// TODO: do we still need this?
 	s.deletedDocIDs.Add(docID)
@@ -59,6 +66,10 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "delete from vector index")
 	}
 
+	if err := s.deleteFromNamedVectorIndices(docID); err != nil {
+		return errors.Wrap(err, "delete from named vector indices")
+	}
+
 	if err := s.store.WriteWALs(); err != nil {
 		return errors.Wrap(err, "flush all buffered WALs")
 	}
@@ -67,6 +78,10 @@ func (s *Shard) deleteObject(ctx context.Context, id strfmt.UUID) error {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
+	if err := s.flushNamedVectorIndices(); err != nil {
+		return errors.Wrap(err, "flush all named vector index buffered WALs")
+	}
+
 	return nil
 }
 
@@ -20,20 +20,36 @@ import (
 )
 
 func (s *Shard) analyzeObject(object *storobj.Object) ([]inverted.Property, error) {
+	schemaModel := s.index.getSchema.GetSchemaSkipAuth().Objects
+	c, err := schema.GetClassByName(schemaModel, object.Class().String())
+	if err != nil {
+		return nil, err
+	}
+
+	var schemaMap map[string]interface{}
 	if object.Properties() == nil {
-		return nil, nil
+		// an object without any props still has its id and the null state of
+		// its props indexed
+		schemaMap = map[string]interface{}{}
+	} else {
+		var ok bool
+		schemaMap, ok = object.Properties().(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("expected schema to be map, but got %T", object.Properties())
+		}
 	}
 
-	schemaModel := s.index.getSchema.GetSchemaSkipAuth().Objects
-	c, err := schema.GetClassByName(schemaModel, object.Class().String())
+	analyzer := s.index.analyzer()
+	props, err := analyzer.Object(schemaMap, c.Properties, object.ID())
 	if err != nil {
 		return nil, err
 	}
 
-	schemaMap, ok := object.Properties().(map[string]interface{})
-	if !ok {
-		return nil, fmt.Errorf("expected schema to be map, but got %T", object.Properties())
+	timestamps, err := analyzer.Timestamps(object.CreationTimeUnix(),
+		object.LastUpdateTimeUnix())
+	if err != nil {
+		return nil, err
 	}
 
-	return inverted.NewAnalyzer().Object(schemaMap, c.Properties, object.ID())
+	return append(props, timestamps...), nil
 }
@@ -51,6 +51,34 @@ func (s *Shard) extendInvertedIndicesLSM(props []inverted.Property,
 				}
 			}
 		}
+
+		if prop.HasTrigramIndex {
+			if err := s.extendTrigramIndexLSM(prop); err != nil {
+				return errors.Wrapf(err, "extend trigram index of prop '%s'", prop.Name)
+			}
+		}
+	}
+
+	return nil
+}
+
+// extendTrigramIndexLSM adds the terms of the prop to the rows of their
+// trigrams. Terms are never removed from the trigram index, a term which is
+// no longer contained in any doc simply has an empty row in the prop bucket,
+// which is skipped when reading.
+func (s *Shard) extendTrigramIndexLSM(prop inverted.Property) error {
+	b := s.store.Bucket(helpers.BucketFromPropNameLSM(helpers.TrigramProp(prop.Name)))
+	if b == nil {
+		return errors.Errorf("no trigram bucket for prop '%s' found", prop.Name)
+	}
+
+	for _, item := range prop.Items {
+		for _, trigram := range inverted.TermTrigrams(item.Data) {
+			if err := b.SetAdd(trigram, [][]byte{item.Data}); err != nil {
+				return errors.Wrapf(err, "add term '%s' to trigram '%s'",
+					string(item.Data), string(trigram))
+			}
+		}
 	}
 
 	return nil
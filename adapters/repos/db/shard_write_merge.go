@@ -23,6 +23,9 @@ import (
 )
 
 func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) error {
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	idBytes, err := uuid.MustParse(merge.ID.String()).MarshalBinary()
 	if err != nil {
 		return err
@@ -37,6 +40,10 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.updateNamedVectorIndices(next, status); err != nil {
+		return errors.Wrap(err, "update named vector indices")
+	}
+
 	if err := s.store.WriteWALs(); err != nil {
 		return errors.Wrap(err, "flush all buffered WALs")
 	}
@@ -45,6 +52,10 @@ func (s *Shard) mergeObject(ctx context.Context, merge objects.MergeDocument) er
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
+	if err := s.flushNamedVectorIndices(); err != nil {
+		return errors.Wrap(err, "flush all named vector index buffered WALs")
+	}
+
 	return nil
 }
 
@@ -80,6 +91,10 @@ func (s *Shard) mergeObjectInStorage(merge objects.MergeDocument,
 		return nil, status, errors.Wrap(err, "udpate inverted indices")
 	}
 
+	if err := s.updateReindexBinary(previous, nextObj); err != nil {
+		return nil, status, errors.Wrap(err, "update indices of reindex job")
+	}
+
 	return nextObj, status, nil
 }
 
@@ -139,6 +154,10 @@ func (s *Shard) mutableMergeObjectLSM(merge objects.MergeDocument,
 	// do not updated inverted index, since this requires delta analysis, which
 	// must be done by the caller!
 
+	if err := s.updateReindexBinary(previous, nextObj); err != nil {
+		return out, errors.Wrap(err, "update indices of reindex job")
+	}
+
 	return out, nil
 }
 
@@ -199,6 +218,10 @@ func mergeProps(previous *storobj.Object,
 		next.Vector = merge.Vector
 	}
 
+	for name, vector := range merge.Vectors {
+		next.SetNamedVector(name, vector)
+	}
+
 	next.SetProperties(properties)
 
 	return next
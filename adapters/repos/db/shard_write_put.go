@@ -25,6 +25,9 @@ import (
 )
 
 func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
+	s.reindexLock.RLock()
+	defer s.reindexLock.RUnlock()
+
 	idBytes, err := uuid.MustParse(object.ID().String()).MarshalBinary()
 	if err != nil {
 		return err
@@ -41,6 +44,10 @@ func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
 		return errors.Wrap(err, "update vector index")
 	}
 
+	if err := s.updateNamedVectorIndices(object, status); err != nil {
+		return errors.Wrap(err, "update named vector indices")
+	}
+
 	if err := s.updatePropertySpecificIndices(object, status); err != nil {
 		return errors.Wrap(err, "update property-specific indices")
 	}
@@ -53,6 +60,10 @@ func (s *Shard) putObject(ctx context.Context, object *storobj.Object) error {
 		return errors.Wrap(err, "flush all vector index buffered WALs")
 	}
 
+	if err := s.flushNamedVectorIndices(); err != nil {
+		return errors.Wrap(err, "flush all named vector index buffered WALs")
+	}
+
 	return nil
 }
 
@@ -107,6 +118,10 @@ func (s *Shard) putObjectLSM(object *storobj.Object,
 		s.metrics.PutObjectUpdateInverted(before)
 	}
 
+	if err := s.updateReindexBinary(previous, object); err != nil {
+		return status, errors.Wrap(err, "update indices of reindex job")
+	}
+
 	return status, nil
 }
 
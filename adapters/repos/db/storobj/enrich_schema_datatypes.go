@@ -27,6 +27,11 @@ func (ko *Object) enrichSchemaTypes(schema map[string]interface{}) error {
 	for propName, value := range schema {
 		switch typed := value.(type) {
 		case []interface{}:
+			if !isCrossRefList(typed) {
+				// object[] and array props, such as string[], are stored as is
+				continue
+			}
+
 			parsed, err := parseCrossRef(typed)
 			if err != nil {
 				return errors.Wrapf(err, "property %q of type cross-ref", propName)
@@ -63,7 +68,27 @@ func parseMapProp(input map[string]interface{}) (interface{}, error) {
 		return parsePhoneNumber(input)
 	}
 
-	return nil, fmt.Errorf("unknown map prop which is not a geo prop or phone: %v", input)
+	// neither geo nor phone, so this is a nested object, which is stored as is
+	return input, nil
+}
+
+// isCrossRefList tells lists of cross-refs apart from lists of nested
+// objects and lists of primitive values, which are the other props stored as
+// lists. Every cross-ref has a beacon. Empty lists are treated as cross-refs
+// as they are indistinguishable and were always parsed as such.
+func isCrossRefList(value []interface{}) bool {
+	for _, elem := range value {
+		asMap, ok := elem.(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		if _, ok := asMap["beacon"]; !ok {
+			return false
+		}
+	}
+
+	return true
 }
 
 func parseGeoProp(lat interface{}, lon interface{}) (*models.GeoCoordinates, error) {
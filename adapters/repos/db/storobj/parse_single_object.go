@@ -32,6 +32,49 @@ func ParseAndExtractTextProp(data []byte, propName string) (string, bool, error)
 	return string(val), len(val) > 0, err
 }
 
+// ParseAndExtractTextArrayProp is the counterpart of ParseAndExtractTextProp
+// for string[] and text[] props
+func ParseAndExtractTextArrayProp(data []byte, propName string) ([]string, bool, error) {
+	propsBytes, err := extractPropsBytes(data)
+	if err != nil {
+		return nil, false, err
+	}
+
+	var out []string
+	var parseErr error
+	_, err = jsonparser.ArrayEach(propsBytes, func(value []byte,
+		dataType jsonparser.ValueType, offset int, err error) {
+		if parseErr != nil {
+			return
+		}
+
+		if dataType != jsonparser.String {
+			parseErr = errors.Errorf("expected elements of prop %s to be strings, "+
+				"but got %s", propName, dataType)
+			return
+		}
+
+		item, err := jsonparser.ParseString(value)
+		if err != nil {
+			parseErr = errors.Wrapf(err, "prop %s", propName)
+			return
+		}
+
+		out = append(out, item)
+	}, propName)
+	if err == jsonparser.KeyPathNotFoundError {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	if parseErr != nil {
+		return nil, false, parseErr
+	}
+
+	return out, len(out) > 0, nil
+}
+
 func extractPropsBytes(data []byte) ([]byte, error) {
 	version := uint8(data[0])
 	if version != 1 {
@@ -16,6 +16,7 @@ import (
 	"encoding/binary"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/go-openapi/strfmt"
@@ -136,6 +137,20 @@ func (ko *Object) SetProperties(schema models.PropertySchema) {
 	ko.Object.Properties = schema
 }
 
+// NamedVectors are the additional vectors of an object next to its main
+// vector, keyed by name
+func (ko *Object) NamedVectors() models.C11yVectors {
+	return ko.Object.Vectors
+}
+
+func (ko *Object) SetNamedVector(name string, vector []float32) {
+	if ko.Object.Vectors == nil {
+		ko.Object.Vectors = models.C11yVectors{}
+	}
+
+	ko.Object.Vectors[name] = vector
+}
+
 func (ko *Object) VectorWeights() models.VectorWeights {
 	return ko.Object.VectorWeights
 }
@@ -165,11 +180,13 @@ func (ko *Object) SearchResult(additional traverser.AdditionalProperties) *searc
 		ClassName: ko.Class().String(),
 		Schema:    ko.Properties(),
 		Vector:    ko.Vector,
+		Vectors:   ko.NamedVectors(),
 		// VectorWeights: ko.VectorWeights(), // TODO: add vector weights
 		Created:              ko.CreationTimeUnix(),
 		Updated:              ko.LastUpdateTimeUnix(),
 		AdditionalProperties: additionalProperties,
 		Score:                1, // TODO: actuallly score
+		Tenant:               ko.Object.Tenant,
 		// TODO: Beacon?
 	}
 }
@@ -229,6 +246,14 @@ func DocIDFromBinary(in []byte) (uint64, error) {
 // n          | []byte    | meta as json
 // 2          | uint32    | length of vectorweights json
 // n          | []byte    | vectorweights as json
+// 2          | uint16    | number of named vectors (optional, absent in older objects)
+//
+// then for each named vector:
+//
+// 2          | uint16    | length of name
+// n          | []byte    | name
+// 2          | uint16    | VectorLength
+// n*4        | []float32 | vector of length n
 func (ko *Object) MarshalBinary() ([]byte, error) {
 	if ko.MarshallerVersion != 1 {
 		return nil, fmt.Errorf("unsupported marshaller version %d", ko.MarshallerVersion)
@@ -289,6 +314,7 @@ func (ko *Object) MarshalBinary() ([]byte, error) {
 	ec.add(binary.Write(buf, le, vectorWeightsLength))
 	_, err = buf.Write(vectorWeights)
 	ec.add(err)
+	ec.add(ko.writeNamedVectors(buf))
 
 	return buf.Bytes(), ec.toError()
 }
@@ -348,6 +374,14 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 	_, err = r.Read(vectorWeights)
 	ec.add(err)
 
+	var namedVectors models.C11yVectors
+	if r.Len() > 0 {
+		// objects written before named vectors were introduced end right after
+		// the vector weights
+		namedVectors, err = readNamedVectors(r)
+		ec.add(err)
+	}
+
 	if ec.toError() != nil {
 		return err
 	}
@@ -365,11 +399,77 @@ func (ko *Object) UnmarshalBinary(data []byte) error {
 		schema,
 		meta,
 		vectorWeights,
+		namedVectors,
 	)
 }
 
+func (ko *Object) writeNamedVectors(buf *bytes.Buffer) error {
+	le := binary.LittleEndian
+	ec := &errorCompounder{}
+
+	// sort the names, so that the same object always leads to the same binary
+	// representation
+	names := make([]string, 0, len(ko.NamedVectors()))
+	for name := range ko.NamedVectors() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ec.add(binary.Write(buf, le, uint16(len(names))))
+	for _, name := range names {
+		vector := []float32(ko.NamedVectors()[name])
+		ec.add(binary.Write(buf, le, uint16(len(name))))
+		_, err := buf.Write([]byte(name))
+		ec.add(err)
+		ec.add(binary.Write(buf, le, uint16(len(vector))))
+		ec.add(binary.Write(buf, le, vector))
+	}
+
+	return ec.toError()
+}
+
+func readNamedVectors(r *bytes.Reader) (models.C11yVectors, error) {
+	le := binary.LittleEndian
+	var count uint16
+	if err := binary.Read(r, le, &count); err != nil {
+		return nil, err
+	}
+
+	if count == 0 {
+		return nil, nil
+	}
+
+	out := make(models.C11yVectors, count)
+	for i := uint16(0); i < count; i++ {
+		var nameLength uint16
+		if err := binary.Read(r, le, &nameLength); err != nil {
+			return nil, err
+		}
+
+		name := make([]byte, nameLength)
+		if _, err := r.Read(name); err != nil {
+			return nil, err
+		}
+
+		var vectorLength uint16
+		if err := binary.Read(r, le, &vectorLength); err != nil {
+			return nil, err
+		}
+
+		vector := make([]float32, vectorLength)
+		if err := binary.Read(r, le, &vector); err != nil {
+			return nil, err
+		}
+
+		out[string(name)] = vector
+	}
+
+	return out, nil
+}
+
 func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className string,
-	schemaB []byte, additionalB []byte, vectorWeightsB []byte) error {
+	schemaB []byte, additionalB []byte, vectorWeightsB []byte,
+	namedVectors models.C11yVectors) error {
 	var schema map[string]interface{}
 	if err := json.Unmarshal(schemaB, &schema); err != nil {
 		return err
@@ -411,6 +511,7 @@ func (ko *Object) parseObject(uuid strfmt.UUID, create, update int64, className
 		ID:                 uuid,
 		Properties:         schema,
 		VectorWeights:      vectorWeights,
+		Vectors:            namedVectors,
 		Additional:         additionalProperties,
 	}
 
@@ -438,6 +539,18 @@ func deepCopyVector(orig []float32) []float32 {
 	return out
 }
 
+func deepCopyNamedVectors(orig models.C11yVectors) models.C11yVectors {
+	if orig == nil {
+		return nil
+	}
+
+	out := make(models.C11yVectors, len(orig))
+	for name, vector := range orig {
+		out[name] = deepCopyVector(vector)
+	}
+	return out
+}
+
 func deepCopyObject(orig models.Object) models.Object {
 	return models.Object{
 		Class:              orig.Class,
@@ -445,6 +558,7 @@ func deepCopyObject(orig models.Object) models.Object {
 		CreationTimeUnix:   orig.CreationTimeUnix,
 		LastUpdateTimeUnix: orig.LastUpdateTimeUnix,
 		Vector:             deepCopyVector(orig.Vector),
+		Vectors:            deepCopyNamedVectors(orig.Vectors),
 		VectorWeights:      orig.VectorWeights,
 		Additional:         orig.Additional, // WARNING: not a deep copy!!
 		Properties:         deepCopyProperties(orig.Properties),
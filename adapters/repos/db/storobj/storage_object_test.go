@@ -77,6 +77,153 @@ func TestStorageObjectMarshalling(t *testing.T) {
 	})
 }
 
+func TestStorageObjectMarshallingWithNestedObjects(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"address": map[string]interface{}{
+					"city":   "Amsterdam",
+					"number": float64(7),
+				},
+				"previousAddresses": []interface{}{
+					map[string]interface{}{
+						"city": "Berlin",
+					},
+					map[string]interface{}{
+						"city": "Paris",
+						"location": map[string]interface{}{
+							"floor": float64(3),
+						},
+					},
+				},
+				"friends": models.MultipleRef{
+					&models.SingleRef{
+						Beacon: "weaviate://localhost/4fd3e8a4-1a2b-4a48-b2b6-0d4cf3fbd5b1",
+					},
+				},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	after, err := FromBinary(asBinary)
+	require.Nil(t, err)
+
+	assert.Equal(t, before, after)
+}
+
+func TestStorageObjectMarshallingWithArrayProps(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"tags":   []interface{}{"green", "blue"},
+				"scores": []interface{}{float64(1), float64(2.5)},
+				"flags":  []interface{}{true, false},
+				"dates":  []interface{}{"2021-01-01T00:00:00Z"},
+				"friends": models.MultipleRef{
+					&models.SingleRef{
+						Beacon: "weaviate://localhost/4fd3e8a4-1a2b-4a48-b2b6-0d4cf3fbd5b1",
+					},
+				},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("compare", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+
+		assert.Equal(t, before, after)
+	})
+
+	t.Run("extract single text array prop", func(t *testing.T) {
+		prop, ok, err := ParseAndExtractTextArrayProp(asBinary, "tags")
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, []string{"green", "blue"}, prop)
+	})
+
+	t.Run("extract missing text array prop", func(t *testing.T) {
+		_, ok, err := ParseAndExtractTextArrayProp(asBinary, "colors")
+		require.Nil(t, err)
+		assert.False(t, ok)
+	})
+}
+
+func TestStorageObjectMarshallingWithNamedVectors(t *testing.T) {
+	before := FromObject(
+		&models.Object{
+			Class:              "MyFavoriteClass",
+			CreationTimeUnix:   123456,
+			LastUpdateTimeUnix: 56789,
+			ID:                 strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+			Properties: map[string]interface{}{
+				"name": "MyName",
+			},
+			Vectors: models.C11yVectors{
+				"title_vector": {1, 2, 3},
+				"body_vector":  {0.5, 0.25},
+			},
+		},
+		[]float32{1, 2, 0.7},
+	)
+
+	before.SetDocID(7)
+
+	asBinary, err := before.MarshalBinary()
+	require.Nil(t, err)
+
+	t.Run("compare", func(t *testing.T) {
+		after, err := FromBinary(asBinary)
+		require.Nil(t, err)
+
+		assert.Equal(t, before, after)
+		assert.Equal(t, []float32{1, 2, 3},
+			[]float32(after.NamedVectors()["title_vector"]))
+	})
+
+	t.Run("extract single text prop", func(t *testing.T) {
+		prop, ok, err := ParseAndExtractTextProp(asBinary, "name")
+		require.Nil(t, err)
+		require.True(t, ok)
+		assert.Equal(t, "MyName", prop)
+	})
+
+	t.Run("objects written without named vectors can still be read", func(t *testing.T) {
+		withoutNamed := FromObject(&models.Object{
+			Class: "MyFavoriteClass",
+			ID:    strfmt.UUID("73f2eb5f-5abf-447a-81ca-74b1dd168247"),
+		}, []float32{1, 2, 0.7})
+
+		asBinary, err := withoutNamed.MarshalBinary()
+		require.Nil(t, err)
+
+		// strip the named vectors count to simulate an object written before
+		// named vectors existed
+		legacy := asBinary[:len(asBinary)-2]
+
+		after, err := FromBinary(legacy)
+		require.Nil(t, err)
+		assert.Nil(t, after.NamedVectors())
+		assert.Equal(t, withoutNamed.Vector, after.Vector)
+	})
+}
+
 func TestNewStorageObject(t *testing.T) {
 	t.Run("objects", func(t *testing.T) {
 		so := New(12)
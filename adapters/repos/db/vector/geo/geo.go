@@ -43,6 +43,7 @@ type vectorIndex interface {
 		allowList helpers.AllowList) ([]uint64, error)
 	Delete(id uint64) error
 	Dump(...string)
+	Drop() error
 }
 
 // Config is passed to the GeoIndex when its created
@@ -119,3 +120,8 @@ func (i *Index) WithinRange(ctx context.Context,
 func (i *Index) Delete(id uint64) error {
 	return i.vectorIndex.Delete(id)
 }
+
+// Drop removes the index including all of its files
+func (i *Index) Drop() error {
+	return i.vectorIndex.Drop()
+}
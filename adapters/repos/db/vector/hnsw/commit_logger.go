@@ -40,6 +40,19 @@ func commitLogDirectory(rootPath, name string) string {
 	return fmt.Sprintf("%s/%s.hnsw.commitlog.d", rootPath, name)
 }
 
+// RemoveCommitLogs removes the commit logs of the index with the given name,
+// such as those left behind by an index which was only partially built
+func RemoveCommitLogs(rootPath, name string) error {
+	return os.RemoveAll(commitLogDirectory(rootPath, name))
+}
+
+// RenameCommitLogs moves the commit logs of an index, so they are loaded by
+// an index with the new name
+func RenameCommitLogs(rootPath, name, newName string) error {
+	return os.Rename(commitLogDirectory(rootPath, name),
+		commitLogDirectory(rootPath, newName))
+}
+
 func NewCommitLogger(rootPath, name string,
 	maintainenceInterval time.Duration,
 	logger logrus.FieldLogger) (*hnswCommitLogger, error) {
@@ -548,6 +561,20 @@ func (l *hnswCommitLogger) Drop() error {
 	return nil
 }
 
+// Shutdown stops all maintenance goroutines and flushes the current log
+// before closing it. Nothing is removed from disk
+func (l *hnswCommitLogger) Shutdown() error {
+	l.cancel <- struct{}{}
+
+	l.Lock()
+	defer l.Unlock()
+	if err := l.logWriter.Flush(); err != nil {
+		return errors.Wrap(err, "flush commit log")
+	}
+
+	return l.logFile.Close()
+}
+
 func (l *hnswCommitLogger) Flush() error {
 	return l.logWriter.Flush()
 }
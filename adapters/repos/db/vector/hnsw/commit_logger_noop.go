@@ -59,6 +59,10 @@ func (n *NoopCommitLogger) Drop() error {
 	return nil
 }
 
+func (n *NoopCommitLogger) Shutdown() error {
+	return nil
+}
+
 func MakeNoopCommitLogger() (CommitLogger, error) {
 	return &NoopCommitLogger{}, nil
 }
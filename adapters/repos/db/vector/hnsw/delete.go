@@ -26,6 +26,13 @@ func (h *hnsw) Delete(id uint64) error {
 	h.deleteLock.Lock()
 	defer h.deleteLock.Unlock()
 
+	if !h.isInRange(id) {
+		// the id is outside of the range of the index, so it was never added.
+		// This can happen on indices which do not contain every doc id, such as
+		// the indices of named vectors
+		return nil
+	}
+
 	if err := h.addTombstone(id); err != nil {
 		return err
 	}
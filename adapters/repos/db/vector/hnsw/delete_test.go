@@ -773,3 +773,32 @@ func bruteForceCosine(vectors [][]float32, query []float32, k int) []uint64 {
 
 	return out
 }
+
+func TestDelete_IDWhichWasNeverAdded(t *testing.T) {
+	vecForID := func(ctx context.Context, id uint64) ([]float32, error) {
+		return []float32{0.1, 0.2}, nil
+	}
+	index, err := New(Config{
+		RootPath:              "doesnt-matter-as-committlogger-is-mocked-out",
+		ID:                    "never-added-test",
+		MakeCommitLoggerThunk: MakeNoopCommitLogger,
+		DistanceProvider:      distancer.NewCosineProvider(),
+		VectorForIDThunk:      vecForID,
+	}, UserConfig{
+		MaxConnections:         30,
+		EFConstruction:         128,
+		CleanupIntervalSeconds: 0,
+	})
+	require.Nil(t, err)
+
+	require.Nil(t, index.Add(0, []float32{0.1, 0.2}))
+
+	// an id far outside the range of the index, such as a doc id which only
+	// exists in another index of the same shard
+	require.Nil(t, index.Delete(initialSize*10))
+	require.Nil(t, index.CleanUpTombstonedNodes())
+
+	res, err := index.SearchByVector([]float32{0.05, 0.05}, 100, nil)
+	require.Nil(t, err)
+	assert.Equal(t, []uint64{0}, res)
+}
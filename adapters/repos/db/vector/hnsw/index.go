@@ -85,6 +85,11 @@ type hnsw struct {
 	// used for cancellation of the tombstone cleanup goroutine
 	cancel chan struct{}
 
+	// used to stop the vector cache prefill, which reads the vectors from the
+	// store of the shard, before the shard is shut down
+	prefillCancel context.CancelFunc
+	prefillDone   chan struct{}
+
 	// // for distributed spike, can be used to call a insertExternal on a different graph
 	// insertHook func(node, targetLevel int, neighborsAtLevel map[int][]uint32)
 
@@ -109,6 +114,7 @@ type CommitLogger interface {
 	ClearLinks(nodeid uint64) error
 	Reset() error
 	Drop() error
+	Shutdown() error
 	NewBufferedLinksLogger() BufferedLinksLogger
 	Flush() error
 }
@@ -475,6 +481,13 @@ func (h *hnsw) isEmpty() bool {
 	return true
 }
 
+func (h *hnsw) isInRange(id uint64) bool {
+	h.Lock()
+	defer h.Unlock()
+
+	return id < uint64(len(h.nodes))
+}
+
 func (h *hnsw) nodeByID(id uint64) *vertex {
 	h.Lock()
 	defer h.Unlock()
@@ -483,6 +496,7 @@ func (h *hnsw) nodeByID(id uint64) *vertex {
 }
 
 func (h *hnsw) Drop() error {
+	h.stopPrefill()
 	// cancel commit log goroutine
 	err := h.commitLog.Drop()
 	if err != nil {
@@ -495,6 +509,21 @@ func (h *hnsw) Drop() error {
 	return nil
 }
 
+// Shutdown stops all background goroutines of the index, but - unlike Drop -
+// keeps the commit log on disk, so the index can be loaded again later on
+func (h *hnsw) Shutdown() error {
+	h.stopPrefill()
+	if err := h.commitLog.Shutdown(); err != nil {
+		return errors.Wrap(err, "commit log shutdown")
+	}
+	h.cache.drop()
+	if h.cleanupInterval != 0 {
+		// the tombstone cleanup goroutine is only started with an interval set
+		h.cancel <- struct{}{}
+	}
+	return nil
+}
+
 func (h *hnsw) Flush() error {
 	return h.commitLog.Flush()
 }
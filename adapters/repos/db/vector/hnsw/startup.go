@@ -133,13 +133,28 @@ func (h *hnsw) prefillCache() {
 	// demand based on actual load as opposed to our predictions.
 	limit := 500000 / 2 // TODO: v1 make configurable when cache is configurable.
 
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	h.prefillCancel = cancel
+	h.prefillDone = make(chan struct{})
+
 	go func() {
-		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		defer close(h.prefillDone)
 		defer cancel()
 
 		err := newVectorCachePrefiller(h.cache, h, h.logger).Prefill(ctx, limit)
-		if err != nil {
+		if err != nil && !errors.Is(err, context.Canceled) {
 			h.logger.WithError(err).Error("prefill vector cache")
 		}
 	}()
 }
+
+// stopPrefill cancels a running vector cache prefill and waits for it to
+// return
+func (h *hnsw) stopPrefill() {
+	if h.prefillCancel == nil {
+		return
+	}
+
+	h.prefillCancel()
+	<-h.prefillDone
+}
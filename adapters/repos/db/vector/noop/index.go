@@ -46,6 +46,10 @@ func (i *Index) Drop() error {
 	return nil
 }
 
+func (i *Index) Shutdown() error {
+	return nil
+}
+
 func (i *Index) Flush() error {
 	return nil
 }
@@ -24,5 +24,6 @@ type VectorIndex interface {
 	SearchByVector(vector []float32, k int, allow helpers.AllowList) ([]uint64, error)
 	UpdateUserConfig(updated schema.VectorIndexConfig) error
 	Drop() error
+	Shutdown() error
 	Flush() error
 }
@@ -59,6 +59,16 @@ func Test_SchemaRepo(t *testing.T) {
 		expected := exampleSchema()
 		assert.Equal(t, &expected, res)
 	})
+
+	t.Run("the schema version is persisted", func(t *testing.T) {
+		state := exampleSchema()
+		state.ObjectSchema.Version = 7
+		require.Nil(t, r.SaveSchema(context.Background(), state))
+
+		res, err := r.LoadSchema(context.Background())
+		require.Nil(t, err)
+		assert.Equal(t, int64(7), res.ObjectSchema.Version)
+	})
 }
 
 func exampleSchema() schemauc.State {
@@ -85,6 +85,11 @@ type ObjectsReferencesCreateParams struct {
 
 	*/
 	PropertyName string
+	/*Tenant
+	  Specifies the tenant in a request targeting a multi-tenant class
+
+	*/
+	Tenant *string
 
 	timeout    time.Duration
 	Context    context.Context
@@ -157,6 +162,17 @@ func (o *ObjectsReferencesCreateParams) SetPropertyName(propertyName string) {
 	o.PropertyName = propertyName
 }
 
+// WithTenant adds the tenant to the objects references create params
+func (o *ObjectsReferencesCreateParams) WithTenant(tenant *string) *ObjectsReferencesCreateParams {
+	o.SetTenant(tenant)
+	return o
+}
+
+// SetTenant adds the tenant to the objects references create params
+func (o *ObjectsReferencesCreateParams) SetTenant(tenant *string) {
+	o.Tenant = tenant
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ObjectsReferencesCreateParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -181,6 +197,22 @@ func (o *ObjectsReferencesCreateParams) WriteToRequest(r runtime.ClientRequest,
 		return err
 	}
 
+	if o.Tenant != nil {
+
+		// query param tenant
+		var qrTenant string
+		if o.Tenant != nil {
+			qrTenant = *o.Tenant
+		}
+		qTenant := qrTenant
+		if qTenant != "" {
+			if err := r.SetQueryParam("tenant", qTenant); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
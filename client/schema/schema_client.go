@@ -38,6 +38,16 @@ type Client struct {
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	SchemaAliasesCreate(params *SchemaAliasesCreateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaAliasesCreateOK, error)
+
+	SchemaAliasesDelete(params *SchemaAliasesDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaAliasesDeleteOK, error)
+
+	SchemaAliasesUpdate(params *SchemaAliasesUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaAliasesUpdateOK, error)
+
+	SchemaApply(params *SchemaApplyParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaApplyOK, error)
+
+	SchemaDiff(params *SchemaDiffParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaDiffOK, error)
+
 	SchemaDump(params *SchemaDumpParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaDumpOK, error)
 
 	SchemaObjectsCreate(params *SchemaObjectsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsCreateOK, error)
@@ -48,11 +58,208 @@ type ClientService interface {
 
 	SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsPropertiesAddOK, error)
 
+	SchemaObjectsPropertiesDelete(params *SchemaObjectsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsPropertiesDeleteOK, error)
+
+	SchemaObjectsPropertiesUpdate(params *SchemaObjectsPropertiesUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsPropertiesUpdateOK, error)
+
+	SchemaObjectsReindex(params *SchemaObjectsReindexParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsReindexCreated, error)
+
+	SchemaObjectsReindexGet(params *SchemaObjectsReindexGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsReindexGetOK, error)
+
 	SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsUpdateOK, error)
 
+	TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsCreateOK, error)
+
+	TenantsDelete(params *TenantsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsDeleteOK, error)
+
+	TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsGetOK, error)
+
+	TenantsUpdate(params *TenantsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsUpdateOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+  SchemaAliasesCreate creates a new alias pointing to an existing class
+*/
+func (a *Client) SchemaAliasesCreate(params *SchemaAliasesCreateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaAliasesCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaAliasesCreateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.aliases.create",
+		Method:             "POST",
+		PathPattern:        "/schema/aliases",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaAliasesCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaAliasesCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.aliases.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaAliasesDelete removes an alias the class it points to is not affected
+*/
+func (a *Client) SchemaAliasesDelete(params *SchemaAliasesDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaAliasesDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaAliasesDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.aliases.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/aliases/{aliasName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaAliasesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaAliasesDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.aliases.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaAliasesUpdate repoints an existing alias to another class
+
+  All queries and objects using the alias are resolved to the new class as soon as the request succeeds.
+*/
+func (a *Client) SchemaAliasesUpdate(params *SchemaAliasesUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaAliasesUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaAliasesUpdateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.aliases.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/aliases/{aliasName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaAliasesUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaAliasesUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.aliases.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaApply applies the additive changes of a schema to the current database schema
+
+  Adds the classes and properties of the submitted schema which are missing in the current database schema. Either all of them are added or none. All other changes are only reported and have to be made manually.
+*/
+func (a *Client) SchemaApply(params *SchemaApplyParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaApplyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaApplyParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.apply",
+		Method:             "POST",
+		PathPattern:        "/schema/apply",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaApplyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaApplyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.apply: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaDiff compares a schema with the current database schema
+
+  Computes the changes required to turn the current database schema into the submitted one, e.g. a schema exported from another environment with GET /schema.
+*/
+func (a *Client) SchemaDiff(params *SchemaDiffParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaDiffOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaDiffParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.diff",
+		Method:             "POST",
+		PathPattern:        "/schema/diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaDiffReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaDiffOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.diff: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   SchemaDump dumps the current the database schema
 */
@@ -228,6 +435,154 @@ func (a *Client) SchemaObjectsPropertiesAdd(params *SchemaObjectsPropertiesAddPa
 	panic(msg)
 }
 
+/*
+  SchemaObjectsPropertiesDelete removes a property and its values in all instances from an object class
+
+  The inverted index of the property is removed immediately. The values of the property are stripped from the stored objects in the background.
+*/
+func (a *Client) SchemaObjectsPropertiesDelete(params *SchemaObjectsPropertiesDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsPropertiesDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsPropertiesDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.objects.properties.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/properties/{propertyName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsPropertiesDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsPropertiesDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaObjectsPropertiesUpdate updates an existing property of an object class
+
+  Use this endpoint to rename a property or to migrate its data type. Only widening data type migrations are supported, i.e. from int to number and from string to text. The inverted index of the property is rebuilt in the background after a data type migration.
+*/
+func (a *Client) SchemaObjectsPropertiesUpdate(params *SchemaObjectsPropertiesUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsPropertiesUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsPropertiesUpdateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.objects.properties.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/properties/{propertyName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsPropertiesUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsPropertiesUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.properties.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaObjectsReindex rebuilds indices of an object class in the background
+
+  Starts a reindex job which builds the inverted indices of the given properties or the vector index of the class side-by-side with the existing ones and swaps them in once complete. Use GET /schema/{className}/reindex/{id} to retrieve the status of the job.
+*/
+func (a *Client) SchemaObjectsReindex(params *SchemaObjectsReindexParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsReindexCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsReindexParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.objects.reindex",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/reindex",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsReindexReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsReindexCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.reindex: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  SchemaObjectsReindexGet views a previously started reindex job
+
+  Get the status of a reindex job of an Object class.
+*/
+func (a *Client) SchemaObjectsReindexGet(params *SchemaObjectsReindexGetParams, authInfo runtime.ClientAuthInfoWriter) (*SchemaObjectsReindexGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSchemaObjectsReindexGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "schema.objects.reindex.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/reindex/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &SchemaObjectsReindexGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SchemaObjectsReindexGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for schema.objects.reindex.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 /*
   SchemaObjectsUpdate updates settings of an existing schema class
 
@@ -265,6 +620,146 @@ func (a *Client) SchemaObjectsUpdate(params *SchemaObjectsUpdateParams, authInfo
 	panic(msg)
 }
 
+/*
+  TenantsCreate creates a new tenant for a specific class
+*/
+func (a *Client) TenantsCreate(params *TenantsCreateParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsCreateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsCreateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "tenants.create",
+		Method:             "POST",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsCreateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsCreateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.create: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  TenantsDelete deletes tenants from a specific class this removes all data of the tenants
+*/
+func (a *Client) TenantsDelete(params *TenantsDeleteParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsDeleteOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsDeleteParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "tenants.delete",
+		Method:             "DELETE",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsDeleteReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsDeleteOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.delete: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  TenantsGet gets all tenants of a specific class
+*/
+func (a *Client) TenantsGet(params *TenantsGetParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsGetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsGetParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "tenants.get",
+		Method:             "GET",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsGetReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsGetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.get: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  TenantsUpdate updates the activity status of the given tenants of a specific class inactive tenants are unloaded from memory until they are activated again
+*/
+func (a *Client) TenantsUpdate(params *TenantsUpdateParams, authInfo runtime.ClientAuthInfoWriter) (*TenantsUpdateOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTenantsUpdateParams()
+	}
+
+	result, err := a.transport.Submit(&runtime.ClientOperation{
+		ID:                 "tenants.update",
+		Method:             "PUT",
+		PathPattern:        "/schema/{className}/tenants",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json", "application/yaml"},
+		Schemes:            []string{"https"},
+		Params:             params,
+		Reader:             &TenantsUpdateReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	})
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TenantsUpdateOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for tenants.update: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -19,11 +19,15 @@ type Group struct {
 	Properties map[string]Property
 	GroupedBy  *GroupedBy // optional to support ungrouped aggregations (formerly meta)
 	Count      int
+	Groups     []Group // nested groups, only set when grouping by multiple levels
 }
 
 type Property struct {
 	Type                  PropertyType
 	NumericalAggregations map[string]float64
+	Percentiles           []Percentile      // only set for the percentile aggregator
+	Histogram             []HistogramBucket // only set for the histogram aggregator
+	DateAggregations      map[string]interface{}
 	TextAggregation       Text
 	BooleanAggregation    Boolean
 	SchemaType            string
@@ -31,8 +35,9 @@ type Property struct {
 }
 
 type Text struct {
-	Items []TextOccurrence
-	Count int
+	Items                    []TextOccurrence
+	Count                    int
+	ApproximateDistinctCount int // estimated with a HyperLogLog sketch
 }
 
 type PropertyType string
@@ -41,6 +46,7 @@ const (
 	PropertyTypeNumerical PropertyType = "numerical"
 	PropertyTypeBoolean   PropertyType = "boolean"
 	PropertyTypeText      PropertyType = "text"
+	PropertyTypeDate      PropertyType = "date"
 	PropertyTypeReference PropertyType = "cref"
 )
 
@@ -62,6 +68,20 @@ type Boolean struct {
 	PercentageFalse float64
 }
 
+// Percentile is the value below which the given percentage of values falls
+type Percentile struct {
+	Percentile float64
+	Value      float64
+}
+
+// HistogramBucket counts the values in [From, To), the last bucket of a
+// histogram also contains the values equal to To
+type HistogramBucket struct {
+	From  float64
+	To    float64
+	Count int
+}
+
 type Reference struct {
 	PointingTo []string
 }
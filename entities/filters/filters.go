@@ -30,6 +30,9 @@ const (
 	OperatorNot              Operator = 9
 	OperatorWithinGeoRange   Operator = 10
 	OperatorLike             Operator = 11
+	OperatorContainsAny      Operator = 12
+	OperatorContainsAll      Operator = 13
+	OperatorIsNull           Operator = 14
 )
 
 func (o Operator) OnValue() bool {
@@ -41,7 +44,10 @@ func (o Operator) OnValue() bool {
 		OperatorLessThan,
 		OperatorLessThanEqual,
 		OperatorWithinGeoRange,
-		OperatorLike:
+		OperatorLike,
+		OperatorContainsAny,
+		OperatorContainsAll,
+		OperatorIsNull:
 		return true
 	default:
 		return false
@@ -72,6 +78,12 @@ func (o Operator) Name() string {
 		return "WithinGeoRange"
 	case OperatorLike:
 		return "Like"
+	case OperatorContainsAny:
+		return "ContainsAny"
+	case OperatorContainsAll:
+		return "ContainsAll"
+	case OperatorIsNull:
+		return "IsNull"
 	default:
 		panic("Unknown operator")
 	}
@@ -33,6 +33,9 @@ func TestOperators(t *testing.T) {
 		test{op: OperatorLessThan, expectedName: "LessThan", expectedOnValue: true},
 		test{op: OperatorWithinGeoRange, expectedName: "WithinGeoRange", expectedOnValue: true},
 		test{op: OperatorLike, expectedName: "Like", expectedOnValue: true},
+		test{op: OperatorContainsAny, expectedName: "ContainsAny", expectedOnValue: true},
+		test{op: OperatorContainsAll, expectedName: "ContainsAll", expectedOnValue: true},
+		test{op: OperatorIsNull, expectedName: "IsNull", expectedOnValue: true},
 		test{op: OperatorAnd, expectedName: "And", expectedOnValue: false},
 		test{op: OperatorOr, expectedName: "Or", expectedOnValue: false},
 		test{op: OperatorNot, expectedName: "Not", expectedOnValue: false},
@@ -18,6 +18,18 @@ import (
 	"github.com/semi-technologies/weaviate/entities/schema"
 )
 
+// The timestamp paths can only be used in filters. Sorting by them is out of
+// scope until Get queries support sorting at all.
+// TODO: make the timestamp paths sortable once sorting is added to Get
+const (
+	// InternalPropCreationTimeUnix is the path to filter on the creation
+	// timestamp of objects
+	InternalPropCreationTimeUnix = "_creationTimeUnix"
+	// InternalPropLastUpdateTimeUnix is the path to filter on the last update
+	// timestamp of objects
+	InternalPropLastUpdateTimeUnix = "_lastUpdateTimeUnix"
+)
+
 // Represents the path in a filter.
 // Either RelationProperty or PrimitiveProperty must be empty (e.g. "").
 type Path struct {
@@ -95,6 +107,19 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 	// Simple case:      ClassName -> property
 	// Nested path case: ClassName -> HasRef -> ClassOfRef -> Property
 	for i := 0; i < len(pathElements); i += 2 {
+		if segment, ok := pathElements[i].(string); ok && i > 0 &&
+			isNestedPropertySegment(segment) {
+			// Classes are always capitalized, so this segment points to a
+			// property of a nested object, e.g. ["address", "city"]. It is
+			// joined with its parent property to "address.city"
+			current.Property = schema.PropertyName(schema.NestedPropertyPath(
+				string(current.Property), segment))
+
+			// the next element is in the position of a class again
+			i--
+			continue
+		}
+
 		lengthRemaining := len(pathElements) - i
 		if lengthRemaining < 2 {
 			return nil, fmt.Errorf("missing an argument after '%s'", pathElements[i])
@@ -116,6 +141,11 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 		}
 
 		propertyName, err := schema.ValidatePropertyName(rawPropertyName)
+		if _, ok := PropertyLength(rawPropertyName); ok || IsTimestampProperty(rawPropertyName) {
+			// the length of the property or a timestamp of the object is filtered
+			// on, the segment is kept as is and resolved by the searcher
+			propertyName, err = schema.PropertyName(rawPropertyName), nil
+		}
 		// Invalid property name?
 		// Try to parse it as as a reference.
 		if err != nil {
@@ -137,3 +167,30 @@ func ParsePath(pathElements []interface{}, rootClass string) (*Path, error) {
 
 	return sentinel.Child, nil
 }
+
+// PropertyLength returns the name of the property if the path segment refers
+// to its length, such as "len(title)"
+func PropertyLength(segment string) (string, bool) {
+	if !strings.HasPrefix(segment, "len(") || !strings.HasSuffix(segment, ")") {
+		return "", false
+	}
+
+	propName := segment[len("len(") : len(segment)-1]
+	if _, err := schema.ValidatePropertyName(propName); err != nil {
+		return "", false
+	}
+
+	return propName, true
+}
+
+// IsTimestampProperty checks whether the path segment refers to the creation
+// or last update timestamp of the object, rather than to one of its props
+func IsTimestampProperty(segment string) bool {
+	return segment == InternalPropCreationTimeUnix ||
+		segment == InternalPropLastUpdateTimeUnix
+}
+
+func isNestedPropertySegment(segment string) bool {
+	_, err := schema.ValidatePropertyName(segment)
+	return err == nil
+}
@@ -64,6 +64,98 @@ func Test_ParsePath(t *testing.T) {
 
 		// Print Slice
 	})
+
+	t.Run("with nested object props", func(t *testing.T) {
+		rootClass := "Person"
+		segments := []interface{}{"address", "location", "floor"}
+		expectedPath := &Path{
+			Class:    "Person",
+			Property: "address.location.floor",
+		}
+
+		path, err := ParsePath(segments, rootClass)
+
+		require.Nil(t, err, "should not error")
+		assert.Equal(t, expectedPath, path, "should parse the path correctly")
+	})
+
+	t.Run("with a nested object prop on a ref", func(t *testing.T) {
+		rootClass := "Person"
+		segments := []interface{}{"livesIn", "City", "address", "street"}
+		expectedPath := &Path{
+			Class:    "Person",
+			Property: "livesIn",
+			Child: &Path{
+				Class:    "City",
+				Property: "address.street",
+			},
+		}
+
+		path, err := ParsePath(segments, rootClass)
+
+		require.Nil(t, err, "should not error")
+		assert.Equal(t, expectedPath, path, "should parse the path correctly")
+	})
+
+	t.Run("with the length of a prop", func(t *testing.T) {
+		rootClass := "Person"
+		segments := []interface{}{"livesIn", "City", "len(name)"}
+		expectedPath := &Path{
+			Class:    "Person",
+			Property: "livesIn",
+			Child: &Path{
+				Class:    "City",
+				Property: "len(name)",
+			},
+		}
+
+		path, err := ParsePath(segments, rootClass)
+
+		require.Nil(t, err, "should not error")
+		assert.Equal(t, expectedPath, path, "should parse the path correctly")
+	})
+
+	t.Run("with the length of an invalid prop", func(t *testing.T) {
+		_, err := ParsePath([]interface{}{"len(na-me)"}, "Person")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with a timestamp of the object", func(t *testing.T) {
+		path, err := ParsePath([]interface{}{"_lastUpdateTimeUnix"}, "Person")
+		require.Nil(t, err)
+		assert.Equal(t, &Path{
+			Class:    "Person",
+			Property: "_lastUpdateTimeUnix",
+		}, path)
+	})
+
+	t.Run("with an unknown internal prop", func(t *testing.T) {
+		_, err := ParsePath([]interface{}{"_deletionTimeUnix"}, "Person")
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_PropertyLength(t *testing.T) {
+	tests := []struct {
+		segment      string
+		expectedProp string
+		expectedOk   bool
+	}{
+		{segment: "len(title)", expectedProp: "title", expectedOk: true},
+		{segment: "len(firstName)", expectedProp: "firstName", expectedOk: true},
+		{segment: "title", expectedOk: false},
+		{segment: "len()", expectedOk: false},
+		{segment: "len(title", expectedOk: false},
+		{segment: "length(title)", expectedOk: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.segment, func(t *testing.T) {
+			prop, ok := PropertyLength(test.segment)
+			assert.Equal(t, test.expectedOk, ok)
+			assert.Equal(t, test.expectedProp, prop)
+		})
+	}
 }
 
 func Test_SlicePath(t *testing.T) {
@@ -41,6 +41,12 @@ type Class struct {
 	// Configuration specific to modules this Weaviate instance has installed
 	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
 
+	// multi tenancy config
+	MultiTenancyConfig *MultiTenancyConfig `json:"multiTenancyConfig,omitempty"`
+
+	// Names of additional vectors objects of this class can carry next to their main vector. Each named vector is indexed in its own vector index using the vectorIndexConfig of the class.
+	NamedVectors []string `json:"namedVectors,omitempty"`
+
 	// The properties of the class.
 	Properties []*Property `json:"properties"`
 
@@ -62,6 +68,10 @@ func (m *Class) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMultiTenancyConfig(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProperties(formats); err != nil {
 		res = append(res, err)
 	}
@@ -90,6 +100,24 @@ func (m *Class) validateInvertedIndexConfig(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Class) validateMultiTenancyConfig(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.MultiTenancyConfig) { // not required
+		return nil
+	}
+
+	if m.MultiTenancyConfig != nil {
+		if err := m.MultiTenancyConfig.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("multiTenancyConfig")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Class) validateProperties(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Properties) { // not required
@@ -17,8 +17,10 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // InvertedIndexConfig Configure the inverted index built into Weaviate
@@ -26,12 +28,80 @@ import (
 // swagger:model InvertedIndexConfig
 type InvertedIndexConfig struct {
 
+	// The analyzer applied to all string and text properties of the class which are not configured in propertyAnalyzers.
+	Analyzer *AnalyzerConfig `json:"analyzer,omitempty"`
+
 	// Asynchronous index clean up happens every n seconds
 	CleanupIntervalSeconds int64 `json:"cleanupIntervalSeconds,omitempty"`
+
+	// Index which properties of an object are null, not set or empty lists, so that objects can be filtered with the IsNull operator. Defaults to false. Cannot be changed after the class has been created.
+	IndexNullState bool `json:"indexNullState,omitempty"`
+
+	// Index the length of string and text properties (in characters) and of array properties (in elements), so that objects can be filtered by it, using a path such as ["len(title)"] with an integer value. Null and unset properties have a length of 0. Defaults to false. Cannot be changed after the class has been created.
+	IndexPropertyLength bool `json:"indexPropertyLength,omitempty"`
+
+	// Index the creation and last update timestamps of objects, so that objects can be filtered by them, using the paths ["_creationTimeUnix"] and ["_lastUpdateTimeUnix"]. The timestamps are milliseconds since the epoch and can be compared to an int, a string holding an int, or a date value. The timestamps can not be used for sorting, as Get queries do not support sorting yet. Defaults to false. Cannot be changed after the class has been created.
+	IndexTimestamps bool `json:"indexTimestamps,omitempty"`
+
+	// Analyzers for individual string and text properties of the class, keyed by the name of the property.
+	PropertyAnalyzers map[string]AnalyzerConfig `json:"propertyAnalyzers,omitempty"`
 }
 
 // Validate validates this inverted index config
 func (m *InvertedIndexConfig) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAnalyzer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePropertyAnalyzers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *InvertedIndexConfig) validateAnalyzer(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Analyzer) { // not required
+		return nil
+	}
+
+	if m.Analyzer != nil {
+		if err := m.Analyzer.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("analyzer")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *InvertedIndexConfig) validatePropertyAnalyzers(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.PropertyAnalyzers) { // not required
+		return nil
+	}
+
+	for k := range m.PropertyAnalyzers {
+
+		if err := validate.Required("propertyAnalyzers"+"."+k, "body", m.PropertyAnalyzers[k]); err != nil {
+			return err
+		}
+		if val, ok := m.PropertyAnalyzers[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
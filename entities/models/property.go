@@ -17,8 +17,13 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Property property
@@ -35,15 +40,115 @@ type Property struct {
 	// Optional. Should this property be indexed in the inverted index. Defaults to true. If you choose false, you will not be able to use this property in where filters. This property has no affect on vectorization decisions done by modules
 	IndexInverted *bool `json:"indexInverted,omitempty"`
 
+	// Optional. Additionally index the trigrams of the terms of a string or text property. This allows answering Like filters which start with a wildcard, such as "*foo*", without scanning all terms of the property. Requires the property to be indexed in the inverted index. Defaults to false. Cannot be changed after the property has been created.
+	IndexTrigrams bool `json:"indexTrigrams,omitempty"`
+
 	// Configuratino specific to modules this Weaviate instance has installed
 	ModuleConfig interface{} `json:"moduleConfig,omitempty"`
 
 	// Name of the property as URI relative to the schema URL.
 	Name string `json:"name,omitempty"`
+
+	// The properties of the nested object(s). Only allowed and required if the dataType is "object" or "object[]".
+	NestedProperties []*NestedProperty `json:"nestedProperties,omitempty"`
+
+	// Determines how the values of a string or text property are split into the terms of the inverted index, both when indexing and when filtering. "word" splits on any non-alphanumerical character and lowercases, "whitespace" splits on whitespace only and keeps the casing, "field" indexes the whole trimmed value as one term, "lowercase" splits on whitespace and lowercases, "trigram" indexes all lowercased sequences of three letters or digits. Defaults to "word" for text and "whitespace" for string properties. Cannot be changed after the property has been created.
+	// Enum: [word whitespace field lowercase trigram]
+	Tokenization string `json:"tokenization,omitempty"`
 }
 
 // Validate validates this property
 func (m *Property) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNestedProperties(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTokenization(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Property) validateNestedProperties(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NestedProperties) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.NestedProperties); i++ {
+		if swag.IsZero(m.NestedProperties[i]) { // not required
+			continue
+		}
+
+		if m.NestedProperties[i] != nil {
+			if err := m.NestedProperties[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nestedProperties" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+var propertyTypeTokenizationPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["word","whitespace","field","lowercase","trigram"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		propertyTypeTokenizationPropEnum = append(propertyTypeTokenizationPropEnum, v)
+	}
+}
+
+const (
+
+	// PropertyTokenizationWord captures enum value "word"
+	PropertyTokenizationWord string = "word"
+
+	// PropertyTokenizationWhitespace captures enum value "whitespace"
+	PropertyTokenizationWhitespace string = "whitespace"
+
+	// PropertyTokenizationField captures enum value "field"
+	PropertyTokenizationField string = "field"
+
+	// PropertyTokenizationLowercase captures enum value "lowercase"
+	PropertyTokenizationLowercase string = "lowercase"
+
+	// PropertyTokenizationTrigram captures enum value "trigram"
+	PropertyTokenizationTrigram string = "trigram"
+)
+
+// prop value enum
+func (m *Property) validateTokenizationEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, propertyTypeTokenizationPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Property) validateTokenization(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Tokenization) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateTokenizationEnum("tokenization", "body", m.Tokenization); err != nil {
+		return err
+	}
+
 	return nil
 }
 
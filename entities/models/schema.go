@@ -30,6 +30,9 @@ import (
 // swagger:model Schema
 type Schema struct {
 
+	// Alternative names of classes, which are resolved to the classes they point to.
+	Aliases []*Alias `json:"aliases,omitempty"`
+
 	// Semantic classes that are available.
 	Classes []*Class `json:"classes"`
 
@@ -39,12 +42,19 @@ type Schema struct {
 
 	// Name of the schema.
 	Name string `json:"name,omitempty"`
+
+	// Version of the schema, incremented on every change. Ignored when submitting a schema.
+	Version int64 `json:"version,omitempty"`
 }
 
 // Validate validates this schema
 func (m *Schema) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAliases(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateClasses(formats); err != nil {
 		res = append(res, err)
 	}
@@ -59,6 +69,31 @@ func (m *Schema) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Schema) validateAliases(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Aliases) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Aliases); i++ {
+		if swag.IsZero(m.Aliases[i]) { // not required
+			continue
+		}
+
+		if m.Aliases[i] != nil {
+			if err := m.Aliases[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("aliases" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Schema) validateClasses(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Classes) { // not required
@@ -35,7 +35,7 @@ type WhereFilter struct {
 	Operands []*WhereFilter `json:"operands"`
 
 	// operator to use
-	// Enum: [And Or Equal Like Not NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange]
+	// Enum: [And Or Equal Like Not NotEqual GreaterThan GreaterThanEqual LessThan LessThanEqual WithinGeoRange ContainsAny ContainsAll IsNull]
 	Operator string `json:"operator,omitempty"`
 
 	// path to the property currently being filtered
@@ -44,23 +44,41 @@ type WhereFilter struct {
 	// value as boolean
 	ValueBoolean *bool `json:"valueBoolean,omitempty"`
 
+	// list of values as booleans, used with the ContainsAny and ContainsAll operators
+	ValueBooleanArray []bool `json:"valueBooleanArray,omitempty"`
+
 	// value as date (as string)
 	ValueDate *string `json:"valueDate,omitempty"`
 
+	// list of values as dates (as strings), used with the ContainsAny and ContainsAll operators
+	ValueDateArray []string `json:"valueDateArray,omitempty"`
+
 	// value as geo coordinates and distance
 	ValueGeoRange *WhereFilterGeoRange `json:"valueGeoRange,omitempty"`
 
 	// value as integer
 	ValueInt *int64 `json:"valueInt,omitempty"`
 
+	// list of values as integers, used with the ContainsAny and ContainsAll operators
+	ValueIntArray []int64 `json:"valueIntArray,omitempty"`
+
 	// value as number/float
 	ValueNumber *float64 `json:"valueNumber,omitempty"`
 
+	// list of values as numbers/floats, used with the ContainsAny and ContainsAll operators
+	ValueNumberArray []float64 `json:"valueNumberArray,omitempty"`
+
 	// value as string
 	ValueString *string `json:"valueString,omitempty"`
 
+	// list of values as strings, used with the ContainsAny and ContainsAll operators
+	ValueStringArray []string `json:"valueStringArray,omitempty"`
+
 	// value as text (on text props)
 	ValueText *string `json:"valueText,omitempty"`
+
+	// list of values as text (on text[] props), used with the ContainsAny and ContainsAll operators
+	ValueTextArray []string `json:"valueTextArray,omitempty"`
 }
 
 // Validate validates this where filter
@@ -114,7 +132,7 @@ var whereFilterTypeOperatorPropEnum []interface{}
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","Not","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["And","Or","Equal","Like","Not","NotEqual","GreaterThan","GreaterThanEqual","LessThan","LessThanEqual","WithinGeoRange","ContainsAny","ContainsAll","IsNull"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -156,6 +174,15 @@ const (
 
 	// WhereFilterOperatorWithinGeoRange captures enum value "WithinGeoRange"
 	WhereFilterOperatorWithinGeoRange string = "WithinGeoRange"
+
+	// WhereFilterOperatorContainsAny captures enum value "ContainsAny"
+	WhereFilterOperatorContainsAny string = "ContainsAny"
+
+	// WhereFilterOperatorContainsAll captures enum value "ContainsAll"
+	WhereFilterOperatorContainsAll string = "ContainsAll"
+
+	// WhereFilterOperatorIsNull captures enum value "IsNull"
+	WhereFilterOperatorIsNull string = "IsNull"
 )
 
 // prop value enum
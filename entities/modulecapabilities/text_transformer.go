@@ -0,0 +1,27 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modulecapabilities
+
+import "context"
+
+// TextTransform performs a transformation on the given texts, such as
+// correcting the spelling of search concepts
+type TextTransform interface {
+	Transform(ctx context.Context, in []string) ([]string, error)
+}
+
+// TextTransformers defines all text transformers a module provides. The
+// key is the name of the argument the transformation applies to, e.g.
+// "nearText"
+type TextTransformers interface {
+	TextTransformers() map[string]TextTransform
+}
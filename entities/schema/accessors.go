@@ -34,6 +34,32 @@ func (s *Schema) FindClassByName(className ClassName) *models.Class {
 	return nil
 }
 
+// GetAlias returns the alias with the given name or nil if there is none
+func (s *Schema) GetAlias(name string) *models.Alias {
+	if s.Objects == nil {
+		return nil
+	}
+
+	for _, alias := range s.Objects.Aliases {
+		if alias.Alias == name {
+			return alias
+		}
+	}
+
+	return nil
+}
+
+// ResolveAlias returns the name of the class the given alias points to. Any
+// name which is not an alias, such as a regular class name, is returned
+// unchanged.
+func (s *Schema) ResolveAlias(name string) string {
+	if alias := s.GetAlias(name); alias != nil {
+		return alias.Class
+	}
+
+	return name
+}
+
 // func (s *Schema) GetKindOfClass(className ClassName) (kind.Kind, bool) {
 // 	_, err := GetClassByName(s.Objects, string(className))
 // 	if err == nil {
@@ -76,3 +102,10 @@ func extractAllOfPropType(classes []*models.Class, propType string) []ClassAndPr
 
 	return result
 }
+
+// MultiTenancyEnabled indicates whether each tenant of the class is stored in
+// its own isolated shard
+func MultiTenancyEnabled(class *models.Class) bool {
+	return class != nil && class.MultiTenancyConfig != nil &&
+		class.MultiTenancyConfig.Enabled
+}
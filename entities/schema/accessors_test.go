@@ -64,6 +64,16 @@ func Test_Accessors(t *testing.T) {
 		assert.Equal(t, (*models.Class)(nil), class)
 	})
 
+	t.Run("ResolveAlias", func(t *testing.T) {
+		schema.Objects.Aliases = []*models.Alias{{Alias: "Vehicle", Class: "Car"}}
+		defer func() { schema.Objects.Aliases = nil }()
+
+		assert.Equal(t, "Car", schema.ResolveAlias("Vehicle"))
+		assert.Equal(t, "Train", schema.ResolveAlias("Train"))
+		assert.Equal(t, "Invalid", schema.ResolveAlias("Invalid"))
+		assert.Equal(t, (*models.Alias)(nil), schema.GetAlias("Car"))
+	})
+
 	t.Run("GetPropsOfType", func(t *testing.T) {
 		props := schema.GetPropsOfType("string")
 
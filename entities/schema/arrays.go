@@ -0,0 +1,39 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+// arrayElementDataTypes maps each array data type to the data type of its
+// elements
+var arrayElementDataTypes = map[DataType]DataType{
+	DataTypeStringArray:  DataTypeString,
+	DataTypeTextArray:    DataTypeText,
+	DataTypeIntArray:     DataTypeInt,
+	DataTypeNumberArray:  DataTypeNumber,
+	DataTypeBooleanArray: DataTypeBoolean,
+	DataTypeDateArray:    DataTypeDate,
+}
+
+// IsArrayDataType checks whether the data type is a list of primitive
+// values, such as string[] or int[]. Lists of nested objects (object[]) are
+// not considered array data types, as their elements are not primitive
+func IsArrayDataType(dt DataType) bool {
+	_, ok := arrayElementDataTypes[dt]
+	return ok
+}
+
+// ArrayElementDataType returns the data type of the elements of an array
+// data type, e.g. int for int[]. The second return value is false if the
+// data type is not an array data type
+func ArrayElementDataType(dt DataType) (DataType, bool) {
+	elem, ok := arrayElementDataTypes[dt]
+	return elem, ok
+}
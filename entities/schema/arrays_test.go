@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArrayElementDataType(t *testing.T) {
+	tests := []struct {
+		dataType     DataType
+		expectedElem DataType
+		expectedOK   bool
+	}{
+		{DataTypeStringArray, DataTypeString, true},
+		{DataTypeTextArray, DataTypeText, true},
+		{DataTypeIntArray, DataTypeInt, true},
+		{DataTypeNumberArray, DataTypeNumber, true},
+		{DataTypeBooleanArray, DataTypeBoolean, true},
+		{DataTypeDateArray, DataTypeDate, true},
+		{DataTypeString, "", false},
+		{DataTypeObjectArray, "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.dataType), func(t *testing.T) {
+			elem, ok := ArrayElementDataType(test.dataType)
+			assert.Equal(t, test.expectedOK, ok)
+			assert.Equal(t, test.expectedElem, elem)
+			assert.Equal(t, test.expectedOK, IsArrayDataType(test.dataType))
+		})
+	}
+}
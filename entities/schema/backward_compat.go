@@ -124,6 +124,12 @@ func GetValueDataTypeFromString(dt string) (*DataType, error) {
 			returnDataType = DataTypePhoneNumber
 		} else if dt == string(DataTypeBlob) {
 			returnDataType = DataTypeBlob
+		} else if dt == string(DataTypeObject) {
+			returnDataType = DataTypeObject
+		} else if dt == string(DataTypeObjectArray) {
+			returnDataType = DataTypeObjectArray
+		} else if IsArrayDataType(DataType(dt)) {
+			returnDataType = DataType(dt)
 		}
 	} else {
 		return nil, errors_.New(ErrorNoSuchDatatype)
@@ -144,7 +150,15 @@ func IsValidValueDataType(dt string) bool {
 		string(DataTypeDate),
 		string(DataTypeGeoCoordinates),
 		string(DataTypePhoneNumber),
-		string(DataTypeBlob):
+		string(DataTypeBlob),
+		string(DataTypeObject),
+		string(DataTypeObjectArray),
+		string(DataTypeStringArray),
+		string(DataTypeTextArray),
+		string(DataTypeIntArray),
+		string(DataTypeNumberArray),
+		string(DataTypeBooleanArray),
+		string(DataTypeDateArray):
 		return true
 	}
 	return false
@@ -41,9 +41,29 @@ const (
 	DataTypePhoneNumber DataType = "phoneNumber"
 	// DataTypeBlob represents a base64 encoded data
 	DataTypeBlob DataType = "blob"
+	// DataTypeObject represents a nested object, its properties are described
+	// by the nestedProperties of the property
+	DataTypeObject DataType = "object"
+	// DataTypeObjectArray represents a list of nested objects, their
+	// properties are described by the nestedProperties of the property
+	DataTypeObjectArray DataType = "object[]"
+	// DataTypeStringArray The data type is a list of values of type string
+	DataTypeStringArray DataType = "string[]"
+	// DataTypeTextArray The data type is a list of values of type text
+	DataTypeTextArray DataType = "text[]"
+	// DataTypeIntArray The data type is a list of values of type int
+	DataTypeIntArray DataType = "int[]"
+	// DataTypeNumberArray The data type is a list of values of type
+	// number/float
+	DataTypeNumberArray DataType = "number[]"
+	// DataTypeBooleanArray The data type is a list of values of type boolean
+	DataTypeBooleanArray DataType = "boolean[]"
+	// DataTypeDateArray The data type is a list of values of type date
+	DataTypeDateArray DataType = "date[]"
 )
 
-var PrimitiveDataTypes []DataType = []DataType{DataTypeString, DataTypeText, DataTypeInt, DataTypeNumber, DataTypeBoolean, DataTypeDate, DataTypeGeoCoordinates, DataTypePhoneNumber, DataTypeBlob}
+var PrimitiveDataTypes []DataType = []DataType{DataTypeString, DataTypeText, DataTypeInt, DataTypeNumber, DataTypeBoolean, DataTypeDate, DataTypeGeoCoordinates, DataTypePhoneNumber, DataTypeBlob, DataTypeObject, DataTypeObjectArray,
+	DataTypeStringArray, DataTypeTextArray, DataTypeIntArray, DataTypeNumberArray, DataTypeBooleanArray, DataTypeDateArray}
 
 type PropertyKind int
 
@@ -127,7 +147,11 @@ func (s *Schema) FindPropertyDataType(dataType []string) (PropertyDataType, erro
 			case string(DataTypeString), string(DataTypeText),
 				string(DataTypeInt), string(DataTypeNumber),
 				string(DataTypeBoolean), string(DataTypeDate), string(DataTypeGeoCoordinates),
-				string(DataTypePhoneNumber), string(DataTypeBlob):
+				string(DataTypePhoneNumber), string(DataTypeBlob),
+				string(DataTypeObject), string(DataTypeObjectArray),
+				string(DataTypeStringArray), string(DataTypeTextArray),
+				string(DataTypeIntArray), string(DataTypeNumberArray),
+				string(DataTypeBooleanArray), string(DataTypeDateArray):
 				return &propertyDataType{
 					kind:          PropertyKindPrimitive,
 					primitiveType: DataType(someDataType),
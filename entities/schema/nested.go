@@ -0,0 +1,84 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"strings"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// NestedPropertyDataTypes are the data types the properties of nested objects
+// can have. Cross-references, geoCoordinates, phoneNumbers and blobs are not
+// supported within nested objects
+var NestedPropertyDataTypes = []DataType{DataTypeString, DataTypeText, DataTypeInt, DataTypeNumber, DataTypeBoolean, DataTypeDate, DataTypeObject, DataTypeObjectArray}
+
+// IsNestedDataType checks whether the property holds nested objects, i.e. is
+// of type object or object[]
+func IsNestedDataType(dt []string) bool {
+	if len(dt) != 1 {
+		return false
+	}
+
+	return dt[0] == string(DataTypeObject) || dt[0] == string(DataTypeObjectArray)
+}
+
+// IsValidNestedDataType checks whether the given string is a data type which
+// can be used for the properties of nested objects
+func IsValidNestedDataType(dt string) bool {
+	for _, valid := range NestedPropertyDataTypes {
+		if dt == string(valid) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// NestedPropertyPath joins the path segments of a nested property, e.g.
+// "address" and "city" become "address.city"
+func NestedPropertyPath(segments ...string) string {
+	return strings.Join(segments, ".")
+}
+
+// FlattenNestedProperties turns the nested properties of an object property
+// into one property per nested primitive value. They are named by their
+// path, e.g. "address.city", so they can be indexed and filtered on like
+// regular properties. Properties which are not of a nested type have no
+// nested properties, so the result is empty
+func FlattenNestedProperties(prop *models.Property) []*models.Property {
+	if !IsNestedDataType(prop.DataType) {
+		return nil
+	}
+
+	var out []*models.Property
+	flattenNestedProperties(prop, prop.Name, prop.NestedProperties, &out)
+	return out
+}
+
+func flattenNestedProperties(root *models.Property, path string,
+	nested []*models.NestedProperty, out *[]*models.Property) {
+	for _, prop := range nested {
+		propPath := NestedPropertyPath(path, prop.Name)
+		if IsNestedDataType(prop.DataType) {
+			flattenNestedProperties(root, propPath, prop.NestedProperties, out)
+			continue
+		}
+
+		*out = append(*out, &models.Property{
+			Name:          propPath,
+			DataType:      prop.DataType,
+			Description:   prop.Description,
+			IndexInverted: root.IndexInverted,
+		})
+	}
+}
@@ -0,0 +1,70 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlattenNestedProperties(t *testing.T) {
+	t.Run("with a primitive property", func(t *testing.T) {
+		prop := &models.Property{
+			Name:     "name",
+			DataType: []string{"string"},
+		}
+
+		assert.Len(t, FlattenNestedProperties(prop), 0)
+	})
+
+	t.Run("with deeply nested objects", func(t *testing.T) {
+		indexInverted := false
+		prop := &models.Property{
+			Name:          "addresses",
+			DataType:      []string{"object[]"},
+			IndexInverted: &indexInverted,
+			NestedProperties: []*models.NestedProperty{
+				{Name: "city", DataType: []string{"string"}},
+				{
+					Name:     "location",
+					DataType: []string{"object"},
+					NestedProperties: []*models.NestedProperty{
+						{Name: "floor", DataType: []string{"int"}},
+						{Name: "since", DataType: []string{"date"}},
+					},
+				},
+			},
+		}
+
+		expected := []*models.Property{
+			{
+				Name:          "addresses.city",
+				DataType:      []string{"string"},
+				IndexInverted: &indexInverted,
+			},
+			{
+				Name:          "addresses.location.floor",
+				DataType:      []string{"int"},
+				IndexInverted: &indexInverted,
+			},
+			{
+				Name:          "addresses.location.since",
+				DataType:      []string{"date"},
+				IndexInverted: &indexInverted,
+			},
+		}
+
+		assert.Equal(t, expected, FlattenNestedProperties(prop))
+	})
+}
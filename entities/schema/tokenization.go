@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// Tokenizations are the ways the values of string and text properties can be
+// split into the terms of the inverted index
+var Tokenizations = []string{
+	models.PropertyTokenizationWord,
+	models.PropertyTokenizationWhitespace,
+	models.PropertyTokenizationField,
+	models.PropertyTokenizationLowercase,
+	models.PropertyTokenizationTrigram,
+}
+
+// DefaultTokenization is the tokenization of string and text properties
+// which don't specify one, it matches how they were tokenized before the
+// tokenization was configurable. Other data types are not tokenized, so
+// their default is empty.
+func DefaultTokenization(dt DataType) string {
+	if elemType, ok := ArrayElementDataType(dt); ok {
+		dt = elemType
+	}
+
+	switch dt {
+	case DataTypeText:
+		return models.PropertyTokenizationWord
+	case DataTypeString:
+		return models.PropertyTokenizationWhitespace
+	default:
+		return ""
+	}
+}
+
+// PropertyTokenization returns the tokenization of the property, which is
+// the default of its data type if none is set
+func PropertyTokenization(prop *models.Property) string {
+	if prop.Tokenization != "" {
+		return prop.Tokenization
+	}
+
+	if len(prop.DataType) != 1 {
+		return ""
+	}
+
+	return DefaultTokenization(DataType(prop.DataType[0]))
+}
+
+// ValidateTokenization checks that a tokenization is only set on string and
+// text properties and that it is one of the Tokenizations
+func ValidateTokenization(prop *models.Property) error {
+	if prop.Tokenization == "" {
+		return nil
+	}
+
+	if len(prop.DataType) != 1 || DefaultTokenization(DataType(prop.DataType[0])) == "" {
+		return fmt.Errorf("tokenization is only supported for properties of type "+
+			"string, text, string[] and text[], got %v", prop.DataType)
+	}
+
+	for _, tokenization := range Tokenizations {
+		if prop.Tokenization == tokenization {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported tokenization %q, must be one of %v",
+		prop.Tokenization, Tokenizations)
+}
+
+// ValidateIndexTrigrams checks that a trigram index is only requested for
+// string and text properties which are indexed in the inverted index
+func ValidateIndexTrigrams(prop *models.Property) error {
+	if !prop.IndexTrigrams {
+		return nil
+	}
+
+	if len(prop.DataType) != 1 || DefaultTokenization(DataType(prop.DataType[0])) == "" {
+		return fmt.Errorf("indexTrigrams is only supported for properties of type "+
+			"string, text, string[] and text[], got %v", prop.DataType)
+	}
+
+	if prop.IndexInverted != nil && !*prop.IndexInverted {
+		return fmt.Errorf("indexTrigrams requires the property to be indexed " +
+			"in the inverted index, but indexInverted is false")
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPropertyTokenization(t *testing.T) {
+	tests := []struct {
+		name     string
+		prop     *models.Property
+		expected string
+	}{
+		{
+			name:     "text without tokenization",
+			prop:     &models.Property{DataType: []string{"text"}},
+			expected: models.PropertyTokenizationWord,
+		},
+		{
+			name:     "string[] without tokenization",
+			prop:     &models.Property{DataType: []string{"string[]"}},
+			expected: models.PropertyTokenizationWhitespace,
+		},
+		{
+			name: "string with tokenization",
+			prop: &models.Property{
+				DataType:     []string{"string"},
+				Tokenization: models.PropertyTokenizationField,
+			},
+			expected: models.PropertyTokenizationField,
+		},
+		{
+			name:     "int",
+			prop:     &models.Property{DataType: []string{"int"}},
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expected, PropertyTokenization(test.prop))
+		})
+	}
+}
+
+func TestValidateTokenization(t *testing.T) {
+	t.Run("without tokenization", func(t *testing.T) {
+		err := ValidateTokenization(&models.Property{DataType: []string{"int"}})
+		assert.Nil(t, err)
+	})
+
+	t.Run("on a text[] prop", func(t *testing.T) {
+		err := ValidateTokenization(&models.Property{
+			DataType:     []string{"text[]"},
+			Tokenization: models.PropertyTokenizationLowercase,
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("on an int prop", func(t *testing.T) {
+		err := ValidateTokenization(&models.Property{
+			DataType:     []string{"int"},
+			Tokenization: models.PropertyTokenizationField,
+		})
+		assert.EqualError(t, err, "tokenization is only supported for properties "+
+			"of type string, text, string[] and text[], got [int]")
+	})
+
+	t.Run("unsupported tokenization", func(t *testing.T) {
+		err := ValidateTokenization(&models.Property{
+			DataType:     []string{"string"},
+			Tokenization: "ngram",
+		})
+		assert.EqualError(t, err, `unsupported tokenization "ngram", must be one `+
+			`of [word whitespace field lowercase trigram]`)
+	})
+}
@@ -26,6 +26,7 @@ type Result struct {
 	ClassName            string
 	Score                float32
 	Vector               []float32
+	Vectors              models.C11yVectors
 	Beacon               string
 	Certainty            float32
 	Schema               models.PropertySchema
@@ -33,6 +34,7 @@ type Result struct {
 	Updated              int64
 	AdditionalProperties models.AdditionalProperties
 	VectorWeights        map[string]string
+	Tenant               string
 }
 
 type Results []Result
@@ -54,6 +56,7 @@ func (r Result) ObjectWithVector(includeVector bool) *models.Object {
 		CreationTimeUnix:   r.Created,
 		LastUpdateTimeUnix: r.Updated,
 		VectorWeights:      r.VectorWeights,
+		Tenant:             r.Tenant,
 	}
 
 	if r.AdditionalProperties != nil {
@@ -62,6 +65,7 @@ func (r Result) ObjectWithVector(includeVector bool) *models.Object {
 
 	if includeVector {
 		t.Vector = r.Vector
+		t.Vectors = r.Vectors
 	}
 
 	return t
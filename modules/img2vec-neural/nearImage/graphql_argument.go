@@ -22,6 +22,10 @@ func getNearImageArgumentFn(classname string) *graphql.ArgumentConfig {
 	return nearImageArgument("GetObjects", classname)
 }
 
+func aggregateNearImageArgumentFn(classname string) *graphql.ArgumentConfig {
+	return nearImageArgument("AggregateObjects", classname)
+}
+
 func exploreNearImageArgumentFn() *graphql.ArgumentConfig {
 	return nearImageArgument("Explore", "")
 }
@@ -25,13 +25,15 @@ type Params struct{}
 
 type qnaClient interface {
 	Answer(ctx context.Context,
-		text, question string) (*ent.AnswerResult, error)
+		text, question string, limit int) (*ent.AnswerResult, error)
 }
 
 type paramsHelper interface {
 	GetQuestion(params interface{}) string
 	GetProperties(params interface{}) []string
 	GetCertainty(params interface{}) float64
+	GetLimit(params interface{}) int
+	GetRerank(params interface{}) bool
 }
 
 type AnswerProvider struct {
@@ -28,6 +28,18 @@ func (p *AnswerProvider) additionalAnswerField(classname string) *graphql.Field
 				"property":      &graphql.Field{Type: graphql.String},
 				"certainty":     &graphql.Field{Type: graphql.Float},
 				"hasAnswer":     &graphql.Field{Type: graphql.Boolean},
+				"candidates": &graphql.Field{
+					Type: graphql.NewList(graphql.NewObject(graphql.ObjectConfig{
+						Name: fmt.Sprintf("%sAdditionalAnswerCandidate", classname),
+						Fields: graphql.Fields{
+							"result":        &graphql.Field{Type: graphql.String},
+							"startPosition": &graphql.Field{Type: graphql.Int},
+							"endPosition":   &graphql.Field{Type: graphql.Int},
+							"property":      &graphql.Field{Type: graphql.String},
+							"certainty":     &graphql.Field{Type: graphql.Float},
+						},
+					})),
+				},
 			},
 		}),
 	}
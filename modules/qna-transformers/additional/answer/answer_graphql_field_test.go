@@ -37,6 +37,13 @@ func TestAnswerField(t *testing.T) {
 		//     certainty: 0.2
 		//     property: "propName"
 		//     hasAnswer: true
+		//     candidates: [{
+		//       result: "answer",
+		//       startPosition: 1
+		//       endPosition: 2
+		//       certainty: 0.2
+		//       property: "propName"
+		//     }]
 		//   }
 		// }
 		assert.NotNil(t, answer)
@@ -44,12 +51,18 @@ func TestAnswerField(t *testing.T) {
 		assert.NotNil(t, answer.Type)
 		answerObject, answerObjectOK := answer.Type.(*graphql.Object)
 		assert.True(t, answerObjectOK)
-		assert.Equal(t, 6, len(answerObject.Fields()))
+		assert.Equal(t, 7, len(answerObject.Fields()))
 		assert.NotNil(t, answerObject.Fields()["result"])
 		assert.NotNil(t, answerObject.Fields()["startPosition"])
 		assert.NotNil(t, answerObject.Fields()["endPosition"])
 		assert.NotNil(t, answerObject.Fields()["property"])
 		assert.NotNil(t, answerObject.Fields()["certainty"])
 		assert.NotNil(t, answerObject.Fields()["hasAnswer"])
+		candidatesList, candidatesListOK := answerObject.Fields()["candidates"].Type.(*graphql.List)
+		assert.True(t, candidatesListOK)
+		candidateObject, candidateObjectOK := candidatesList.OfType.(*graphql.Object)
+		assert.True(t, candidateObjectOK)
+		assert.Equal(t, "ClassAdditionalAnswerCandidate", candidateObject.Name())
+		assert.Equal(t, 5, len(candidateObject.Fields()))
 	})
 }
@@ -14,72 +14,178 @@ package answer
 import (
 	"context"
 	"errors"
+	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/search"
 	qnamodels "github.com/semi-technologies/weaviate/modules/qna-transformers/additional/models"
+	"github.com/semi-technologies/weaviate/modules/qna-transformers/ent"
 )
 
 func (p *AnswerProvider) findAnswer(ctx context.Context,
 	in []search.Result, params *Params, limit *int,
 	argumentModuleParams map[string]interface{}) ([]search.Result, error) {
-	if len(in) > 0 {
-		properties := p.paramsHelper.GetProperties(argumentModuleParams["ask"])
-		textProperties := map[string]string{}
-		schema := in[0].Object().Properties.(map[string]interface{})
-		for property, value := range schema {
-			if p.containsProperty(property, properties) {
-				if valueString, ok := value.(string); ok && len(valueString) > 0 {
-					textProperties[property] = valueString
-				}
-			}
-		}
+	if len(in) == 0 {
+		return in, nil
+	}
 
-		texts := []string{}
-		for _, value := range textProperties {
-			texts = append(texts, value)
-		}
-		text := strings.Join(texts, " ")
-		if len(text) == 0 {
-			return in, errors.New("empty content")
+	askParams := argumentModuleParams["ask"]
+	properties := p.paramsHelper.GetProperties(askParams)
+	certainty := p.paramsHelper.GetCertainty(askParams)
+	answersLimit := p.paramsHelper.GetLimit(askParams)
+	question := p.paramsHelper.GetQuestion(askParams)
+
+	hasContent := false
+	for i := range in {
+		textProperties := p.textProperties(in[i], properties)
+		if len(textProperties) == 0 {
+			p.setAnswer(&in[i], &qnamodels.Answer{HasAnswer: false})
+			continue
 		}
-		question := p.paramsHelper.GetQuestion(argumentModuleParams["ask"])
+		hasContent = true
+
 		if question == "" {
 			return in, errors.New("empty question")
 		}
 
-		answer, err := p.qna.Answer(ctx, text, question)
+		propertyNames := p.sortedKeys(textProperties)
+		texts := make([]string, len(propertyNames))
+		for j, property := range propertyNames {
+			texts[j] = textProperties[property]
+		}
+
+		answer, err := p.qna.Answer(ctx, strings.Join(texts, " "), question, answersLimit)
 		if err != nil {
 			return in, err
 		}
 
-		ap := in[0].AdditionalProperties
-		if ap == nil {
-			ap = models.AdditionalProperties{}
+		candidates := p.candidates(answer, certainty, answersLimit, propertyNames, textProperties)
+		if len(candidates) == 0 {
+			p.setAnswer(&in[i], &qnamodels.Answer{HasAnswer: false})
+			continue
 		}
 
-		certainty := p.paramsHelper.GetCertainty(argumentModuleParams["ask"])
+		best := candidates[0]
+		p.setAnswer(&in[i], &qnamodels.Answer{
+			Result:        best.Result,
+			Property:      best.Property,
+			StartPosition: best.StartPosition,
+			EndPosition:   best.EndPosition,
+			Certainty:     best.Certainty,
+			HasAnswer:     true,
+			Candidates:    candidates,
+		})
+	}
+
+	if !hasContent {
+		return in, errors.New("empty content")
+	}
+
+	if p.paramsHelper.GetRerank(askParams) {
+		p.rerank(in)
+	}
+
+	return in, nil
+}
+
+// candidates turns the answers of the model into candidates ordered by
+// certainty, dropping all answers below the requested certainty
+func (p *AnswerProvider) candidates(answer *ent.AnswerResult, certainty float64,
+	limit int, propertyNames []string, textProperties map[string]string,
+) []*qnamodels.AnswerCandidate {
+	answers := answer.Answers
+	if len(answers) == 0 && answer.Answer != nil {
+		answers = []ent.Answer{{Answer: answer.Answer, Certainty: answer.Certainty}}
+	}
+
+	candidates := []*qnamodels.AnswerCandidate{}
+	for _, answer := range answers {
+		if answer.Answer == nil {
+			continue
+		}
 		if certainty > 0 && answer.Certainty != nil && *answer.Certainty < certainty {
-			ap["answer"] = &qnamodels.Answer{
-				HasAnswer: false,
-			}
-		} else {
-			propertyName, startPos, endPos := p.findProperty(answer.Answer, textProperties)
-			ap["answer"] = &qnamodels.Answer{
-				Result:        answer.Answer,
-				Property:      propertyName,
-				StartPosition: startPos,
-				EndPosition:   endPos,
-				Certainty:     answer.Certainty,
-				HasAnswer:     answer.Answer != nil,
-			}
+			continue
 		}
+		propertyName, startPos, endPos := p.findProperty(answer.Answer, propertyNames, textProperties)
+		candidates = append(candidates, &qnamodels.AnswerCandidate{
+			Result:        answer.Answer,
+			Property:      propertyName,
+			StartPosition: startPos,
+			EndPosition:   endPos,
+			Certainty:     answer.Certainty,
+		})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return certaintyOf(candidates[i].Certainty) > certaintyOf(candidates[j].Certainty)
+	})
+
+	if limit > 0 && len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+	return candidates
+}
+
+// rerank orders the results by the certainty of their best answer, so that
+// the best answer across all results is surfaced first. Results without an
+// answer keep their relative order at the end
+func (p *AnswerProvider) rerank(in []search.Result) {
+	sort.SliceStable(in, func(i, j int) bool {
+		return p.bestCertainty(in[i]) > p.bestCertainty(in[j])
+	})
+}
 
-		in[0].AdditionalProperties = ap
+func (p *AnswerProvider) bestCertainty(result search.Result) float64 {
+	answer, ok := result.AdditionalProperties["answer"].(*qnamodels.Answer)
+	if !ok || !answer.HasAnswer {
+		return -1
 	}
+	return certaintyOf(answer.Certainty)
+}
 
-	return in, nil
+func certaintyOf(certainty *float64) float64 {
+	if certainty == nil {
+		return 0
+	}
+	return *certainty
+}
+
+func (p *AnswerProvider) setAnswer(result *search.Result, answer *qnamodels.Answer) {
+	ap := result.AdditionalProperties
+	if ap == nil {
+		ap = models.AdditionalProperties{}
+	}
+	ap["answer"] = answer
+	result.AdditionalProperties = ap
+}
+
+func (p *AnswerProvider) textProperties(result search.Result,
+	properties []string) map[string]string {
+	textProperties := map[string]string{}
+	schema, ok := result.Object().Properties.(map[string]interface{})
+	if !ok {
+		return textProperties
+	}
+
+	for property, value := range schema {
+		if p.containsProperty(property, properties) {
+			if valueString, ok := value.(string); ok && len(valueString) > 0 {
+				textProperties[property] = valueString
+			}
+		}
+	}
+	return textProperties
+}
+
+func (p *AnswerProvider) sortedKeys(textProperties map[string]string) []string {
+	keys := make([]string, 0, len(textProperties))
+	for key := range textProperties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
 }
 
 func (p *AnswerProvider) containsProperty(property string, properties []string) bool {
@@ -94,20 +200,39 @@ func (p *AnswerProvider) containsProperty(property string, properties []string)
 	return false
 }
 
-func (p *AnswerProvider) findProperty(answer *string, textProperties map[string]string) (*string, int, int) {
+// findProperty returns the property containing the answer together with the
+// byte positions of the answer in the original property text
+func (p *AnswerProvider) findProperty(answer *string, propertyNames []string,
+	textProperties map[string]string) (*string, int, int) {
 	if answer == nil {
 		return nil, 0, 0
 	}
-	lowercaseAnswer := strings.ToLower(*answer)
-	if len(lowercaseAnswer) > 0 {
-		for property, value := range textProperties {
-			lowercaseValue := strings.ToLower(strings.ReplaceAll(value, "\n", " "))
-			if strings.Contains(lowercaseValue, lowercaseAnswer) {
-				startIndex := strings.Index(lowercaseValue, lowercaseAnswer)
-				return &property, startIndex, startIndex + len(lowercaseAnswer)
+	if len(*answer) > 0 {
+		for i := range propertyNames {
+			startPos, endPos, ok := findPosition(textProperties[propertyNames[i]], *answer)
+			if ok {
+				return &propertyNames[i], startPos, endPos
 			}
 		}
 	}
 	propertyNotFound := ""
 	return &propertyNotFound, 0, 0
 }
+
+// findPosition looks for answer in text ignoring case and treating line
+// breaks as spaces, the returned positions are byte offsets in text
+func findPosition(text, answer string) (int, int, bool) {
+	normalized := strings.ReplaceAll(text, "\n", " ")
+	answerLength := utf8.RuneCountInString(answer)
+	for startPos := range normalized {
+		endPos := startPos
+		for n := 0; n < answerLength && endPos < len(normalized); n++ {
+			_, size := utf8.DecodeRuneInString(normalized[endPos:])
+			endPos += size
+		}
+		if strings.EqualFold(normalized[startPos:endPos], answer) {
+			return startPos, endPos, true
+		}
+	}
+	return 0, 0, false
+}
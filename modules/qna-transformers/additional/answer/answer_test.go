@@ -13,8 +13,10 @@ package answer
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/search"
 	qnamodels "github.com/semi-technologies/weaviate/modules/qna-transformers/additional/models"
 	"github.com/semi-technologies/weaviate/modules/qna-transformers/ent"
@@ -238,20 +240,221 @@ func TestAdditionalAnswerProvider(t *testing.T) {
 		assert.Equal(t, 0, answerAdditional.EndPosition)
 		assert.Equal(t, false, answerAdditional.HasAnswer)
 	})
+
+	t.Run("should answer all results", func(t *testing.T) {
+		// given
+		qnaClient := &fakeQnAClient{}
+		fakeHelper := &fakeParamsHelper{}
+		answerProvider := New(qnaClient, fakeHelper)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "content with answer",
+				},
+			},
+			{
+				ID:     "some-uuid-without-content",
+				Schema: map[string]interface{}{},
+			},
+			{
+				ID: "other-uuid",
+				Schema: map[string]interface{}{
+					"content": "the answer is here",
+				},
+			},
+		}
+		fakeParams := &Params{}
+		limit := 3
+		argumentModuleParams := map[string]interface{}{
+			"ask": map[string]interface{}{
+				"question": "question",
+			},
+		}
+
+		// when
+		out, err := answerProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, argumentModuleParams)
+
+		// then
+		require.Nil(t, err)
+		require.Len(t, out, 3)
+		first := out[0].AdditionalProperties["answer"].(*qnamodels.Answer)
+		assert.True(t, first.HasAnswer)
+		assert.Equal(t, 13, first.StartPosition)
+		assert.Equal(t, 19, first.EndPosition)
+		second := out[1].AdditionalProperties["answer"].(*qnamodels.Answer)
+		assert.False(t, second.HasAnswer)
+		third := out[2].AdditionalProperties["answer"].(*qnamodels.Answer)
+		assert.True(t, third.HasAnswer)
+		assert.Equal(t, 4, third.StartPosition)
+		assert.Equal(t, 10, third.EndPosition)
+	})
+
+	t.Run("should return multiple candidates ordered by certainty", func(t *testing.T) {
+		// given
+		qnaClient := &fakeQnAClient{}
+		fakeHelper := &fakeParamsHelper{}
+		answerProvider := New(qnaClient, fakeHelper)
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content":  "content with answer",
+					"content2": "this one is just a title",
+				},
+			},
+		}
+		fakeParams := &Params{}
+		limit := 1
+		argumentModuleParams := map[string]interface{}{
+			"ask": map[string]interface{}{
+				"question":  "question",
+				"limit":     3,
+				"certainty": float64(0.5),
+			},
+		}
+
+		// when
+		out, err := answerProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, argumentModuleParams)
+
+		// then
+		require.Nil(t, err)
+		require.Len(t, out, 1)
+		answer := out[0].AdditionalProperties["answer"].(*qnamodels.Answer)
+		assert.True(t, answer.HasAnswer)
+		assert.Equal(t, "answer", *answer.Result)
+		assert.Equal(t, 0.8, *answer.Certainty)
+		require.Len(t, answer.Candidates, 2, "candidate below the certainty is dropped")
+		assert.Equal(t, "answer", *answer.Candidates[0].Result)
+		assert.Equal(t, "content", *answer.Candidates[0].Property)
+		assert.Equal(t, "JUST A TITLE", *answer.Candidates[1].Result)
+		assert.Equal(t, 0.6, *answer.Candidates[1].Certainty)
+		assert.Equal(t, "content2", *answer.Candidates[1].Property)
+		assert.Equal(t, 12, answer.Candidates[1].StartPosition)
+		assert.Equal(t, 24, answer.Candidates[1].EndPosition)
+	})
+
+	t.Run("should rerank results by their best answer", func(t *testing.T) {
+		// given
+		qnaClient := &fakeQnAClient{}
+		fakeHelper := &fakeParamsHelper{}
+		answerProvider := New(qnaClient, fakeHelper)
+		in := []search.Result{
+			{
+				ID:     "uuid-without-answer",
+				Schema: map[string]interface{}{},
+			},
+			{
+				ID: "uuid-with-good-answer",
+				Schema: map[string]interface{}{
+					"content": "content with answer",
+				},
+			},
+			{
+				ID: "uuid-with-best-answer",
+				Schema: map[string]interface{}{
+					"content": "content with the best answer",
+				},
+			},
+		}
+		fakeParams := &Params{}
+		limit := 3
+		argumentModuleParams := map[string]interface{}{
+			"ask": map[string]interface{}{
+				"question": "question",
+				"rerank":   true,
+			},
+		}
+
+		// when
+		out, err := answerProvider.AdditionalPropertyFn(context.Background(), in, fakeParams, &limit, argumentModuleParams)
+
+		// then
+		require.Nil(t, err)
+		require.Len(t, out, 3)
+		assert.Equal(t, strfmt.UUID("uuid-with-best-answer"), out[0].ID)
+		assert.Equal(t, strfmt.UUID("uuid-with-good-answer"), out[1].ID)
+		assert.Equal(t, strfmt.UUID("uuid-without-answer"), out[2].ID)
+	})
+}
+
+func TestFindPosition(t *testing.T) {
+	tests := []struct {
+		name          string
+		text          string
+		answer        string
+		expectedFound bool
+		expectedStart int
+		expectedEnd   int
+	}{
+		{
+			name:          "exact match",
+			text:          "content with answer",
+			answer:        "answer",
+			expectedFound: true,
+			expectedStart: 13,
+			expectedEnd:   19,
+		},
+		{
+			name:          "different case and line break",
+			text:          "first line\nSecond Line",
+			answer:        "line second",
+			expectedFound: true,
+			expectedStart: 6,
+			expectedEnd:   17,
+		},
+		{
+			name:          "multi-byte characters before the answer",
+			text:          "Größe: Zürich",
+			answer:        "zürich",
+			expectedFound: true,
+			expectedStart: 9,
+			expectedEnd:   16,
+		},
+		{
+			name:          "not found",
+			text:          "content with answer",
+			answer:        "question",
+			expectedFound: false,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			start, end, found := findPosition(test.text, test.answer)
+			assert.Equal(t, test.expectedFound, found)
+			assert.Equal(t, test.expectedStart, start)
+			assert.Equal(t, test.expectedEnd, end)
+		})
+	}
 }
 
 type fakeQnAClient struct{}
 
 func (c *fakeQnAClient) Answer(ctx context.Context,
-	text, question string) (*ent.AnswerResult, error) {
+	text, question string, limit int) (*ent.AnswerResult, error) {
 	answerString := "answer"
 	var certainty float64 = 0.8
+	if strings.Contains(text, "best") {
+		certainty = 0.9
+	}
 	answer := &ent.AnswerResult{
 		Text:      question,
 		Question:  question,
 		Answer:    &answerString,
 		Certainty: &certainty,
 	}
+	if limit > 1 {
+		titleString := "JUST A TITLE"
+		titleCertainty := 0.6
+		lowString := "this one"
+		lowCertainty := 0.3
+		// deliberately out of order
+		answer.Answers = []ent.Answer{
+			{Answer: &lowString, Certainty: &lowCertainty},
+			{Answer: &answerString, Certainty: &certainty},
+			{Answer: &titleString, Certainty: &titleCertainty},
+		}
+	}
 	return answer, nil
 }
 
@@ -283,3 +486,21 @@ func (h *fakeParamsHelper) GetCertainty(params interface{}) float64 {
 	}
 	return 0
 }
+
+func (h *fakeParamsHelper) GetLimit(params interface{}) int {
+	if fakeParamsMap, ok := params.(map[string]interface{}); ok {
+		if limit, ok := fakeParamsMap["limit"].(int); ok {
+			return limit
+		}
+	}
+	return 0
+}
+
+func (h *fakeParamsHelper) GetRerank(params interface{}) bool {
+	if fakeParamsMap, ok := params.(map[string]interface{}); ok {
+		if rerank, ok := fakeParamsMap["rerank"].(bool); ok {
+			return rerank
+		}
+	}
+	return false
+}
@@ -20,4 +20,17 @@ type Answer struct {
 	EndPosition   int      `json:"endPosition,omitempty"`
 	Certainty     *float64 `json:"certainty,omitempty"`
 	HasAnswer     bool     `json:"hasAnswer,omitempty"`
+	// Candidates contains all answers found for the object ordered by
+	// certainty, the best one is also set on the answer itself
+	Candidates []*AnswerCandidate `json:"candidates,omitempty"`
+}
+
+// AnswerCandidate used in qna module to represent
+// one of possibly many answers found in an object
+type AnswerCandidate struct {
+	Result        *string  `json:"result,omitempty"`
+	Property      *string  `json:"property,omitempty"`
+	StartPosition int      `json:"startPosition,omitempty"`
+	EndPosition   int      `json:"endPosition,omitempty"`
+	Certainty     *float64 `json:"certainty,omitempty"`
 }
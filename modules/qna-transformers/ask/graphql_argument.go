@@ -53,5 +53,13 @@ func askFields(prefix string) graphql.InputObjectConfigFieldMap {
 			Description: "Properties which contains text",
 			Type:        graphql.NewList(graphql.String),
 		},
+		"limit": &graphql.InputObjectFieldConfig{
+			Description: "Maximum number of candidate answers per object",
+			Type:        graphql.Int,
+		},
+		"rerank": &graphql.InputObjectFieldConfig{
+			Description: "Order the results by the certainty of their best answer",
+			Type:        graphql.Boolean,
+		},
 	}
 }
@@ -32,13 +32,15 @@ func TestAskGraphQLArgument(t *testing.T) {
 		//   question: "question?",
 		//   certainty: 0.9
 		//   properties: ["prop1", "prop2"]
+		//   limit: 3
+		//   rerank: true
 		// }
 		assert.NotNil(t, ask)
 		assert.Equal(t, "QnATransformersPrefixClassAskInpObj", ask.Type.Name())
 		askFields, ok := ask.Type.(*graphql.InputObject)
 		assert.True(t, ok)
 		assert.NotNil(t, askFields)
-		assert.Equal(t, 3, len(askFields.Fields()))
+		assert.Equal(t, 5, len(askFields.Fields()))
 		fields := askFields.Fields()
 		question := fields["question"]
 		questionNonNull, questionNonNullOK := question.Type.(*graphql.NonNull)
@@ -50,5 +52,7 @@ func TestAskGraphQLArgument(t *testing.T) {
 		propertiesList, propertiesListOK := properties.Type.(*graphql.List)
 		assert.True(t, propertiesListOK)
 		assert.Equal(t, "String", propertiesList.OfType.Name())
+		assert.Equal(t, "Int", fields["limit"].Type.Name())
+		assert.Equal(t, "Boolean", fields["rerank"].Type.Name())
 	})
 }
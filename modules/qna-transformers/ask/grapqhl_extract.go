@@ -32,5 +32,15 @@ func extractAskFn(source map[string]interface{}) interface{} {
 		}
 	}
 
+	limit, ok := source["limit"].(int)
+	if ok {
+		args.Limit = limit
+	}
+
+	rerank, ok := source["rerank"].(bool)
+	if ok {
+		args.Rerank = rerank
+	}
+
 	return &args
 }
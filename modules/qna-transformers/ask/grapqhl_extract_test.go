@@ -71,6 +71,21 @@ func Test_extractAskFn(t *testing.T) {
 				Properties: []string{"prop1", "prop2"},
 			},
 		},
+		{
+			name: "should parse properly with question and limit and rerank",
+			args: args{
+				source: map[string]interface{}{
+					"question": "some question",
+					"limit":    3,
+					"rerank":   true,
+				},
+			},
+			want: &AskParams{
+				Question: "some question",
+				Limit:    3,
+				Rerank:   true,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
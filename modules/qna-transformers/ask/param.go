@@ -19,6 +19,8 @@ type AskParams struct {
 	Question   string
 	Certainty  float64
 	Properties []string
+	Limit      int
+	Rerank     bool
 }
 
 func (n AskParams) GetCertainty() float64 {
@@ -35,5 +37,9 @@ func validateAskFn(param interface{}) error {
 		return errors.Errorf("'ask.question' needs to be defined")
 	}
 
+	if ask.Limit < 0 {
+		return errors.Errorf("'ask.limit' needs to be a positive number, got %d", ask.Limit)
+	}
+
 	return nil
 }
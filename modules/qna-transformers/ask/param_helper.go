@@ -37,3 +37,17 @@ func (p *ParamsHelper) GetCertainty(params interface{}) float64 {
 	}
 	return 0
 }
+
+func (p *ParamsHelper) GetLimit(params interface{}) int {
+	if parameters, ok := params.(*AskParams); ok {
+		return parameters.Limit
+	}
+	return 0
+}
+
+func (p *ParamsHelper) GetRerank(params interface{}) bool {
+	if parameters, ok := params.(*AskParams); ok {
+		return parameters.Rerank
+	}
+	return false
+}
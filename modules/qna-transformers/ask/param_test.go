@@ -30,6 +30,25 @@ func Test_validateAskFn(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should validate with limit",
+			args: args{
+				param: &AskParams{
+					Question: "question",
+					Limit:    3,
+				},
+			},
+		},
+		{
+			name: "should not validate when limit is negative",
+			args: args{
+				param: &AskParams{
+					Question: "question",
+					Limit:    -1,
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "should not validate when empty question",
 			args: args{
@@ -39,10 +39,11 @@ func New(origin string, logger logrus.FieldLogger) *qna {
 }
 
 func (v *qna) Answer(ctx context.Context,
-	text, question string) (*ent.AnswerResult, error) {
+	text, question string, limit int) (*ent.AnswerResult, error) {
 	body, err := json.Marshal(answersInput{
 		Text:     text,
 		Question: question,
+		Limit:    limit,
 	})
 	if err != nil {
 		return nil, errors.Wrapf(err, "marshal body")
@@ -79,9 +80,28 @@ func (v *qna) Answer(ctx context.Context,
 		Question:  resBody.Question,
 		Answer:    resBody.Answer,
 		Certainty: resBody.Certainty,
+		Answers:   v.answers(resBody),
 	}, nil
 }
 
+// answers returns the candidate answers of the response. Inference
+// containers which only ever return a single answer don't set the answers
+// list, in this case the single answer is the only candidate
+func (v *qna) answers(resBody answersResponse) []ent.Answer {
+	if len(resBody.Answers) == 0 {
+		if resBody.Answer == nil {
+			return nil
+		}
+		return []ent.Answer{{Answer: resBody.Answer, Certainty: resBody.Certainty}}
+	}
+
+	answers := make([]ent.Answer, len(resBody.Answers))
+	for i, answer := range resBody.Answers {
+		answers[i] = ent.Answer{Answer: answer.Answer, Certainty: answer.Certainty}
+	}
+	return answers
+}
+
 func (v *qna) url(path string) string {
 	return fmt.Sprintf("%s%s", v.origin, path)
 }
@@ -89,10 +109,16 @@ func (v *qna) url(path string) string {
 type answersInput struct {
 	Text     string `json:"text"`
 	Question string `json:"question"`
+	Limit    int    `json:"limit,omitempty"`
 }
 
-type answersResponse struct {
-	answersInput
+type answerResponse struct {
 	Answer    *string  `json:"answer"`
 	Certainty *float64 `json:"certainty"`
 }
+
+type answersResponse struct {
+	answersInput
+	answerResponse
+	Answers []answerResponse `json:"answers"`
+}
@@ -0,0 +1,105 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/modules/qna-transformers/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnswer(t *testing.T) {
+	t.Run("when the server returns a single answer", func(t *testing.T) {
+		server := httptest.NewServer(&testAnswerHandler{t: t})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+
+		res, err := c.Answer(context.Background(), "John lives in Amsterdam", "Where does John live?", 0)
+
+		require.Nil(t, err)
+		require.NotNil(t, res.Answer)
+		assert.Equal(t, "Amsterdam", *res.Answer)
+		require.Len(t, res.Answers, 1)
+		assert.Equal(t, "Amsterdam", *res.Answers[0].Answer)
+		assert.Equal(t, 0.9, *res.Answers[0].Certainty)
+	})
+
+	t.Run("when the server returns multiple answers", func(t *testing.T) {
+		server := httptest.NewServer(&testAnswerHandler{t: t})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+
+		res, err := c.Answer(context.Background(), "John lives in Amsterdam", "Where does John live?", 2)
+
+		require.Nil(t, err)
+		require.Len(t, res.Answers, 2)
+		assert.Equal(t, "Amsterdam", *res.Answers[0].Answer)
+		assert.Equal(t, 0.9, *res.Answers[0].Certainty)
+		assert.Equal(t, "John lives in Amsterdam", *res.Answers[1].Answer)
+		assert.Equal(t, 0.4, *res.Answers[1].Certainty)
+	})
+
+	t.Run("when the server has no answer", func(t *testing.T) {
+		server := httptest.NewServer(&testAnswerHandler{t: t, noAnswer: true})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+
+		res, err := c.Answer(context.Background(), "John lives in Amsterdam", "What is the meaning of life?", 0)
+
+		require.Nil(t, err)
+		assert.Nil(t, res.Answer)
+		assert.Equal(t, []ent.Answer(nil), res.Answers)
+	})
+}
+
+type testAnswerHandler struct {
+	t        *testing.T
+	noAnswer bool
+}
+
+func (f *testAnswerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/answers/", r.URL.String())
+	assert.Equal(f.t, http.MethodPost, r.Method)
+
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+
+	var b map[string]interface{}
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &b))
+
+	out := map[string]interface{}{
+		"text":     b["text"],
+		"question": b["question"],
+	}
+	if !f.noAnswer {
+		out["answer"] = "Amsterdam"
+		out["certainty"] = 0.9
+		if limit, ok := b["limit"].(float64); ok && limit > 1 {
+			out["answers"] = []map[string]interface{}{
+				{"answer": "Amsterdam", "certainty": 0.9},
+				{"answer": "John lives in Amsterdam", "certainty": 0.4},
+			}
+		}
+	}
+	outBytes, err := json.Marshal(out)
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
@@ -16,4 +16,12 @@ type AnswerResult struct {
 	Question  string
 	Answer    *string
 	Certainty *float64
+	// Answers contains all candidate answers ordered by certainty, the first
+	// entry is the same as Answer and Certainty
+	Answers []Answer
+}
+
+type Answer struct {
+	Answer    *string
+	Certainty *float64
 }
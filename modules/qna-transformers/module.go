@@ -43,7 +43,7 @@ type QnAModule struct {
 
 type qnaClient interface {
 	Answer(ctx context.Context,
-		text, question string) (*ent.AnswerResult, error)
+		text, question string, limit int) (*ent.AnswerResult, error)
 	MetaInfo() (map[string]interface{}, error)
 }
 
@@ -0,0 +1,49 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package summary
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSummaryField(t *testing.T) {
+	t.Run("should generate summary argument properly", func(t *testing.T) {
+		// given
+		summaryProvider := &SummaryProvider{}
+		classname := "Class"
+
+		// when
+		summary := summaryProvider.additionalSummaryField(classname)
+
+		// then
+		// the built graphQL field needs to support this structure:
+		// Type: {
+		//   summary(properties: ["content"]) {
+		//     property: "content",
+		//     result: "summary of the content"
+		//   }
+		// }
+		assert.NotNil(t, summary)
+		assert.NotNil(t, summary.Args["properties"])
+		summaryList, summaryListOK := summary.Type.(*graphql.List)
+		assert.True(t, summaryListOK)
+		summaryObject, summaryObjectOK := summaryList.OfType.(*graphql.Object)
+		assert.True(t, summaryObjectOK)
+		assert.Equal(t, "ClassAdditionalSummary", summaryObject.Name())
+		assert.Equal(t, 2, len(summaryObject.Fields()))
+		assert.NotNil(t, summaryObject.Fields()["property"])
+		assert.NotNil(t, summaryObject.Fields()["result"])
+	})
+}
@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package summary
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSummaryArguments(t *testing.T) {
+	t.Run("without arguments", func(t *testing.T) {
+		p := &SummaryProvider{}
+
+		params := p.parseSummaryArguments(nil)
+
+		assert.Equal(t, &Params{}, params)
+	})
+
+	t.Run("with properties", func(t *testing.T) {
+		p := &SummaryProvider{}
+		args := []*ast.Argument{
+			{
+				Name: ast.NewName(&ast.Name{Value: "properties"}),
+				Value: ast.NewListValue(&ast.ListValue{
+					Values: []ast.Value{
+						ast.NewStringValue(&ast.StringValue{Value: "title"}),
+						ast.NewStringValue(&ast.StringValue{Value: "body"}),
+					},
+				}),
+			},
+		}
+
+		params := p.parseSummaryArguments(args)
+
+		assert.Equal(t, &Params{Properties: []string{"title", "body"}}, params)
+	})
+}
@@ -0,0 +1,92 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package summary
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	summodels "github.com/semi-technologies/weaviate/modules/sum-transformers/additional/models"
+)
+
+func (p *SummaryProvider) findSummary(ctx context.Context,
+	in []search.Result, params *Params) ([]search.Result, error) {
+	for i := range in {
+		textProperties := p.textProperties(in[i], params.GetProperties())
+
+		summaries := []*summodels.Summary{}
+		for _, property := range p.sortedKeys(textProperties) {
+			results, err := p.sum.GetSummary(ctx, property, textProperties[property])
+			if err != nil {
+				return in, errors.Wrapf(err, "summarize property %q", property)
+			}
+			for _, result := range results {
+				summaries = append(summaries, &summodels.Summary{
+					Property: result.Property,
+					Result:   result.Result,
+				})
+			}
+		}
+
+		ap := in[i].AdditionalProperties
+		if ap == nil {
+			ap = models.AdditionalProperties{}
+		}
+		ap["summary"] = summaries
+		in[i].AdditionalProperties = ap
+	}
+
+	return in, nil
+}
+
+func (p *SummaryProvider) textProperties(result search.Result,
+	properties []string) map[string]string {
+	textProperties := map[string]string{}
+	schema, ok := result.Object().Properties.(map[string]interface{})
+	if !ok {
+		return textProperties
+	}
+
+	for property, value := range schema {
+		if p.containsProperty(property, properties) {
+			if valueString, ok := value.(string); ok && len(valueString) > 0 {
+				textProperties[property] = valueString
+			}
+		}
+	}
+	return textProperties
+}
+
+func (p *SummaryProvider) containsProperty(property string, properties []string) bool {
+	if len(properties) == 0 {
+		return true
+	}
+	for i := range properties {
+		if properties[i] == property {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys makes sure the summaries are always returned in the same order
+func (p *SummaryProvider) sortedKeys(in map[string]string) []string {
+	keys := make([]string, 0, len(in))
+	for key := range in {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
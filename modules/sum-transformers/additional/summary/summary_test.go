@@ -0,0 +1,131 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package summary
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/search"
+	summodels "github.com/semi-technologies/weaviate/modules/sum-transformers/additional/models"
+	"github.com/semi-technologies/weaviate/modules/sum-transformers/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdditionalSummaryProvider(t *testing.T) {
+	t.Run("should fail with wrong parameters", func(t *testing.T) {
+		// given
+		summaryProvider := New(&fakeSUMClient{})
+		in := []search.Result{{ID: "some-uuid"}}
+
+		// when
+		_, err := summaryProvider.AdditionalPropertyFn(context.Background(), in, "wrong", nil, nil)
+
+		// then
+		require.NotNil(t, err)
+		assert.Equal(t, "wrong parameters", err.Error())
+	})
+
+	t.Run("should summarize all text properties", func(t *testing.T) {
+		// given
+		summaryProvider := New(&fakeSUMClient{})
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content":   "this is a very long text",
+					"title":     "a title",
+					"wordCount": 17,
+				},
+			},
+		}
+
+		// when
+		out, err := summaryProvider.AdditionalPropertyFn(context.Background(), in, &Params{}, nil, nil)
+
+		// then
+		require.Nil(t, err)
+		require.Len(t, out, 1)
+		summary, ok := out[0].AdditionalProperties["summary"]
+		require.True(t, ok)
+		expected := []*summodels.Summary{
+			{Property: "content", Result: "summary of: this is a very long text"},
+			{Property: "title", Result: "summary of: a title"},
+		}
+		assert.Equal(t, expected, summary)
+	})
+
+	t.Run("should summarize only the selected properties", func(t *testing.T) {
+		// given
+		summaryProvider := New(&fakeSUMClient{})
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "this is a very long text",
+					"title":   "a title",
+				},
+			},
+		}
+		params := &Params{Properties: []string{"content"}}
+
+		// when
+		out, err := summaryProvider.AdditionalPropertyFn(context.Background(), in, params, nil, nil)
+
+		// then
+		require.Nil(t, err)
+		require.Len(t, out, 1)
+		expected := []*summodels.Summary{
+			{Property: "content", Result: "summary of: this is a very long text"},
+		}
+		assert.Equal(t, expected, out[0].AdditionalProperties["summary"])
+	})
+
+	t.Run("should fail when the remote module fails", func(t *testing.T) {
+		// given
+		summaryProvider := New(&fakeSUMClient{err: errors.New("remote error")})
+		in := []search.Result{
+			{
+				ID: "some-uuid",
+				Schema: map[string]interface{}{
+					"content": "this is a very long text",
+				},
+			},
+		}
+
+		// when
+		_, err := summaryProvider.AdditionalPropertyFn(context.Background(), in, &Params{}, nil, nil)
+
+		// then
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "remote error")
+	})
+}
+
+type fakeSUMClient struct {
+	err error
+}
+
+func (c *fakeSUMClient) GetSummary(ctx context.Context,
+	property, text string) ([]ent.SummaryResult, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	return []ent.SummaryResult{
+		{
+			Property: property,
+			Result:   "summary of: " + text,
+		},
+	}, nil
+}
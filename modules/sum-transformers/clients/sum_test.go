@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/modules/sum-transformers/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSummary(t *testing.T) {
+	t.Run("when all is fine", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		expected := []ent.SummaryResult{
+			{
+				Property: "content",
+				Result:   "this is the summary",
+			},
+		}
+		res, err := c.GetSummary(context.Background(), "content", "This is my text")
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, res)
+	})
+
+	t.Run("when the context is expired", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{t: t})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		ctx, cancel := context.WithDeadline(context.Background(), time.Now())
+		defer cancel()
+
+		_, err := c.GetSummary(ctx, "content", "This is my text")
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "context deadline exceeded")
+	})
+
+	t.Run("when the server returns an error", func(t *testing.T) {
+		server := httptest.NewServer(&fakeHandler{
+			t:           t,
+			serverError: errors.Errorf("nope, not gonna happen"),
+		})
+		defer server.Close()
+		c := New(server.URL, nullLogger())
+		_, err := c.GetSummary(context.Background(), "content", "This is my text")
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "nope, not gonna happen")
+	})
+}
+
+type fakeHandler struct {
+	t           *testing.T
+	serverError error
+}
+
+func (f *fakeHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	assert.Equal(f.t, "/sum/", r.URL.String())
+	assert.Equal(f.t, http.MethodPost, r.Method)
+
+	if f.serverError != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(fmt.Sprintf(`{"error":"%s"}`, f.serverError.Error())))
+		return
+	}
+
+	bodyBytes, err := ioutil.ReadAll(r.Body)
+	require.Nil(f.t, err)
+	defer r.Body.Close()
+
+	var b map[string]interface{}
+	require.Nil(f.t, json.Unmarshal(bodyBytes, &b))
+
+	textInput := b["text"].(string)
+	assert.Greater(f.t, len(textInput), 0)
+
+	out := map[string]interface{}{
+		"text": textInput,
+		"summary": []map[string]interface{}{
+			{"result": "this is the summary"},
+		},
+	}
+	outBytes, err := json.Marshal(out)
+	require.Nil(f.t, err)
+
+	w.Write(outBytes)
+}
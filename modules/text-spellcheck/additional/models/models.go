@@ -0,0 +1,29 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package models
+
+// SpellCheckChange represents a single correction
+// made by the spellcheck module
+type SpellCheckChange struct {
+	Original  string `json:"original,omitempty"`
+	Corrected string `json:"corrected,omitempty"`
+}
+
+// SpellCheck used in spellcheck module to represent
+// the spell check result of a given search text
+type SpellCheck struct {
+	OriginalText        string             `json:"originalText"`
+	DidYouMean          string             `json:"didYouMean"`
+	Location            string             `json:"location"`
+	NumberOfCorrections int                `json:"numberOfCorrections"`
+	Changes             []SpellCheckChange `json:"changes"`
+}
@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package spellcheck
+
+import (
+	"testing"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpellCheckField(t *testing.T) {
+	t.Run("should generate spellCheck argument properly", func(t *testing.T) {
+		// given
+		spellCheckProvider := &SpellCheckProvider{}
+		classname := "Class"
+
+		// when
+		spellCheck := spellCheckProvider.additionalSpellCheckField(classname)
+
+		// then
+		// the built graphQL field needs to support this structure:
+		// Type: [{
+		//   originalText: "Whaat is the captital of Poland?",
+		//   didYouMean: "what is the capital of Poland?",
+		//   location: "nearText.concepts[0]",
+		//   numberOfCorrections: 2,
+		//   changes: [{
+		//     original: "whaat",
+		//     corrected: "what"
+		//   }]
+		// }]
+		assert.NotNil(t, spellCheck)
+		spellCheckList, spellCheckListOK := spellCheck.Type.(*graphql.List)
+		assert.True(t, spellCheckListOK)
+		spellCheckObject, spellCheckObjectOK := spellCheckList.OfType.(*graphql.Object)
+		assert.True(t, spellCheckObjectOK)
+		assert.Equal(t, "ClassAdditionalSpellCheck", spellCheckObject.Name())
+		assert.Equal(t, 5, len(spellCheckObject.Fields()))
+		assert.NotNil(t, spellCheckObject.Fields()["originalText"])
+		assert.NotNil(t, spellCheckObject.Fields()["didYouMean"])
+		assert.NotNil(t, spellCheckObject.Fields()["location"])
+		assert.NotNil(t, spellCheckObject.Fields()["numberOfCorrections"])
+		changes, changesOK := spellCheckObject.Fields()["changes"].Type.(*graphql.List)
+		assert.True(t, changesOK)
+		changesObject, changesObjectOK := changes.OfType.(*graphql.Object)
+		assert.True(t, changesObjectOK)
+		assert.Equal(t, 2, len(changesObject.Fields()))
+		assert.NotNil(t, changesObject.Fields()["original"])
+		assert.NotNil(t, changesObject.Fields()["corrected"])
+	})
+}
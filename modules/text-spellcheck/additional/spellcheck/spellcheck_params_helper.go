@@ -0,0 +1,80 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package spellcheck
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// searchText is a single text taken from the search arguments, together
+// with the location it was found at, e.g. "nearText.concepts[0]"
+type searchText struct {
+	location string
+	text     string
+}
+
+type paramsHelper struct{}
+
+// getSearchTexts collects the texts of the nearText and ask arguments. Those
+// arguments are owned by other modules, so their params are only known as
+// interface{}. The fields are therefore looked up by name.
+func (p paramsHelper) getSearchTexts(argumentModuleParams map[string]interface{}) []searchText {
+	texts := []searchText{}
+	if nearText, ok := argumentModuleParams["nearText"]; ok {
+		for i, value := range p.getStringSlice(nearText, "Values") {
+			texts = append(texts, searchText{
+				location: fmt.Sprintf("nearText.concepts[%d]", i),
+				text:     value,
+			})
+		}
+	}
+	if ask, ok := argumentModuleParams["ask"]; ok {
+		if question := p.getString(ask, "Question"); question != "" {
+			texts = append(texts, searchText{
+				location: "ask.question",
+				text:     question,
+			})
+		}
+	}
+	return texts
+}
+
+func (p paramsHelper) getStringSlice(params interface{}, fieldName string) []string {
+	if field, ok := p.getField(params, fieldName); ok {
+		if values, ok := field.Interface().([]string); ok {
+			return values
+		}
+	}
+	return nil
+}
+
+func (p paramsHelper) getString(params interface{}, fieldName string) string {
+	if field, ok := p.getField(params, fieldName); ok {
+		if value, ok := field.Interface().(string); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+func (p paramsHelper) getField(params interface{}, fieldName string) (reflect.Value, bool) {
+	value := reflect.ValueOf(params)
+	if value.Kind() == reflect.Ptr {
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return reflect.Value{}, false
+	}
+	field := value.FieldByName(fieldName)
+	return field, field.IsValid()
+}
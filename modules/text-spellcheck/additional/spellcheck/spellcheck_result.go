@@ -0,0 +1,71 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package spellcheck
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/search"
+	spellcheckmodels "github.com/semi-technologies/weaviate/modules/text-spellcheck/additional/models"
+)
+
+func (p *SpellCheckProvider) findSpellCheck(ctx context.Context,
+	in []search.Result, params *Params,
+	argumentModuleParams map[string]interface{}) ([]search.Result, error) {
+	if len(in) == 0 {
+		return in, nil
+	}
+
+	texts := p.getSearchTexts(argumentModuleParams)
+	if len(texts) == 0 {
+		return in, errors.New("spellCheck requires a nearText or ask argument")
+	}
+
+	// the search texts are the same for every result, so they only need to be
+	// checked once
+	spellCheck := make([]*spellcheckmodels.SpellCheck, len(texts))
+	for i, text := range texts {
+		result, err := p.spellCheck.Check(ctx, text.text)
+		if err != nil {
+			return in, errors.Wrapf(err, "check %s", text.location)
+		}
+
+		changes := make([]spellcheckmodels.SpellCheckChange, len(result.Changes))
+		for j, change := range result.Changes {
+			changes[j] = spellcheckmodels.SpellCheckChange{
+				Original:  change.Original,
+				Corrected: change.Corrected,
+			}
+		}
+
+		spellCheck[i] = &spellcheckmodels.SpellCheck{
+			OriginalText:        text.text,
+			DidYouMean:          result.DidYouMean,
+			Location:            text.location,
+			NumberOfCorrections: len(changes),
+			Changes:             changes,
+		}
+	}
+
+	for i := range in {
+		ap := in[i].AdditionalProperties
+		if ap == nil {
+			ap = models.AdditionalProperties{}
+		}
+		ap["spellCheck"] = spellCheck
+		in[i].AdditionalProperties = ap
+	}
+
+	return in, nil
+}
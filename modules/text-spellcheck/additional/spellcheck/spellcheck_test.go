@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package spellcheck
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/search"
+	spellcheckmodels "github.com/semi-technologies/weaviate/modules/text-spellcheck/additional/models"
+	"github.com/semi-technologies/weaviate/modules/text-spellcheck/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdditionalSpellCheckProvider(t *testing.T) {
+	t.Run("should fail without a search text", func(t *testing.T) {
+		// given
+		spellCheckProvider := New(&fakeSpellCheckClient{})
+		in := []search.Result{{ID: "some-uuid"}}
+
+		// when
+		_, err := spellCheckProvider.AdditionalPropertyFn(context.Background(),
+			in, &Params{}, nil, map[string]interface{}{})
+
+		// then
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "requires a nearText or ask argument")
+	})
+
+	t.Run("should check nearText concepts", func(t *testing.T) {
+		// given
+		spellCheckProvider := New(&fakeSpellCheckClient{})
+		in := []search.Result{{ID: "some-uuid"}, {ID: "other-uuid"}}
+		argumentModuleParams := map[string]interface{}{
+			"nearText": &fakeNearTextParams{
+				Values: []string{"Whaat is the captital of Poland?", "Warsaw"},
+			},
+		}
+
+		// when
+		out, err := spellCheckProvider.AdditionalPropertyFn(context.Background(),
+			in, &Params{}, nil, argumentModuleParams)
+
+		// then
+		require.Nil(t, err)
+		require.Len(t, out, 2)
+		expected := []*spellcheckmodels.SpellCheck{
+			{
+				OriginalText:        "Whaat is the captital of Poland?",
+				DidYouMean:          "what is the capital of Poland?",
+				Location:            "nearText.concepts[0]",
+				NumberOfCorrections: 2,
+				Changes: []spellcheckmodels.SpellCheckChange{
+					{Original: "whaat", Corrected: "what"},
+					{Original: "captital", Corrected: "capital"},
+				},
+			},
+			{
+				OriginalText:        "Warsaw",
+				DidYouMean:          "Warsaw",
+				Location:            "nearText.concepts[1]",
+				NumberOfCorrections: 0,
+				Changes:             []spellcheckmodels.SpellCheckChange{},
+			},
+		}
+		assert.Equal(t, expected, out[0].AdditionalProperties["spellCheck"])
+		assert.Equal(t, expected, out[1].AdditionalProperties["spellCheck"])
+	})
+
+	t.Run("should check ask question", func(t *testing.T) {
+		// given
+		spellCheckProvider := New(&fakeSpellCheckClient{})
+		in := []search.Result{{ID: "some-uuid"}}
+		argumentModuleParams := map[string]interface{}{
+			"ask": &fakeAskParams{
+				Question: "Whaat is the captital of Poland?",
+			},
+		}
+
+		// when
+		out, err := spellCheckProvider.AdditionalPropertyFn(context.Background(),
+			in, &Params{}, nil, argumentModuleParams)
+
+		// then
+		require.Nil(t, err)
+		require.Len(t, out, 1)
+		spellCheck, ok := out[0].AdditionalProperties["spellCheck"].([]*spellcheckmodels.SpellCheck)
+		require.True(t, ok)
+		require.Len(t, spellCheck, 1)
+		assert.Equal(t, "ask.question", spellCheck[0].Location)
+		assert.Equal(t, "what is the capital of Poland?", spellCheck[0].DidYouMean)
+		assert.Equal(t, 2, spellCheck[0].NumberOfCorrections)
+	})
+}
+
+type fakeNearTextParams struct {
+	Values []string
+}
+
+type fakeAskParams struct {
+	Question string
+}
+
+type fakeSpellCheckClient struct{}
+
+func (c *fakeSpellCheckClient) Check(ctx context.Context,
+	text string) (*ent.SpellCheckResult, error) {
+	changes := []ent.SpellCheckChange{}
+	didYouMean := text
+	if strings.Contains(text, "Whaat") {
+		changes = append(changes, ent.SpellCheckChange{Original: "whaat", Corrected: "what"})
+		didYouMean = strings.ReplaceAll(didYouMean, "Whaat", "what")
+	}
+	if strings.Contains(text, "captital") {
+		changes = append(changes, ent.SpellCheckChange{Original: "captital", Corrected: "capital"})
+		didYouMean = strings.ReplaceAll(didYouMean, "captital", "capital")
+	}
+	return &ent.SpellCheckResult{
+		Text:       text,
+		DidYouMean: didYouMean,
+		Changes:    changes,
+	}, nil
+}
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/modules/text-spellcheck/ent"
+	"github.com/sirupsen/logrus"
+)
+
+type spellCheck struct {
+	origin     string
+	httpClient *http.Client
+	logger     logrus.FieldLogger
+}
+
+func New(origin string, logger logrus.FieldLogger) *spellCheck {
+	return &spellCheck{
+		origin:     origin,
+		httpClient: &http.Client{},
+		logger:     logger,
+	}
+}
+
+func (s *spellCheck) Check(ctx context.Context, text string) (*ent.SpellCheckResult, error) {
+	body, err := json.Marshal(spellCheckInput{
+		Text: text,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "marshal body")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", s.url("/spellcheck/"),
+		bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "create POST request")
+	}
+
+	res, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "send POST request")
+	}
+	defer res.Body.Close()
+
+	bodyBytes, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "read response body")
+	}
+
+	var resBody spellCheckResponse
+	if err := json.Unmarshal(bodyBytes, &resBody); err != nil {
+		return nil, errors.Wrap(err, "unmarshal response body")
+	}
+
+	if res.StatusCode > 399 {
+		return nil, errors.Errorf("fail with status %d: %s", res.StatusCode,
+			resBody.Error)
+	}
+
+	changes := make([]ent.SpellCheckChange, len(resBody.Changes))
+	for i, change := range resBody.Changes {
+		changes[i] = ent.SpellCheckChange{
+			Original:  change.Original,
+			Corrected: change.Correction,
+		}
+	}
+
+	return &ent.SpellCheckResult{
+		Text:       resBody.Text,
+		DidYouMean: s.applyChanges(resBody.Text, changes),
+		Changes:    changes,
+	}, nil
+}
+
+// applyChanges replaces every (case-insensitive) occurrence of a misspelled
+// word with its correction
+func (s *spellCheck) applyChanges(text string, changes []ent.SpellCheckChange) string {
+	corrected := text
+	for _, change := range changes {
+		if change.Original == "" {
+			continue
+		}
+		r := regexp.MustCompile(fmt.Sprintf(`(?i)\b%s\b`, regexp.QuoteMeta(change.Original)))
+		corrected = r.ReplaceAllLiteralString(corrected, change.Corrected)
+	}
+	return corrected
+}
+
+func (s *spellCheck) url(path string) string {
+	return fmt.Sprintf("%s%s", s.origin, path)
+}
+
+type spellCheckInput struct {
+	Text string `json:"text"`
+}
+
+type spellCheckCorrection struct {
+	Original   string `json:"original"`
+	Correction string `json:"correction"`
+}
+
+type spellCheckResponse struct {
+	spellCheckInput
+	Error   string                 `json:"error"`
+	Changes []spellCheckCorrection `json:"changes"`
+}
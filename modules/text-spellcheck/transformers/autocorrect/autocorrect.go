@@ -0,0 +1,46 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package autocorrect
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/modules/text-spellcheck/ent"
+)
+
+type spellCheckClient interface {
+	Check(ctx context.Context, text string) (*ent.SpellCheckResult, error)
+}
+
+// AutocorrectTransformer replaces the given texts with their spell-checked
+// versions
+type AutocorrectTransformer struct {
+	spellCheck spellCheckClient
+}
+
+func New(spellCheck spellCheckClient) *AutocorrectTransformer {
+	return &AutocorrectTransformer{spellCheck}
+}
+
+func (t *AutocorrectTransformer) Transform(ctx context.Context,
+	in []string) ([]string, error) {
+	out := make([]string, len(in))
+	for i, text := range in {
+		result, err := t.spellCheck.Check(ctx, text)
+		if err != nil {
+			return nil, errors.Wrapf(err, "autocorrect %q", text)
+		}
+		out[i] = result.DidYouMean
+	}
+	return out, nil
+}
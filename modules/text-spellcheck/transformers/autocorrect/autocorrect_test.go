@@ -0,0 +1,61 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package autocorrect
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/modules/text-spellcheck/ent"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAutocorrectTransformer(t *testing.T) {
+	t.Run("should correct all texts", func(t *testing.T) {
+		transformer := New(&fakeSpellCheckClient{})
+
+		out, err := transformer.Transform(context.Background(),
+			[]string{"Whaat is the captital of Poland?", "Warsaw"})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{"what is the capital of Poland?", "Warsaw"}, out)
+	})
+
+	t.Run("should fail when the remote module fails", func(t *testing.T) {
+		transformer := New(&fakeSpellCheckClient{err: errors.New("remote error")})
+
+		_, err := transformer.Transform(context.Background(), []string{"Warsaw"})
+
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "remote error")
+	})
+}
+
+type fakeSpellCheckClient struct {
+	err error
+}
+
+func (c *fakeSpellCheckClient) Check(ctx context.Context,
+	text string) (*ent.SpellCheckResult, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	didYouMean := strings.ReplaceAll(text, "Whaat", "what")
+	didYouMean = strings.ReplaceAll(didYouMean, "captital", "capital")
+	return &ent.SpellCheckResult{
+		Text:       text,
+		DidYouMean: didYouMean,
+	}, nil
+}
@@ -37,6 +37,10 @@ func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
 	return f.schema
 }
 
+func (f *fakeSchemaGetter) TenantsSkipAuth(className string) []*models.Tenant {
+	return nil
+}
+
 type fakeClassificationRepo struct {
 	sync.Mutex
 	db map[strfmt.UUID]models.Classification
@@ -32,6 +32,7 @@ func (m *ContextionaryModule) PropertyConfigDefaults(
 	return map[string]interface{}{
 		"skip":                  !vectorizer.DefaultPropertyIndexed,
 		"vectorizePropertyName": vectorizer.DefaultVectorizePropertyName,
+		"weight":                float64(vectorizer.DefaultPropertyWeight),
 	}
 }
 
@@ -53,9 +53,9 @@ type ContextionaryModule struct {
 	concepts                     *concepts.RESTHandlers
 	vectorizer                   *localvectorizer.Vectorizer
 	configValidator              configValidator
-	graphqlProvider              modulecapabilities.GraphQLArguments
+	graphqlProvider              *text2vecneartext.GraphQLArgumentsProvider
 	additionalPropertiesProvider modulecapabilities.AdditionalProperties
-	searcher                     modulecapabilities.Searcher
+	searcher                     *text2vecneartext.Searcher
 	remote                       remoteClient
 	classifierContextual         modulecapabilities.Classifier
 	logger                       logrus.FieldLogger
@@ -195,6 +195,20 @@ func (m *ContextionaryModule) VectorizeObject(ctx context.Context,
 	return m.vectorizer.Object(ctx, obj, icheck)
 }
 
+// InitDependency picks up an optional nearText transformer provided by
+// another module, such as the autocorrection of text-spellcheck
+func (m *ContextionaryModule) InitDependency(modules []modulecapabilities.Module) error {
+	for _, module := range modules {
+		if arg, ok := module.(modulecapabilities.TextTransformers); ok {
+			if transformer, ok := arg.TextTransformers()["nearText"]; ok && transformer != nil {
+				m.graphqlProvider.SetNearTextTransformer(transformer)
+				m.searcher.SetNearTextTransformer(transformer)
+			}
+		}
+	}
+	return nil
+}
+
 func (m *ContextionaryModule) Arguments() map[string]modulecapabilities.GraphQLArgument {
 	return m.graphqlProvider.Arguments()
 }
@@ -219,4 +233,5 @@ func (m *ContextionaryModule) MetaInfo() (map[string]interface{}, error) {
 var (
 	_ = modulecapabilities.Module(New())
 	_ = modulecapabilities.Vectorizer(New())
+	_ = modulecapabilities.ModuleDependency(New())
 )
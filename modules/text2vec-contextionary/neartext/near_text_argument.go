@@ -18,29 +18,33 @@ import (
 	"github.com/semi-technologies/weaviate/adapters/handlers/graphql/descriptions"
 )
 
-func getNearTextArgumentFn(classname string) *graphql.ArgumentConfig {
-	return nearTextArgument("GetObjects", classname)
+func (g *GraphQLArgumentsProvider) getNearTextArgumentFn(classname string) *graphql.ArgumentConfig {
+	return g.nearTextArgument("GetObjects", classname)
 }
 
-func exploreNearTextArgumentFn() *graphql.ArgumentConfig {
-	return nearTextArgument("Explore", "")
+func (g *GraphQLArgumentsProvider) aggregateNearTextArgumentFn(classname string) *graphql.ArgumentConfig {
+	return g.nearTextArgument("AggregateObjects", classname)
 }
 
-func nearTextArgument(prefix, className string) *graphql.ArgumentConfig {
+func (g *GraphQLArgumentsProvider) exploreNearTextArgumentFn() *graphql.ArgumentConfig {
+	return g.nearTextArgument("Explore", "")
+}
+
+func (g *GraphQLArgumentsProvider) nearTextArgument(prefix, className string) *graphql.ArgumentConfig {
 	prefixName := fmt.Sprintf("Txt2VecC11y%s%s", prefix, className)
 	return &graphql.ArgumentConfig{
 		Type: graphql.NewInputObject(
 			graphql.InputObjectConfig{
 				Name:        fmt.Sprintf("%sNearTextInpObj", prefixName),
-				Fields:      nearTextFields(prefixName),
+				Fields:      g.nearTextFields(prefixName),
 				Description: descriptions.GetWhereInpObj,
 			},
 		),
 	}
 }
 
-func nearTextFields(prefix string) graphql.InputObjectConfigFieldMap {
-	return graphql.InputObjectConfigFieldMap{
+func (g *GraphQLArgumentsProvider) nearTextFields(prefix string) graphql.InputObjectConfigFieldMap {
+	fields := graphql.InputObjectConfigFieldMap{
 		"concepts": &graphql.InputObjectFieldConfig{
 			// Description: descriptions.Concepts,
 			Type: graphql.NewNonNull(graphql.NewList(graphql.String)),
@@ -66,6 +70,15 @@ func nearTextFields(prefix string) graphql.InputObjectConfigFieldMap {
 				}),
 		},
 	}
+	// autocorrect is only offered if a module providing a nearText
+	// transformer (such as text-spellcheck) is enabled
+	if g.nearTextTransformer != nil {
+		fields["autocorrect"] = &graphql.InputObjectFieldConfig{
+			Description: descriptions.Autocorrect,
+			Type:        graphql.Boolean,
+		}
+	}
+	return fields
 }
 
 func movementInp(prefix string) graphql.InputObjectConfigFieldMap {
@@ -12,6 +12,7 @@
 package neartext
 
 import (
+	"context"
 	"testing"
 
 	"github.com/graphql-go/graphql"
@@ -24,7 +25,7 @@ func TestNearTextGraphQLArgument(t *testing.T) {
 		prefix := "Prefix"
 		classname := "Class"
 		// when
-		nearText := nearTextArgument(prefix, classname)
+		nearText := New().nearTextArgument(prefix, classname)
 
 		// then
 		// the built graphQL field needs to support this structure:
@@ -102,4 +103,26 @@ func TestNearTextGraphQLArgument(t *testing.T) {
 		_, moveAwayFromForceOK := moveAwayFrom.Fields()["force"].Type.(*graphql.NonNull)
 		assert.True(t, moveAwayFromForceOK)
 	})
+	t.Run("should generate nearText argument with autocorrect", func(t *testing.T) {
+		// given
+		provider := New()
+		provider.SetNearTextTransformer(&fakeTransformer{})
+
+		// when
+		nearText := provider.nearTextArgument("Prefix", "Class")
+
+		// then
+		nearTextFields, ok := nearText.Type.(*graphql.InputObject)
+		assert.True(t, ok)
+		assert.Equal(t, 5, len(nearTextFields.Fields()))
+		autocorrect := nearTextFields.Fields()["autocorrect"]
+		assert.NotNil(t, autocorrect)
+		assert.Equal(t, "Boolean", autocorrect.Type.Name())
+	})
+}
+
+type fakeTransformer struct{}
+
+func (t *fakeTransformer) Transform(ctx context.Context, in []string) ([]string, error) {
+	return in, nil
 }
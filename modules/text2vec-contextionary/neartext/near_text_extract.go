@@ -54,6 +54,12 @@ func extractNearTextFn(source map[string]interface{}) interface{} {
 		args.MoveAwayFrom = extractMovement(moveAwayFrom)
 	}
 
+	// autocorrect is an optional arg, so it could be nil
+	autocorrect, ok := source["autocorrect"]
+	if ok {
+		args.Autocorrect = autocorrect.(bool)
+	}
+
 	return &args
 }
 
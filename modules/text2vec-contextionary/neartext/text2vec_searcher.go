@@ -29,11 +29,20 @@ type Vectorizer interface {
 }
 
 type Searcher struct {
-	vectorizer Vectorizer
+	vectorizer          Vectorizer
+	nearTextTransformer modulecapabilities.TextTransform
 }
 
 func NewSearcher(vectorizer Vectorizer) *Searcher {
-	return &Searcher{vectorizer}
+	return &Searcher{vectorizer: vectorizer}
+}
+
+// SetNearTextTransformer enables autocorrection of the nearText concepts. It
+// is set once the module dependencies are resolved, which happens after the
+// module was initialized.
+func (s *Searcher) SetNearTextTransformer(
+	nearTextTransformer modulecapabilities.TextTransform) {
+	s.nearTextTransformer = nearTextTransformer
 }
 
 func (s *Searcher) VectorSearches() map[string]modulecapabilities.VectorForParams {
@@ -52,7 +61,12 @@ func (s *Searcher) vectorForNearTextParam(ctx context.Context, params interface{
 
 func (s *Searcher) vectorFromNearTextParam(ctx context.Context,
 	params *NearTextParams, findVectorFn modulecapabilities.FindVectorFn) ([]float32, error) {
-	vector, err := s.vectorizer.Corpi(ctx, params.Values)
+	values, err := s.nearTextValues(ctx, params)
+	if err != nil {
+		return nil, errors.Errorf("autocorrect keywords: %v", err)
+	}
+
+	vector, err := s.vectorizer.Corpi(ctx, values)
 	if err != nil {
 		return nil, errors.Errorf("vectorize keywords: %v", err)
 	}
@@ -126,3 +140,11 @@ func (s *Searcher) vectorFromValuesAndObjects(ctx context.Context,
 
 	return libvectorizer.CombineVectors(objectVectors), nil
 }
+
+func (s *Searcher) nearTextValues(ctx context.Context,
+	params *NearTextParams) ([]string, error) {
+	if params.Autocorrect && s.nearTextTransformer != nil {
+		return s.nearTextTransformer.Transform(ctx, params.Values)
+	}
+	return params.Values, nil
+}
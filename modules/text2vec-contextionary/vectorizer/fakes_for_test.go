@@ -13,16 +13,23 @@ package vectorizer
 
 import (
 	"context"
+	"strings"
 
 	txt2vecmodels "github.com/semi-technologies/weaviate/modules/text2vec-contextionary/additional/models"
 )
 
 type fakeClient struct {
 	lastInput []string
+	vectors   map[string][]float32
+	inputs    [][]string
 }
 
 func (c *fakeClient) VectorForCorpi(ctx context.Context, corpi []string, overrides map[string]string) ([]float32, []txt2vecmodels.InterpretationSource, error) {
 	c.lastInput = corpi
+	c.inputs = append(c.inputs, corpi)
+	if vector, ok := c.vectors[strings.Join(corpi, " ")]; ok {
+		return vector, nil, nil
+	}
 	return []float32{0, 1, 2, 3}, nil, nil
 }
 
@@ -44,6 +51,7 @@ type fakeIndexCheck struct {
 	skippedProperty    string
 	vectorizeClassName bool
 	excludedProperty   string
+	weights            map[string]float32
 }
 
 func (f *fakeIndexCheck) PropertyIndexed(propName string) bool {
@@ -54,6 +62,14 @@ func (f *fakeIndexCheck) VectorizePropertyName(propName string) bool {
 	return f.excludedProperty != propName
 }
 
+func (f *fakeIndexCheck) PropertyWeight(propName string) float32 {
+	if weight, ok := f.weights[propName]; ok {
+		return weight
+	}
+
+	return DefaultPropertyWeight
+}
+
 func (f *fakeIndexCheck) VectorizeClassName() bool {
 	return f.vectorizeClassName
 }
@@ -19,6 +19,7 @@ const (
 	DefaultPropertyIndexed       = true
 	DefaultVectorizeClassName    = true
 	DefaultVectorizePropertyName = false
+	DefaultPropertyWeight        = 1
 )
 
 type indexChecker struct {
@@ -57,6 +58,27 @@ func (ic *indexChecker) VectorizePropertyName(propName string) bool {
 	return asBool
 }
 
+// PropertyWeight is the weight of the property in relation to the other
+// vectorized parts of the object, e.g. a weight of 2 means the property
+// counts double
+func (ic *indexChecker) PropertyWeight(propName string) float32 {
+	weight, ok := ic.cfg.Property(propName)["weight"]
+	if !ok {
+		return DefaultPropertyWeight
+	}
+
+	switch asNumber := weight.(type) {
+	case float64:
+		return float32(asNumber)
+	case float32:
+		return asNumber
+	case int:
+		return float32(asNumber)
+	default:
+		return DefaultPropertyWeight
+	}
+}
+
 func (ic *indexChecker) VectorizeClassName() bool {
 	vcn, ok := ic.cfg.Class()["vectorizeClassName"]
 	if !ok {
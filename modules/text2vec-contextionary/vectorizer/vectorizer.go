@@ -23,6 +23,7 @@ import (
 	"github.com/fatih/camelcase"
 	"github.com/semi-technologies/weaviate/entities/models"
 	txt2vecmodels "github.com/semi-technologies/weaviate/modules/text2vec-contextionary/additional/models"
+	libvectorizer "github.com/semi-technologies/weaviate/usecases/vectorizer"
 )
 
 // Vectorizer turns objects into vectors
@@ -52,6 +53,7 @@ type ClassIndexCheck interface {
 	PropertyIndexed(property string) bool
 	VectorizeClassName() bool
 	VectorizePropertyName(propertyName string) bool
+	PropertyWeight(propertyName string) float32
 }
 
 // New from c11y client
@@ -90,9 +92,12 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 	schema interface{}, overrides map[string]string,
 	icheck ClassIndexCheck) ([]float32, []txt2vecmodels.InterpretationSource, error) {
 	var corpi []string
+	var weights []float32
+	weighted := false
 
 	if icheck.VectorizeClassName() {
 		corpi = append(corpi, camelCaseToLower(className))
+		weights = append(weights, DefaultPropertyWeight)
 	}
 
 	if schema != nil {
@@ -110,6 +115,12 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 				} else {
 					corpi = append(corpi, strings.ToLower(valueString))
 				}
+
+				weight := icheck.PropertyWeight(prop)
+				weights = append(weights, weight)
+				if weight != DefaultPropertyWeight {
+					weighted = true
+				}
 			}
 		}
 	}
@@ -119,7 +130,14 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 		corpi = append(corpi, camelCaseToLower(className))
 	}
 
-	vector, ie, err := v.client.VectorForCorpi(ctx, []string{strings.Join(corpi, " ")}, overrides)
+	var vector []float32
+	var ie []txt2vecmodels.InterpretationSource
+	var err error
+	if weighted {
+		vector, ie, err = v.weightedCorpi(ctx, corpi, weights, overrides)
+	} else {
+		vector, ie, err = v.client.VectorForCorpi(ctx, []string{strings.Join(corpi, " ")}, overrides)
+	}
 	if err != nil {
 		switch err.(type) {
 		case ErrNoUsableWords:
@@ -154,6 +172,27 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 }
 
 // Corpi takes any list of strings and builds a common vector for all of them
+// weightedCorpi vectorizes each corpus on its own and combines the individual
+// vectors using their weights. This is only required if at least one property
+// has a non-default weight, otherwise all corpi are vectorized together.
+func (v *Vectorizer) weightedCorpi(ctx context.Context, corpi []string,
+	weights []float32, overrides map[string]string,
+) ([]float32, []txt2vecmodels.InterpretationSource, error) {
+	vectors := make([][]float32, len(corpi))
+	var sources []txt2vecmodels.InterpretationSource
+	for i, corpus := range corpi {
+		vector, ie, err := v.client.VectorForCorpi(ctx, []string{corpus}, overrides)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		vectors[i] = vector
+		sources = append(sources, ie...)
+	}
+
+	return libvectorizer.CombineVectorsWithWeights(vectors, weights), sources, nil
+}
+
 func (v *Vectorizer) Corpi(ctx context.Context, corpi []string,
 ) ([]float32, error) {
 	for i, corpus := range corpi {
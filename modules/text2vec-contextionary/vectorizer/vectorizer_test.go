@@ -164,6 +164,37 @@ func TestVectorizingObjects(t *testing.T) {
 	}
 }
 
+func TestVectorizingObjectsWithPropertyWeights(t *testing.T) {
+	client := &fakeClient{
+		vectors: map[string][]float32{
+			"car":              {3, 3},
+			"title great car":  {3, 0},
+			"review it drives": {0, 3},
+		},
+	}
+	v := New(client)
+	ic := &fakeIndexCheck{
+		vectorizeClassName: true,
+		weights:            map[string]float32{"title": 2},
+	}
+
+	input := &models.Object{
+		Class: "Car",
+		Properties: map[string]interface{}{
+			"title":  "great car",
+			"review": "it drives",
+		},
+	}
+	err := v.Object(context.Background(), input, ic)
+	require.Nil(t, err)
+
+	// each corpus is vectorized on its own and the title counts double
+	assert.ElementsMatch(t, [][]string{
+		{"car"}, {"title great car"}, {"review it drives"},
+	}, client.inputs)
+	assert.Equal(t, models.C11yVector{2.25, 1.5}, input.Vector)
+}
+
 func TestVectorizingActions(t *testing.T) {
 	type testCase struct {
 		name               string
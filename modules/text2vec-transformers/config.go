@@ -36,6 +36,7 @@ func (m *TransformersModule) PropertyConfigDefaults(
 	return map[string]interface{}{
 		"skip":                  !vectorizer.DefaultPropertyIndexed,
 		"vectorizePropertyName": vectorizer.DefaultVectorizePropertyName,
+		"weight":                float64(vectorizer.DefaultPropertyWeight),
 	}
 }
 
@@ -72,6 +73,10 @@ func (cv *ConfigValidator) Do(ctx context.Context, class *models.Class,
 		return errors.Errorf("invalid combination of properties")
 	}
 
+	if err := cv.validatePropertyWeights(class, cfg); err != nil {
+		return err
+	}
+
 	cv.checkForPossibilityOfDuplicateVectors(ctx, class, settings)
 
 	return nil
@@ -115,6 +120,39 @@ func (cv *ConfigValidator) validateIndexState(ctx context.Context,
 		"indexing.")
 }
 
+func (cv *ConfigValidator) validatePropertyWeights(class *models.Class,
+	cfg moduletools.ClassConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	for _, prop := range class.Properties {
+		weight, ok := cfg.Property(prop.Name)["weight"]
+		if !ok {
+			continue
+		}
+
+		var asFloat float64
+		switch asNumber := weight.(type) {
+		case float64:
+			asFloat = asNumber
+		case int:
+			asFloat = float64(asNumber)
+		default:
+			return errors.Errorf("property %q: weight must be a number, got %T",
+				prop.Name, weight)
+		}
+
+		if asFloat <= 0 {
+			return errors.Errorf("property %q: weight must be greater than 0, got %v. "+
+				"To exclude a property from vectorization use 'skip' instead",
+				prop.Name, asFloat)
+		}
+	}
+
+	return nil
+}
+
 func (cv *ConfigValidator) checkForPossibilityOfDuplicateVectors(
 	ctx context.Context, class *models.Class, settings ClassSettings) {
 	if !settings.VectorizeClassName() {
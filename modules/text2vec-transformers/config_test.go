@@ -36,6 +36,7 @@ func TestConfigDefaults(t *testing.T) {
 		def := New().PropertyConfigDefaults(&dt)
 		assert.Equal(t, false, def["vectorizePropertyName"])
 		assert.Equal(t, false, def["skip"])
+		assert.Equal(t, float64(1), def["weight"])
 	})
 }
 
@@ -73,6 +74,62 @@ func TestConfigValidator(t *testing.T) {
 	})
 }
 
+func TestConfigValidator_PropertyWeights(t *testing.T) {
+	class := &models.Class{
+		Class: "ValidName",
+		Properties: []*models.Property{
+			{
+				DataType: []string{"text"},
+				Name:     "title",
+			},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		weight      interface{}
+		expectedErr string
+	}{
+		{name: "valid weight", weight: float64(2)},
+		{name: "valid fractional weight", weight: 0.5},
+		{
+			name:   "weight of zero",
+			weight: float64(0),
+			expectedErr: "property \"title\": weight must be greater than 0, got 0. " +
+				"To exclude a property from vectorization use 'skip' instead",
+		},
+		{
+			name:        "weight of the wrong type",
+			weight:      "double",
+			expectedErr: "property \"title\": weight must be a number, got string",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			cfg := fakeClassConfig{
+				properties: map[string]map[string]interface{}{
+					"title": {"weight": test.weight},
+				},
+			}
+
+			logger, _ := ltest.NewNullLogger()
+			v := NewConfigValidator(logger)
+			err := v.Do(context.Background(), class, cfg, &fakeIndexChecker{
+				vectorizeClassName: true,
+				propertyIndexed:    true,
+			})
+
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedErr, err.Error())
+			}
+		})
+	}
+}
+
 func TestConfigValidator_RiskOfDuplicateVectors(t *testing.T) {
 	type test struct {
 		name          string
@@ -185,3 +242,15 @@ func (f *fakeIndexChecker) VectorizePropertyName(propName string) bool {
 func (f *fakeIndexChecker) PropertyIndexed(propName string) bool {
 	return f.propertyIndexed
 }
+
+type fakeClassConfig struct {
+	properties map[string]map[string]interface{}
+}
+
+func (f fakeClassConfig) Class() map[string]interface{} {
+	return map[string]interface{}{}
+}
+
+func (f fakeClassConfig) Property(propName string) map[string]interface{} {
+	return f.properties[propName]
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
 	"github.com/semi-technologies/weaviate/entities/moduletools"
 	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/clients"
+	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/neartext"
 	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/vectorizer"
 	"github.com/sirupsen/logrus"
 )
@@ -33,8 +34,8 @@ func New() *TransformersModule {
 type TransformersModule struct {
 	vectorizer      textVectorizer
 	metaProvider    metaProvider
-	graphqlProvider modulecapabilities.GraphQLArguments
-	searcher        modulecapabilities.Searcher
+	graphqlProvider *neartext.GraphQLArgumentsProvider
+	searcher        *neartext.Searcher
 	logger          logrus.FieldLogger
 }
 
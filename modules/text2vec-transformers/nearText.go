@@ -22,6 +22,20 @@ func (m *TransformersModule) initNearText() error {
 	return nil
 }
 
+// InitDependency picks up an optional nearText transformer provided by
+// another module, such as the autocorrection of text-spellcheck
+func (m *TransformersModule) InitDependency(modules []modulecapabilities.Module) error {
+	for _, module := range modules {
+		if arg, ok := module.(modulecapabilities.TextTransformers); ok {
+			if transformer, ok := arg.TextTransformers()["nearText"]; ok && transformer != nil {
+				m.graphqlProvider.SetNearTextTransformer(transformer)
+				m.searcher.SetNearTextTransformer(transformer)
+			}
+		}
+	}
+	return nil
+}
+
 func (m *TransformersModule) Arguments() map[string]modulecapabilities.GraphQLArgument {
 	return m.graphqlProvider.Arguments()
 }
@@ -33,4 +47,5 @@ func (m *TransformersModule) VectorSearches() map[string]modulecapabilities.Vect
 var (
 	_ = modulecapabilities.GraphQLArguments(New())
 	_ = modulecapabilities.Searcher(New())
+	_ = modulecapabilities.ModuleDependency(New())
 )
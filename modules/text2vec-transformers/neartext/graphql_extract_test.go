@@ -53,6 +53,19 @@ func Test_extractNearTextFn(t *testing.T) {
 				Network:   true,
 			},
 		},
+		{
+			"Extract with concepts and autocorrect",
+			args{
+				source: map[string]interface{}{
+					"concepts":    []interface{}{"c1", "c2", "c3"},
+					"autocorrect": true,
+				},
+			},
+			&NearTextParams{
+				Values:      []string{"c1", "c2", "c3"},
+				Autocorrect: true,
+			},
+		},
 		{
 			"Extract with moveTo and moveAwayFrom",
 			args{
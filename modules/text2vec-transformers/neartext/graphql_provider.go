@@ -15,12 +15,22 @@ import (
 	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
 )
 
-type GraphQLArgumentsProvider struct{}
+type GraphQLArgumentsProvider struct {
+	nearTextTransformer modulecapabilities.TextTransform
+}
 
 func New() *GraphQLArgumentsProvider {
 	return &GraphQLArgumentsProvider{}
 }
 
+// SetNearTextTransformer enables the autocorrect argument. It is set once the
+// module dependencies are resolved, which happens after the module was
+// initialized.
+func (g *GraphQLArgumentsProvider) SetNearTextTransformer(
+	nearTextTransformer modulecapabilities.TextTransform) {
+	g.nearTextTransformer = nearTextTransformer
+}
+
 func (g *GraphQLArgumentsProvider) Arguments() map[string]modulecapabilities.GraphQLArgument {
 	arguments := map[string]modulecapabilities.GraphQLArgument{}
 	arguments["nearText"] = g.getNearText()
@@ -29,9 +39,10 @@ func (g *GraphQLArgumentsProvider) Arguments() map[string]modulecapabilities.Gra
 
 func (g *GraphQLArgumentsProvider) getNearText() modulecapabilities.GraphQLArgument {
 	return modulecapabilities.GraphQLArgument{
-		GetArgumentsFunction:     getNearTextArgumentFn,
-		ExploreArgumentsFunction: exploreNearTextArgumentFn,
-		ExtractFunction:          extractNearTextFn,
-		ValidateFunction:         validateNearTextFn,
+		GetArgumentsFunction:       g.getNearTextArgumentFn,
+		AggregateArgumentsFunction: g.aggregateNearTextArgumentFn,
+		ExploreArgumentsFunction:   g.exploreNearTextArgumentFn,
+		ExtractFunction:            extractNearTextFn,
+		ValidateFunction:           validateNearTextFn,
 	}
 }
@@ -22,6 +22,7 @@ type NearTextParams struct {
 	MoveAwayFrom ExploreMove
 	Certainty    float64
 	Network      bool
+	Autocorrect  bool
 }
 
 func (n NearTextParams) GetCertainty() float64 {
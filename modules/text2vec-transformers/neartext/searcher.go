@@ -23,11 +23,20 @@ import (
 )
 
 type Searcher struct {
-	vectorizer vectorizer
+	vectorizer          vectorizer
+	nearTextTransformer modulecapabilities.TextTransform
 }
 
 func NewSearcher(vectorizer vectorizer) *Searcher {
-	return &Searcher{vectorizer}
+	return &Searcher{vectorizer: vectorizer}
+}
+
+// SetNearTextTransformer enables autocorrection of the nearText concepts. It
+// is set once the module dependencies are resolved, which happens after the
+// module was initialized.
+func (s *Searcher) SetNearTextTransformer(
+	nearTextTransformer modulecapabilities.TextTransform) {
+	s.nearTextTransformer = nearTextTransformer
 }
 
 type vectorizer interface {
@@ -57,7 +66,12 @@ func (s *Searcher) vectorFromNearTextParam(ctx context.Context,
 	// is to built to work with all defaults in the case of a nil-config, see
 	// vectorizer/class_settings_test.go for details.
 	settings := localvectorizer.NewClassSettings(cfg)
-	vector, err := s.vectorizer.Texts(ctx, params.Values, settings)
+	values, err := s.nearTextValues(ctx, params)
+	if err != nil {
+		return nil, errors.Errorf("autocorrect keywords: %v", err)
+	}
+
+	vector, err := s.vectorizer.Texts(ctx, values, settings)
 	if err != nil {
 		return nil, errors.Errorf("vectorize keywords: %v", err)
 	}
@@ -134,3 +148,11 @@ func (s *Searcher) vectorFromValuesAndObjects(ctx context.Context,
 
 	return s.vectorizer.CombineVectors(objectVectors), nil
 }
+
+func (s *Searcher) nearTextValues(ctx context.Context,
+	params *NearTextParams) ([]string, error) {
+	if params.Autocorrect && s.nearTextTransformer != nil {
+		return s.nearTextTransformer.Transform(ctx, params.Values)
+	}
+	return params.Values, nil
+}
@@ -20,6 +20,7 @@ const (
 	DefaultVectorizeClassName    = true
 	DefaultVectorizePropertyName = false
 	DefaultPoolingStrategy       = "masked_mean"
+	DefaultPropertyWeight        = 1
 )
 
 type classSettings struct {
@@ -67,6 +68,32 @@ func (ic *classSettings) VectorizePropertyName(propName string) bool {
 	return asBool
 }
 
+// PropertyWeight is the weight of the property in relation to the other
+// vectorized parts of the object, e.g. a weight of 2 means the property
+// counts double
+func (ic *classSettings) PropertyWeight(propName string) float32 {
+	if ic.cfg == nil {
+		// we would receive a nil-config on cross-class requests, such as Explore{}
+		return DefaultPropertyWeight
+	}
+
+	weight, ok := ic.cfg.Property(propName)["weight"]
+	if !ok {
+		return DefaultPropertyWeight
+	}
+
+	switch asNumber := weight.(type) {
+	case float64:
+		return float32(asNumber)
+	case float32:
+		return asNumber
+	case int:
+		return float32(asNumber)
+	default:
+		return DefaultPropertyWeight
+	}
+}
+
 func (ic *classSettings) VectorizeClassName() bool {
 	if ic.cfg == nil {
 		// we would receive a nil-config on cross-class requests, such as Explore{}
@@ -35,6 +35,7 @@ func TestClassSettings(t *testing.T) {
 		assert.False(t, ic.VectorizePropertyName("someProp"))
 		assert.True(t, ic.VectorizeClassName())
 		assert.Equal(t, ic.PoolingStrategy(), "masked_mean")
+		assert.Equal(t, float32(1), ic.PropertyWeight("someProp"))
 	})
 
 	t.Run("with a nil config", func(t *testing.T) {
@@ -48,6 +49,7 @@ func TestClassSettings(t *testing.T) {
 		assert.False(t, ic.VectorizePropertyName("someProp"))
 		assert.True(t, ic.VectorizeClassName())
 		assert.Equal(t, ic.PoolingStrategy(), "masked_mean")
+		assert.Equal(t, float32(1), ic.PropertyWeight("someProp"))
 	})
 
 	t.Run("with all explicit config matching the defaults", func(t *testing.T) {
@@ -65,6 +67,7 @@ func TestClassSettings(t *testing.T) {
 					"my-module": map[string]interface{}{
 						"skip":                  false,
 						"vectorizePropertyName": false,
+						"weight":                float64(1),
 					},
 				},
 			}},
@@ -77,6 +80,7 @@ func TestClassSettings(t *testing.T) {
 		assert.False(t, ic.VectorizePropertyName("someProp"))
 		assert.True(t, ic.VectorizeClassName())
 		assert.Equal(t, ic.PoolingStrategy(), "masked_mean")
+		assert.Equal(t, float32(1), ic.PropertyWeight("someProp"))
 	})
 
 	t.Run("with all explicit config using non-default values", func(t *testing.T) {
@@ -94,6 +98,7 @@ func TestClassSettings(t *testing.T) {
 					"my-module": map[string]interface{}{
 						"skip":                  true,
 						"vectorizePropertyName": true,
+						"weight":                float64(2),
 					},
 				},
 			}},
@@ -106,5 +111,6 @@ func TestClassSettings(t *testing.T) {
 		assert.True(t, ic.VectorizePropertyName("someProp"))
 		assert.False(t, ic.VectorizeClassName())
 		assert.Equal(t, ic.PoolingStrategy(), "cls")
+		assert.Equal(t, float32(2), ic.PropertyWeight("someProp"))
 	})
 }
@@ -20,12 +20,23 @@ import (
 type fakeClient struct {
 	lastInput  string
 	lastConfig ent.VectorizationConfig
+	vectors    map[string][]float32
+	inputs     []string
 }
 
 func (c *fakeClient) Vectorize(ctx context.Context,
 	text string, cfg ent.VectorizationConfig) (*ent.VectorizationResult, error) {
 	c.lastInput = text
 	c.lastConfig = cfg
+	c.inputs = append(c.inputs, text)
+	if vector, ok := c.vectors[text]; ok {
+		return &ent.VectorizationResult{
+			Vector:     vector,
+			Dimensions: len(vector),
+			Text:       text,
+		}, nil
+	}
+
 	return &ent.VectorizationResult{
 		Vector:     []float32{0, 1, 2, 3},
 		Dimensions: 4,
@@ -38,6 +49,7 @@ type fakeSettings struct {
 	vectorizeClassName bool
 	excludedProperty   string
 	poolingStrategy    string
+	weights            map[string]float32
 }
 
 func (f *fakeSettings) PropertyIndexed(propName string) bool {
@@ -52,6 +64,14 @@ func (f *fakeSettings) VectorizeClassName() bool {
 	return f.vectorizeClassName
 }
 
+func (f *fakeSettings) PropertyWeight(propName string) float32 {
+	if weight, ok := f.weights[propName]; ok {
+		return weight
+	}
+
+	return DefaultPropertyWeight
+}
+
 func (f *fakeSettings) PoolingStrategy() string {
 	return f.poolingStrategy
 }
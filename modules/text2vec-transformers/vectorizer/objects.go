@@ -20,6 +20,7 @@ import (
 	"github.com/fatih/camelcase"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/modules/text2vec-transformers/ent"
+	libvectorizer "github.com/semi-technologies/weaviate/usecases/vectorizer"
 )
 
 type Vectorizer struct {
@@ -42,6 +43,7 @@ type ClassSettings interface {
 	PropertyIndexed(property string) bool
 	VectorizeClassName() bool
 	VectorizePropertyName(propertyName string) bool
+	PropertyWeight(propertyName string) float32
 	PoolingStrategy() string
 }
 
@@ -68,9 +70,12 @@ func (v *Vectorizer) Object(ctx context.Context, object *models.Object,
 func (v *Vectorizer) object(ctx context.Context, className string,
 	schema interface{}, icheck ClassSettings) ([]float32, error) {
 	var corpi []string
+	var weights []float32
+	weighted := false
 
 	if icheck.VectorizeClassName() {
 		corpi = append(corpi, camelCaseToLower(className))
+		weights = append(weights, DefaultPropertyWeight)
 	}
 
 	if schema != nil {
@@ -89,6 +94,12 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 				} else {
 					corpi = append(corpi, strings.ToLower(valueString))
 				}
+
+				weight := icheck.PropertyWeight(prop)
+				weights = append(weights, weight)
+				if weight != DefaultPropertyWeight {
+					weighted = true
+				}
 			}
 		}
 	}
@@ -98,6 +109,10 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 		corpi = append(corpi, camelCaseToLower(className))
 	}
 
+	if weighted {
+		return v.weightedObject(ctx, corpi, weights, icheck)
+	}
+
 	text := strings.Join(corpi, " ")
 	res, err := v.client.Vectorize(ctx, text, ent.VectorizationConfig{
 		PoolingStrategy: icheck.PoolingStrategy(),
@@ -109,6 +124,27 @@ func (v *Vectorizer) object(ctx context.Context, className string,
 	return res.Vector, nil
 }
 
+// weightedObject vectorizes each part of the object on its own and combines
+// the individual vectors using their weights. This is only required if at
+// least one property has a non-default weight, otherwise all parts are
+// vectorized together in a single request.
+func (v *Vectorizer) weightedObject(ctx context.Context, corpi []string,
+	weights []float32, icheck ClassSettings) ([]float32, error) {
+	vectors := make([][]float32, len(corpi))
+	for i, text := range corpi {
+		res, err := v.client.Vectorize(ctx, text, ent.VectorizationConfig{
+			PoolingStrategy: icheck.PoolingStrategy(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		vectors[i] = res.Vector
+	}
+
+	return libvectorizer.CombineVectorsWithWeights(vectors, weights), nil
+}
+
 func camelCaseToLower(in string) string {
 	parts := camelcase.Split(in)
 	var sb strings.Builder
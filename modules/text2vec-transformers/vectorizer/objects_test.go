@@ -171,3 +171,55 @@ func TestVectorizingObjects(t *testing.T) {
 		})
 	}
 }
+
+func TestVectorizingObjectsWithPropertyWeights(t *testing.T) {
+	t.Run("with only default weights", func(t *testing.T) {
+		client := &fakeClient{}
+		v := New(client)
+		ic := &fakeSettings{
+			excludedProperty:   "review",
+			vectorizeClassName: true,
+			weights:            map[string]float32{"review": 1},
+		}
+
+		input := &models.Object{
+			Class: "Car",
+			Properties: map[string]interface{}{
+				"review": "a very great car",
+			},
+		}
+		err := v.Object(context.Background(), input, ic)
+		require.Nil(t, err)
+
+		// a single request with the entire corpus is made
+		assert.Equal(t, []string{"car a very great car"}, client.inputs)
+	})
+
+	t.Run("with the title counting double", func(t *testing.T) {
+		client := &fakeClient{
+			vectors: map[string][]float32{
+				"title great car":  {3, 0},
+				"review it drives": {0, 3},
+			},
+		}
+		v := New(client)
+		ic := &fakeSettings{
+			weights: map[string]float32{"title": 2},
+		}
+
+		input := &models.Object{
+			Class: "Car",
+			Properties: map[string]interface{}{
+				"title":  "great car",
+				"review": "it drives",
+			},
+		}
+		err := v.Object(context.Background(), input, ic)
+		require.Nil(t, err)
+
+		// each part is vectorized on its own
+		assert.ElementsMatch(t, []string{"title great car", "review it drives"},
+			client.inputs)
+		assert.Equal(t, models.C11yVector{2, 1}, input.Vector)
+	})
+}
@@ -18,6 +18,12 @@ fi
 if [[ "$*" == *--qna* ]]; then
   ADDITIONAL_SERVICES+=('qna-transformers')
 fi
+if [[ "$*" == *--sum* ]]; then
+  ADDITIONAL_SERVICES+=('sum-transformers')
+fi
+if [[ "$*" == *--spellcheck* ]]; then
+  ADDITIONAL_SERVICES+=('text-spellcheck')
+fi
 if [[ "$*" == *--image* ]]; then
   ADDITIONAL_SERVICES+=('i2v-neural')
 fi
@@ -0,0 +1,176 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	autherrs "github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	OutcomeSuccess   = "success"
+	OutcomeForbidden = "forbidden"
+	OutcomeFailure   = "failure"
+)
+
+// Record is a single entry in the audit log
+type Record struct {
+	Time      time.Time `json:"time"`
+	RequestID string    `json:"request_id,omitempty"`
+	Principal string    `json:"principal"`
+	Groups    []string  `json:"groups,omitempty"`
+	Verb      string    `json:"verb"`
+	Resource  string    `json:"resource"`
+	Outcome   string    `json:"outcome"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Logger writes one json encoded record per line for each mutation of the
+// schema or the data. A disabled logger does nothing, so it can always be
+// passed to the use cases.
+type Logger struct {
+	sync.Mutex
+	enabled    bool
+	out        io.Writer
+	operations map[string]struct{}
+	now        func() time.Time
+	logger     logrus.FieldLogger
+}
+
+// New audit logger based on the audit config. Records which can't be written
+// are reported to the server logger.
+func New(cfg Config, logger logrus.FieldLogger) (*Logger, error) {
+	l := &Logger{
+		enabled: cfg.Enabled,
+		now:     time.Now,
+		logger:  logger,
+	}
+
+	if !l.enabled {
+		return l, nil
+	}
+
+	if cfg.output() == OutputStdout {
+		l.out = os.Stdout
+	} else {
+		out, err := newRotatingFile(cfg.output(), cfg.maxSizeBytes(), cfg.maxBackups())
+		if err != nil {
+			return nil, err
+		}
+		l.out = out
+	}
+
+	if len(cfg.Operations) > 0 {
+		l.operations = map[string]struct{}{}
+		for _, operation := range cfg.Operations {
+			l.operations[operation] = struct{}{}
+		}
+	}
+
+	return l, nil
+}
+
+// NewWithWriter creates an enabled audit logger writing to out, which records
+// all operations
+func NewWithWriter(out io.Writer, logger logrus.FieldLogger) *Logger {
+	return &Logger{
+		enabled: true,
+		out:     out,
+		now:     time.Now,
+		logger:  logger,
+	}
+}
+
+// Log records the outcome of a mutation. The area of the operation is the
+// first segment of the resource, e.g. "schema" for "schema/Article".
+func (l *Logger) Log(ctx context.Context, principal *models.Principal,
+	verb, resource string, err error) {
+	if !l.enabled || !l.records(area(resource), verb) {
+		return
+	}
+
+	record := Record{
+		Time:      l.now().UTC(),
+		RequestID: RequestIDFromContext(ctx),
+		Principal: "anonymous",
+		Verb:      verb,
+		Resource:  resource,
+		Outcome:   outcome(err),
+	}
+
+	if principal != nil {
+		record.Principal = principal.Username
+		record.Groups = principal.Groups
+	}
+
+	if err != nil {
+		record.Error = err.Error()
+	}
+
+	line, marshalErr := json.Marshal(record)
+	if marshalErr != nil {
+		// a record only consists of strings and a timestamp, so this can't
+		// happen in practice
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+	if _, err := l.out.Write(append(line, '\n')); err != nil {
+		l.logger.WithField("action", "audit_log").
+			WithField("verb", verb).
+			WithField("resource", resource).
+			WithError(err).
+			Error("could not write audit record")
+	}
+}
+
+func (l *Logger) records(area, verb string) bool {
+	if l.operations == nil {
+		return true
+	}
+
+	for _, operation := range []string{
+		area + "." + verb, area + ".*", "*." + verb, "*.*", "*",
+	} {
+		if _, ok := l.operations[operation]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func area(resource string) string {
+	return strings.SplitN(resource, "/", 2)[0]
+}
+
+func outcome(err error) string {
+	if err == nil {
+		return OutcomeSuccess
+	}
+
+	if _, ok := err.(autherrs.Forbidden); ok {
+		return OutcomeForbidden
+	}
+
+	return OutcomeFailure
+}
@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	autherrs "github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogger(t *testing.T) {
+	now := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+	newLogger := func(operations ...string) (*Logger, *bytes.Buffer) {
+		buf := &bytes.Buffer{}
+		logger, _ := test.NewNullLogger()
+		l := NewWithWriter(buf, logger)
+		l.now = func() time.Time { return now }
+		if len(operations) > 0 {
+			l.operations = map[string]struct{}{}
+			for _, operation := range operations {
+				l.operations[operation] = struct{}{}
+			}
+		}
+		return l, buf
+	}
+
+	records := func(t *testing.T, buf *bytes.Buffer) []Record {
+		var out []Record
+		for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+			if line == "" {
+				continue
+			}
+			var r Record
+			require.Nil(t, json.Unmarshal([]byte(line), &r))
+			out = append(out, r)
+		}
+		return out
+	}
+
+	t.Run("records a successful mutation", func(t *testing.T) {
+		l, buf := newLogger()
+		ctx := ContextWithRequestID(context.Background(), "request-1")
+		principal := &models.Principal{Username: "alice", Groups: []string{"editors"}}
+
+		l.Log(ctx, principal, "create", "schema/Article", nil)
+
+		assert.Equal(t, []Record{
+			{
+				Time:      now,
+				RequestID: "request-1",
+				Principal: "alice",
+				Groups:    []string{"editors"},
+				Verb:      "create",
+				Resource:  "schema/Article",
+				Outcome:   OutcomeSuccess,
+			},
+		}, records(t, buf))
+	})
+
+	t.Run("records failed and forbidden mutations", func(t *testing.T) {
+		l, buf := newLogger()
+		principal := &models.Principal{Username: "bob"}
+
+		l.Log(context.Background(), nil, "delete", "objects/some-id", errors.New("not found"))
+		l.Log(context.Background(), principal, "delete", "schema/Article",
+			autherrs.NewForbidden(principal, "delete", "schema/objects"))
+
+		res := records(t, buf)
+		require.Len(t, res, 2)
+		assert.Equal(t, "anonymous", res[0].Principal)
+		assert.Equal(t, OutcomeFailure, res[0].Outcome)
+		assert.Equal(t, "not found", res[0].Error)
+		assert.Equal(t, "bob", res[1].Principal)
+		assert.Equal(t, OutcomeForbidden, res[1].Outcome)
+	})
+
+	t.Run("only records the configured operations", func(t *testing.T) {
+		l, buf := newLogger("schema.*", "objects.delete")
+
+		l.Log(context.Background(), nil, "create", "schema/Article", nil)
+		l.Log(context.Background(), nil, "create", "objects/some-id", nil)
+		l.Log(context.Background(), nil, "delete", "objects/some-id", nil)
+
+		res := records(t, buf)
+		require.Len(t, res, 2)
+		assert.Equal(t, "schema/Article", res[0].Resource)
+		assert.Equal(t, "delete", res[1].Verb)
+	})
+
+	t.Run("a disabled logger does not record anything", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		l, err := New(Config{}, logger)
+		require.Nil(t, err)
+
+		// would panic if it tried to write, as there is no output
+		l.Log(context.Background(), nil, "create", "schema/Article", nil)
+	})
+
+	t.Run("reports records which can't be written", func(t *testing.T) {
+		logger, hook := test.NewNullLogger()
+		l := NewWithWriter(failingWriter{}, logger)
+
+		l.Log(context.Background(), nil, "create", "schema/Article", nil)
+
+		require.Len(t, hook.AllEntries(), 1)
+		assert.Equal(t, logrus.ErrorLevel, hook.LastEntry().Level)
+		assert.Equal(t, "schema/Article", hook.LastEntry().Data["resource"])
+	})
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestAuditConfig(t *testing.T) {
+	t.Run("with valid operations", func(t *testing.T) {
+		cfg := Config{
+			Enabled:    true,
+			Operations: []string{"*", "schema.*", "*.delete", "objects.update"},
+		}
+
+		assert.Nil(t, cfg.Validate())
+	})
+
+	t.Run("with an operation without verb", func(t *testing.T) {
+		cfg := Config{Enabled: true, Operations: []string{"schema"}}
+
+		assert.EqualError(t, cfg.Validate(),
+			"audit: operation 'schema' must have the form <area>.<verb>")
+	})
+
+	t.Run("with an unknown area", func(t *testing.T) {
+		cfg := Config{Enabled: true, Operations: []string{"classifications.create"}}
+
+		assert.EqualError(t, cfg.Validate(),
+			"audit: operation 'classifications.create' has unknown area 'classifications'")
+	})
+
+	t.Run("with an unknown verb", func(t *testing.T) {
+		cfg := Config{Enabled: true, Operations: []string{"objects.get"}}
+
+		assert.EqualError(t, cfg.Validate(),
+			"audit: operation 'objects.get' has unknown verb 'get'")
+	})
+}
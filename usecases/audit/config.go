@@ -0,0 +1,103 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package audit
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// OutputStdout writes the audit records to stdout
+	OutputStdout = "stdout"
+
+	// DefaultMaxSizeMB is the size at which an audit log file is rotated if no
+	// size is configured
+	DefaultMaxSizeMB = 100
+
+	// DefaultMaxBackups is the number of rotated audit log files which are
+	// kept if no number is configured
+	DefaultMaxBackups = 5
+)
+
+// Config of the audit log. Output is either "stdout" or the path of a file
+// which is rotated once it reaches MaxSizeMB. Operations limits which
+// operations are recorded, an operation type is written as
+// "<area>.<verb>", e.g. "schema.create" or "objects.delete", "*" can be used
+// in place of the area or the verb. If no operations are set, all of them are
+// recorded.
+type Config struct {
+	Enabled    bool     `json:"enabled" yaml:"enabled"`
+	Output     string   `json:"output" yaml:"output"`
+	MaxSizeMB  int      `json:"max_size_mb" yaml:"max_size_mb"`
+	MaxBackups int      `json:"max_backups" yaml:"max_backups"`
+	Operations []string `json:"operations" yaml:"operations"`
+}
+
+var (
+	areas = map[string]struct{}{"schema": {}, "objects": {}, "*": {}}
+	verbs = map[string]struct{}{"create": {}, "update": {}, "delete": {}, "*": {}}
+)
+
+// Validate audit config for viability, can be called from the central config
+// package
+func (c Config) Validate() error {
+	if c.MaxSizeMB < 0 {
+		return fmt.Errorf("audit: max_size_mb must not be negative, got %d", c.MaxSizeMB)
+	}
+
+	if c.MaxBackups < 0 {
+		return fmt.Errorf("audit: max_backups must not be negative, got %d", c.MaxBackups)
+	}
+
+	for _, operation := range c.Operations {
+		if operation == "*" {
+			continue
+		}
+
+		parts := strings.Split(operation, ".")
+		if len(parts) != 2 {
+			return fmt.Errorf("audit: operation '%s' must have the form <area>.<verb>", operation)
+		}
+
+		if _, ok := areas[parts[0]]; !ok {
+			return fmt.Errorf("audit: operation '%s' has unknown area '%s'", operation, parts[0])
+		}
+
+		if _, ok := verbs[parts[1]]; !ok {
+			return fmt.Errorf("audit: operation '%s' has unknown verb '%s'", operation, parts[1])
+		}
+	}
+
+	return nil
+}
+
+func (c Config) output() string {
+	if c.Output == "" {
+		return OutputStdout
+	}
+	return c.Output
+}
+
+func (c Config) maxSizeBytes() int64 {
+	if c.MaxSizeMB == 0 {
+		return DefaultMaxSizeMB * 1024 * 1024
+	}
+	return int64(c.MaxSizeMB) * 1024 * 1024
+}
+
+func (c Config) maxBackups() int {
+	if c.MaxBackups == 0 {
+		return DefaultMaxBackups
+	}
+	return c.MaxBackups
+}
@@ -0,0 +1,114 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package audit
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// rotatingFile appends to the file at path. Once the file would grow beyond
+// maxSize, it is moved to path.1, while path.1 is moved to path.2 and so on.
+// At most maxBackups of these rotated files are kept.
+type rotatingFile struct {
+	sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func newRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	r := &rotatingFile{
+		path:       path,
+		maxSize:    maxSize,
+		maxBackups: maxBackups,
+	}
+
+	if err := r.open(); err != nil {
+		return nil, err
+	}
+
+	return r, nil
+}
+
+func (r *rotatingFile) open() error {
+	f, err := os.OpenFile(r.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return errors.Wrapf(err, "open audit log %s", r.path)
+	}
+
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return errors.Wrapf(err, "stat audit log %s", r.path)
+	}
+
+	r.file = f
+	r.size = info.Size()
+	return nil
+}
+
+func (r *rotatingFile) Write(p []byte) (int, error) {
+	r.Lock()
+	defer r.Unlock()
+
+	// a failed rotation leaves the current file in place, so the record is
+	// still written and only the rotation error is reported
+	var rotateErr error
+	if r.size > 0 && r.size+int64(len(p)) > r.maxSize {
+		rotateErr = r.rotate()
+	}
+
+	n, err := r.file.Write(p)
+	r.size += int64(n)
+	if err != nil {
+		return n, err
+	}
+
+	return n, rotateErr
+}
+
+// rotate only replaces the current file once the new one could be opened.
+// Until then writes keep going to the old file, even if it has already been
+// moved.
+func (r *rotatingFile) rotate() error {
+	for i := r.maxBackups - 1; i > 0; i-- {
+		from := fmt.Sprintf("%s.%d", r.path, i)
+		if _, err := os.Stat(from); err != nil {
+			continue
+		}
+
+		if err := os.Rename(from, fmt.Sprintf("%s.%d", r.path, i+1)); err != nil {
+			return errors.Wrapf(err, "rotate audit log %s", from)
+		}
+	}
+
+	if err := os.Rename(r.path, r.path+".1"); err != nil {
+		return errors.Wrapf(err, "rotate audit log %s", r.path)
+	}
+
+	old := r.file
+	if err := r.open(); err != nil {
+		return err
+	}
+
+	if err := old.Close(); err != nil {
+		return errors.Wrapf(err, "close rotated audit log %s", r.path+".1")
+	}
+
+	return nil
+}
@@ -0,0 +1,83 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package audit
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotatingFile(t *testing.T) {
+	dir := t.TempDir()
+	filePath := path.Join(dir, "audit.log")
+
+	f, err := newRotatingFile(filePath, 10, 2)
+	require.Nil(t, err)
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		_, err := f.Write([]byte(line))
+		require.Nil(t, err)
+	}
+
+	contents := func(p string) string {
+		b, err := ioutil.ReadFile(p)
+		require.Nil(t, err)
+		return string(b)
+	}
+
+	assert.Equal(t, "fourth\n", contents(filePath))
+	assert.Equal(t, "third\n", contents(filePath+".1"))
+	assert.Equal(t, "second\n", contents(filePath+".2"))
+	assert.NoFileExists(t, filePath+".3", "only max backups are kept")
+
+	t.Run("appends to an existing file on restart", func(t *testing.T) {
+		f, err := newRotatingFile(filePath, 100, 2)
+		require.Nil(t, err)
+
+		_, err = f.Write([]byte("fifth\n"))
+		require.Nil(t, err)
+
+		assert.Equal(t, "fourth\nfifth\n", contents(filePath))
+	})
+}
+
+func TestRotatingFile_FailedRotation(t *testing.T) {
+	dir := t.TempDir()
+	filePath := path.Join(dir, "audit.log")
+
+	// a non-empty directory in place of the first backup makes the rotation fail
+	require.Nil(t, os.Mkdir(filePath+".1", 0o700))
+	require.Nil(t, ioutil.WriteFile(path.Join(filePath+".1", "blocker"), nil, 0o600))
+
+	f, err := newRotatingFile(filePath, 10, 1)
+	require.Nil(t, err)
+
+	_, err = f.Write([]byte("first\n"))
+	require.Nil(t, err)
+
+	n, err := f.Write([]byte("second\n"))
+	assert.NotNil(t, err, "the rotation error is reported")
+	assert.Equal(t, len("second\n"), n)
+
+	_, err = f.Write([]byte("third\n"))
+	assert.NotNil(t, err)
+
+	b, err := ioutil.ReadFile(filePath)
+	require.Nil(t, err)
+	assert.Equal(t, "first\nsecond\nthird\n", string(b),
+		"records are still written to the old file")
+}
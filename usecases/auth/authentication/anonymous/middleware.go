@@ -42,7 +42,8 @@ func (c *Client) Middleware(next http.Handler) http.Handler {
 
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if hasBearerAuth(r) {
-			// if an OIDC-Header is present we can be sure that the OIDC
+			// if an OIDC-Header (or an api key, which is passed the same way) is
+			// present we can be sure that the OIDC or api key
 			// Authenticator has already validated the token, so we don't have to do
 			// anything and cann call the next handler.
 			next.ServeHTTP(w, r)
@@ -51,7 +52,7 @@ func (c *Client) Middleware(next http.Handler) http.Handler {
 
 		w.WriteHeader(401)
 		w.Write([]byte(
-			`{"code":401,"message":"anonymous access not enabled, please provide an auth scheme such as OIDC"}`,
+			`{"code":401,"message":"anonymous access not enabled, please provide an auth scheme such as OIDC or an api key"}`,
 		))
 	})
 }
@@ -0,0 +1,123 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package apikey
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+
+	errors "github.com/go-openapi/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// Client validates api keys presented as bearer tokens and maps them to the
+// configured principal
+type Client struct {
+	config     config.APIKey
+	keyDigests [][sha256.Size]byte
+}
+
+// New api key client. If api key authentication is not enabled, the client
+// is still valid, but will deny all keys.
+func New(cfg config.Config) (*Client, error) {
+	client := &Client{
+		config: cfg.Authentication.APIKey,
+	}
+
+	if !client.config.Enabled {
+		return client, nil
+	}
+
+	if err := client.validateConfig(); err != nil {
+		return nil, fmt.Errorf("invalid apikey config: %v", err)
+	}
+
+	client.keyDigests = make([][sha256.Size]byte, len(client.config.AllowedKeys))
+	for i, key := range client.config.AllowedKeys {
+		client.keyDigests[i] = sha256.Sum256([]byte(key))
+	}
+
+	return client, nil
+}
+
+func (c *Client) validateConfig() error {
+	if len(c.config.AllowedKeys) == 0 {
+		return fmt.Errorf("need at least one valid allowed key")
+	}
+
+	for i, key := range c.config.AllowedKeys {
+		if key == "" {
+			return fmt.Errorf("allowed key at position %d is empty", i)
+		}
+	}
+
+	if len(c.config.Users) == 0 {
+		return fmt.Errorf("need at least one user")
+	}
+
+	for i, user := range c.config.Users {
+		if user == "" {
+			return fmt.Errorf("user at position %d is empty", i)
+		}
+	}
+
+	if len(c.config.Users) > 1 && len(c.config.Users) != len(c.config.AllowedKeys) {
+		return fmt.Errorf("the number of users (%d) must be 1 or match the number "+
+			"of allowed keys (%d)", len(c.config.Users), len(c.config.AllowedKeys))
+	}
+
+	return nil
+}
+
+// Enabled returns whether api key authentication is configured
+func (c *Client) Enabled() bool {
+	return c.config.Enabled
+}
+
+// ValidateAndExtract can be used as a middleware for go-swagger
+func (c *Client) ValidateAndExtract(token string, scopes []string) (*models.Principal, error) {
+	if !c.config.Enabled {
+		return nil, errors.New(401, "apikey auth is not configured, please try another auth scheme or set up weaviate with apikey configured")
+	}
+
+	pos, ok := c.keyPosition(token)
+	if !ok {
+		return nil, errors.New(401, "invalid api key")
+	}
+
+	username := c.config.Users[0]
+	if len(c.config.Users) > 1 {
+		username = c.config.Users[pos]
+	}
+
+	return &models.Principal{
+		Username: username,
+		Groups:   c.config.Groups[username],
+	}, nil
+}
+
+// keyPosition compares the digests of the keys, so that every comparison
+// takes the same time regardless of how much of the token matches a key
+func (c *Client) keyPosition(token string) (int, bool) {
+	digest := sha256.Sum256([]byte(token))
+
+	pos, found := 0, false
+	for i := range c.keyDigests {
+		if subtle.ConstantTimeCompare(digest[:], c.keyDigests[i][:]) == 1 && !found {
+			pos, found = i, true
+		}
+	}
+
+	return pos, found
+}
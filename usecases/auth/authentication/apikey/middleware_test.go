@@ -0,0 +1,134 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package apikey
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_APIKeyClient(t *testing.T) {
+	configWith := func(apiKey config.APIKey) config.Config {
+		return config.Config{
+			Authentication: config.Authentication{
+				APIKey: apiKey,
+			},
+		}
+	}
+
+	t.Run("when apikey is disabled", func(t *testing.T) {
+		client, err := New(configWith(config.APIKey{}))
+		require.Nil(t, err)
+
+		_, err = client.ValidateAndExtract("some-key", nil)
+		assert.NotNil(t, err)
+		assert.False(t, client.Enabled())
+	})
+
+	t.Run("with invalid configs", func(t *testing.T) {
+		tests := []struct {
+			name        string
+			cfg         config.APIKey
+			expectedErr error
+		}{
+			{
+				name:        "without keys",
+				cfg:         config.APIKey{Enabled: true, Users: []string{"importer"}},
+				expectedErr: fmt.Errorf("invalid apikey config: need at least one valid allowed key"),
+			},
+			{
+				name: "with an empty key",
+				cfg: config.APIKey{
+					Enabled:     true,
+					AllowedKeys: []string{"key1", ""},
+					Users:       []string{"importer"},
+				},
+				expectedErr: fmt.Errorf("invalid apikey config: allowed key at position 1 is empty"),
+			},
+			{
+				name:        "without users",
+				cfg:         config.APIKey{Enabled: true, AllowedKeys: []string{"key1"}},
+				expectedErr: fmt.Errorf("invalid apikey config: need at least one user"),
+			},
+			{
+				name: "with a mismatch of keys and users",
+				cfg: config.APIKey{
+					Enabled:     true,
+					AllowedKeys: []string{"key1", "key2", "key3"},
+					Users:       []string{"importer", "service"},
+				},
+				expectedErr: fmt.Errorf("invalid apikey config: the number of users (2) must be 1 " +
+					"or match the number of allowed keys (3)"),
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := New(configWith(test.cfg))
+				assert.Equal(t, test.expectedErr, err)
+			})
+		}
+	})
+
+	t.Run("with a single user for all keys", func(t *testing.T) {
+		client, err := New(configWith(config.APIKey{
+			Enabled:     true,
+			AllowedKeys: []string{"key1", "key2"},
+			Users:       []string{"importer"},
+			Groups:      map[string][]string{"importer": {"writers"}},
+		}))
+		require.Nil(t, err)
+
+		principal, err := client.ValidateAndExtract("key2", nil)
+		require.Nil(t, err)
+		assert.Equal(t, &models.Principal{
+			Username: "importer",
+			Groups:   []string{"writers"},
+		}, principal)
+	})
+
+	t.Run("with a user per key", func(t *testing.T) {
+		client, err := New(configWith(config.APIKey{
+			Enabled:     true,
+			AllowedKeys: []string{"key1", "key2"},
+			Users:       []string{"importer", "service"},
+		}))
+		require.Nil(t, err)
+
+		principal, err := client.ValidateAndExtract("key1", nil)
+		require.Nil(t, err)
+		assert.Equal(t, "importer", principal.Username)
+
+		principal, err = client.ValidateAndExtract("key2", nil)
+		require.Nil(t, err)
+		assert.Equal(t, "service", principal.Username)
+		assert.Nil(t, principal.Groups)
+	})
+
+	t.Run("with an unknown key", func(t *testing.T) {
+		client, err := New(configWith(config.APIKey{
+			Enabled:     true,
+			AllowedKeys: []string{"key1"},
+			Users:       []string{"importer"},
+		}))
+		require.Nil(t, err)
+
+		_, err = client.ValidateAndExtract("key", nil)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid api key")
+	})
+}
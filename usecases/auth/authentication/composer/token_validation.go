@@ -0,0 +1,48 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package composer
+
+import (
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+)
+
+// TokenFunc validates a bearer token and extracts the principal, it matches
+// the signature go-swagger expects for the oidc security definition
+type TokenFunc func(token string, scopes []string) (*models.Principal, error)
+
+type tokenValidator interface {
+	ValidateAndExtract(token string, scopes []string) (*models.Principal, error)
+}
+
+// New composes api key and oidc validation. Both are presented as bearer
+// tokens, so if both are enabled, a token which is not a valid api key is
+// validated as an oidc token.
+func New(cfg config.Authentication, apikey, oidc tokenValidator) TokenFunc {
+	if cfg.APIKey.Enabled && cfg.OIDC.Enabled {
+		return func(token string, scopes []string) (*models.Principal, error) {
+			if principal, err := apikey.ValidateAndExtract(token, scopes); err == nil {
+				return principal, nil
+			}
+
+			return oidc.ValidateAndExtract(token, scopes)
+		}
+	}
+
+	if cfg.APIKey.Enabled {
+		return apikey.ValidateAndExtract
+	}
+
+	// oidc also needs to be used if it is disabled, as it produces a helpful
+	// error message when a token is presented
+	return oidc.ValidateAndExtract
+}
@@ -0,0 +1,81 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package composer
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TokenValidation(t *testing.T) {
+	apikey := &fakeValidator{validToken: "apikey", username: "importer"}
+	oidc := &fakeValidator{validToken: "oidc-token", username: "alice"}
+
+	t.Run("with only oidc enabled", func(t *testing.T) {
+		fn := New(config.Authentication{OIDC: config.OIDC{Enabled: true}}, apikey, oidc)
+
+		principal, err := fn("oidc-token", nil)
+		require.Nil(t, err)
+		assert.Equal(t, "alice", principal.Username)
+
+		_, err = fn("apikey", nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with only apikey enabled", func(t *testing.T) {
+		fn := New(config.Authentication{APIKey: config.APIKey{Enabled: true}}, apikey, oidc)
+
+		principal, err := fn("apikey", nil)
+		require.Nil(t, err)
+		assert.Equal(t, "importer", principal.Username)
+
+		_, err = fn("oidc-token", nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("with apikey and oidc enabled", func(t *testing.T) {
+		fn := New(config.Authentication{
+			APIKey: config.APIKey{Enabled: true},
+			OIDC:   config.OIDC{Enabled: true},
+		}, apikey, oidc)
+
+		principal, err := fn("apikey", nil)
+		require.Nil(t, err)
+		assert.Equal(t, "importer", principal.Username)
+
+		principal, err = fn("oidc-token", nil)
+		require.Nil(t, err)
+		assert.Equal(t, "alice", principal.Username)
+
+		_, err = fn("something-else", nil)
+		assert.Equal(t, errors.New("invalid token for alice"), err)
+	})
+}
+
+type fakeValidator struct {
+	validToken string
+	username   string
+}
+
+func (v *fakeValidator) ValidateAndExtract(token string,
+	scopes []string) (*models.Principal, error) {
+	if token != v.validToken {
+		return nil, errors.New("invalid token for " + v.username)
+	}
+
+	return &models.Principal{Username: v.username}, nil
+}
@@ -15,6 +15,7 @@ import (
 	"testing"
 
 	"github.com/semi-technologies/weaviate/usecases/auth/authorization/adminlist"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/rbac"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/stretchr/testify/assert"
 )
@@ -50,4 +51,19 @@ func Test_Authorizer(t *testing.T) {
 		_, ok := authorizer.(*adminlist.Authorizer)
 		assert.Equal(t, true, ok)
 	})
+
+	t.Run("when rbac is configured", func(t *testing.T) {
+		cfg := config.Config{
+			Authorization: config.Authorization{
+				RBAC: rbac.Config{
+					Enabled: true,
+				},
+			},
+		}
+
+		authorizer := New(cfg)
+
+		_, ok := authorizer.(*rbac.Authorizer)
+		assert.Equal(t, true, ok)
+	})
 }
@@ -0,0 +1,143 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+)
+
+const AnonymousPrincipalUsername = "anonymous"
+
+// verbs contains all verbs a permission can grant. The shorthands are
+// expanded to the verbs used in the individual authorization calls
+var verbs = map[string][]string{
+	"get":      {"get"},
+	"list":     {"list"},
+	"validate": {"validate"},
+	"create":   {"create"},
+	"update":   {"update"},
+	"delete":   {"delete"},
+	"read":     {"get", "list", "validate"},
+	"write":    {"create", "update", "delete"},
+	"*":        {"get", "list", "validate", "create", "update", "delete"},
+}
+
+type grant struct {
+	verbs     map[string]struct{}
+	resources []string
+}
+
+// Authorizer grants access based on the roles of the user and the roles of
+// all groups the user is part of
+type Authorizer struct {
+	roles  map[string][]grant
+	groups map[string][]string
+	users  map[string][]string
+}
+
+// New Authorizer using role-based access control
+func New(cfg Config) *Authorizer {
+	a := &Authorizer{
+		roles:  map[string][]grant{},
+		groups: cfg.Groups,
+		users:  cfg.Users,
+	}
+
+	for name, permissions := range cfg.Roles {
+		for _, permission := range permissions {
+			g := grant{
+				verbs:     map[string]struct{}{},
+				resources: permission.Resources,
+			}
+			for _, verb := range permission.Verbs {
+				for _, expanded := range verbs[verb] {
+					g.verbs[expanded] = struct{}{}
+				}
+			}
+			a.roles[name] = append(a.roles[name], g)
+		}
+	}
+
+	return a
+}
+
+// Authorize allows the request if any of the roles of the principal grants
+// the verb on a resource pattern matching the resource
+func (a *Authorizer) Authorize(principal *models.Principal, verb, resource string) error {
+	if principal == nil {
+		principal = newAnonymousPrincipal()
+	}
+
+	for _, role := range a.rolesOf(principal) {
+		for _, g := range a.roles[role] {
+			if _, ok := g.verbs[verb]; !ok {
+				continue
+			}
+
+			for _, pattern := range g.resources {
+				if matchPattern(pattern, resource) {
+					return nil
+				}
+			}
+		}
+	}
+
+	return errors.NewForbidden(principal, verb, resource)
+}
+
+func (a *Authorizer) rolesOf(principal *models.Principal) []string {
+	roles := append([]string{}, a.users[principal.Username]...)
+	for _, group := range principal.Groups {
+		roles = append(roles, a.groups[group]...)
+	}
+
+	return roles
+}
+
+// matchPattern reports whether resource matches pattern, where each "*" in
+// the pattern matches any (possibly empty) sequence of characters, including
+// "/"
+func matchPattern(pattern, resource string) bool {
+	// star and match mark the position of the last "*" in the pattern and the
+	// position in the resource it was tried against, so we can backtrack and
+	// let the "*" consume one more character on a mismatch
+	p, r := 0, 0
+	star, match := -1, 0
+	for r < len(resource) {
+		switch {
+		case p < len(pattern) && pattern[p] == '*':
+			star, match = p, r
+			p++
+		case p < len(pattern) && pattern[p] == resource[r]:
+			p++
+			r++
+		case star >= 0:
+			match++
+			p, r = star+1, match
+		default:
+			return false
+		}
+	}
+
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+
+	return p == len(pattern)
+}
+
+func newAnonymousPrincipal() *models.Principal {
+	return &models.Principal{
+		Username: AnonymousPrincipalUsername,
+	}
+}
@@ -0,0 +1,194 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RBAC_Authorizer(t *testing.T) {
+	cfg := Config{
+		Enabled: true,
+		Roles: map[string][]Permission{
+			"articleReader": {
+				{
+					Verbs:     []string{"read"},
+					Resources: []string{"traversal/Article*", "objects/Article/*"},
+				},
+			},
+			"logWriter": {
+				{
+					Verbs:     []string{"write"},
+					Resources: []string{"objects/Log", "objects/Log/*", "batch/objects"},
+				},
+				{
+					Verbs:     []string{"get"},
+					Resources: []string{"traversal/Log"},
+				},
+			},
+			"schemaAdmin": {
+				{
+					Verbs:     []string{"*"},
+					Resources: []string{"schema/*"},
+				},
+			},
+			"logSchemaEditor": {
+				{
+					Verbs:     []string{"write"},
+					Resources: []string{"schema/Log"},
+				},
+			},
+		},
+		Groups: map[string][]string{
+			"editors": {"articleReader"},
+			"loggers": {"logWriter", "logSchemaEditor"},
+		},
+		Users: map[string][]string{
+			"alice":     {"schemaAdmin"},
+			"anonymous": {"articleReader"},
+		},
+	}
+
+	authorizer := New(cfg)
+
+	t.Run("a role of the user grants the request", func(t *testing.T) {
+		principal := &models.Principal{Username: "alice"}
+
+		err := authorizer.Authorize(principal, "update", "schema/Article")
+		assert.Nil(t, err)
+	})
+
+	t.Run("a role of a group of the user grants the request", func(t *testing.T) {
+		principal := &models.Principal{
+			Username: "johndoe",
+			Groups:   []string{"editors"},
+		}
+
+		err := authorizer.Authorize(principal, "get", "traversal/ArticleAuthor")
+		assert.Nil(t, err)
+	})
+
+	t.Run("the verb is not granted on a matching resource", func(t *testing.T) {
+		principal := &models.Principal{
+			Username: "johndoe",
+			Groups:   []string{"editors"},
+		}
+
+		err := authorizer.Authorize(principal, "create", "traversal/Article")
+		assert.Equal(t, errors.NewForbidden(principal, "create", "traversal/Article"), err)
+	})
+
+	t.Run("the verb is granted, but the resource does not match", func(t *testing.T) {
+		principal := &models.Principal{
+			Username: "johndoe",
+			Groups:   []string{"loggers"},
+		}
+
+		err := authorizer.Authorize(principal, "get", "traversal/Article")
+		assert.Equal(t, errors.NewForbidden(principal, "get", "traversal/Article"), err)
+	})
+
+	t.Run("permissions of multiple groups are combined", func(t *testing.T) {
+		principal := &models.Principal{
+			Username: "johndoe",
+			Groups:   []string{"editors", "loggers"},
+		}
+
+		assert.Nil(t, authorizer.Authorize(principal, "get", "traversal/Article"))
+		assert.Nil(t, authorizer.Authorize(principal, "get", "traversal/Log"))
+		assert.Nil(t, authorizer.Authorize(principal, "delete",
+			"objects/Log/a0f2a0f6-3bb9-4c6b-bc1b-50f0b4a8c6b0"))
+	})
+
+	t.Run("object permissions are granted per class", func(t *testing.T) {
+		principal := &models.Principal{
+			Username: "johndoe",
+			Groups:   []string{"editors", "loggers"},
+		}
+
+		id := "a0f2a0f6-3bb9-4c6b-bc1b-50f0b4a8c6b0"
+		assert.Nil(t, authorizer.Authorize(principal, "get", "objects/Article/"+id))
+		assert.Nil(t, authorizer.Authorize(principal, "create", "objects/Log"))
+		assert.Nil(t, authorizer.Authorize(principal, "update", "objects/Log/"+id))
+
+		err := authorizer.Authorize(principal, "update", "objects/Article/"+id)
+		assert.Equal(t, errors.NewForbidden(principal, "update", "objects/Article/"+id), err)
+
+		err = authorizer.Authorize(principal, "create", "objects/Article")
+		assert.Equal(t, errors.NewForbidden(principal, "create", "objects/Article"), err)
+
+		err = authorizer.Authorize(principal, "list", "objects")
+		assert.Equal(t, errors.NewForbidden(principal, "list", "objects"), err)
+	})
+
+	t.Run("schema permissions are granted per class", func(t *testing.T) {
+		principal := &models.Principal{
+			Username: "johndoe",
+			Groups:   []string{"loggers"},
+		}
+
+		assert.Nil(t, authorizer.Authorize(principal, "update", "schema/Log"))
+		assert.Nil(t, authorizer.Authorize(principal, "delete", "schema/Log"))
+
+		err := authorizer.Authorize(principal, "update", "schema/Article")
+		assert.Equal(t, errors.NewForbidden(principal, "update", "schema/Article"), err)
+
+		err = authorizer.Authorize(principal, "list", "schema/*")
+		assert.Equal(t, errors.NewForbidden(principal, "list", "schema/*"), err)
+	})
+
+	t.Run("a user without roles is denied", func(t *testing.T) {
+		principal := &models.Principal{Username: "johndoe"}
+
+		err := authorizer.Authorize(principal, "get", "traversal/Article")
+		assert.Equal(t, errors.NewForbidden(principal, "get", "traversal/Article"), err)
+	})
+
+	t.Run("a nil principal gets the roles of the anonymous user", func(t *testing.T) {
+		assert.Nil(t, authorizer.Authorize(nil, "get", "traversal/Article"))
+
+		err := authorizer.Authorize(nil, "create", "objects")
+		assert.Equal(t, errors.NewForbidden(newAnonymousPrincipal(), "create", "objects"), err)
+	})
+}
+
+func Test_RBAC_MatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		resource string
+		matches  bool
+	}{
+		{pattern: "schema/objects", resource: "schema/objects", matches: true},
+		{pattern: "schema/objects", resource: "schema/objectsX", matches: false},
+		{pattern: "schema/*", resource: "schema/objects", matches: true},
+		{pattern: "schema/*", resource: "schema/*", matches: true},
+		{pattern: "*", resource: "objects/some-id", matches: true},
+		{pattern: "traversal/Article*", resource: "traversal/Article", matches: true},
+		{pattern: "traversal/Article*", resource: "traversal/ArticleAuthor", matches: true},
+		{pattern: "traversal/Article*", resource: "traversal/Author", matches: false},
+		{pattern: "*/Log", resource: "traversal/Log", matches: true},
+		{pattern: "*/Log", resource: "traversal/Logs", matches: false},
+		{pattern: "a*b*c", resource: "abbbc", matches: true},
+		{pattern: "a*b*c", resource: "abcb", matches: false},
+		{pattern: "", resource: "objects", matches: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.pattern+" "+test.resource, func(t *testing.T) {
+			assert.Equal(t, test.matches, matchPattern(test.pattern, test.resource))
+		})
+	}
+}
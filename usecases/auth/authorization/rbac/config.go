@@ -0,0 +1,173 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Config maps users and groups to roles. Each role grants a set of verbs on
+// resources matching a pattern. Everything which is not granted explicitly
+// by one of the roles of a subject is denied.
+type Config struct {
+	Enabled bool `json:"enabled" yaml:"enabled"`
+	// Roles by name
+	Roles map[string][]Permission `json:"roles" yaml:"roles"`
+	// Groups maps a group, for example parsed from the OIDC groups claim, to
+	// the names of its roles
+	Groups map[string][]string `json:"groups" yaml:"groups"`
+	// Users maps a username to the names of its roles
+	Users map[string][]string `json:"users" yaml:"users"`
+}
+
+// Permission grants all of Verbs on all resources matching one of
+// Resources. A verb is either one of the verbs used in the authorization
+// calls (e.g. "get", "create") or one of the shorthands "read", "write" or
+// "*". A resource pattern may contain the wildcard "*" which matches any
+// sequence of characters, e.g. "traversal/Article*" or "objects/*". Objects
+// and schema classes are addressed per class, as "objects/<Class>/<id>" and
+// "schema/<Class>", so "objects/Article/*" grants access to all objects of the
+// class Article.
+type Permission struct {
+	Verbs     []string `json:"verbs" yaml:"verbs"`
+	Resources []string `json:"resources" yaml:"resources"`
+}
+
+// Validate rbac config for viability, can be called from the central config
+// package
+func (c Config) Validate() error {
+	for name, permissions := range c.Roles {
+		if err := validatePermissions(permissions); err != nil {
+			return fmt.Errorf("rbac: role '%s': %v", name, err)
+		}
+	}
+
+	if err := c.validateRoleReferences("group", c.Groups); err != nil {
+		return err
+	}
+
+	return c.validateRoleReferences("user", c.Users)
+}
+
+func validatePermissions(permissions []Permission) error {
+	for _, permission := range permissions {
+		if len(permission.Verbs) == 0 {
+			return fmt.Errorf("permission has no verbs")
+		}
+
+		for _, verb := range permission.Verbs {
+			if _, ok := verbs[verb]; !ok {
+				return fmt.Errorf("unknown verb '%s'", verb)
+			}
+		}
+
+		if len(permission.Resources) == 0 {
+			return fmt.Errorf("permission has no resources")
+		}
+
+		for _, resource := range permission.Resources {
+			if resource == "" {
+				return fmt.Errorf("resource pattern must not be empty")
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c Config) validateRoleReferences(subjectType string,
+	subjects map[string][]string) error {
+	for subject, roles := range subjects {
+		for _, role := range roles {
+			if _, ok := c.Roles[role]; !ok {
+				return fmt.Errorf("rbac: %s '%s' references undefined role '%s'",
+					subjectType, subject, role)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ParseRoles parses roles in the format used by the environment variables,
+// i.e. "<role>=<verb>:<pattern>[,<verb>:<pattern>...][;<role>=...]", for
+// example "reader=read:traversal/*,read:objects/*;logger=write:objects/Log/*"
+func ParseRoles(in string) (map[string][]Permission, error) {
+	roles := map[string][]Permission{}
+	err := parseAssignments(in, func(role string, values []string) error {
+		for _, value := range values {
+			parts := strings.SplitN(value, ":", 2)
+			if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+				return fmt.Errorf("role '%s': permission '%s' must have the form "+
+					"<verb>:<pattern>", role, value)
+			}
+
+			roles[role] = append(roles[role], Permission{
+				Verbs:     []string{parts[0]},
+				Resources: []string{parts[1]},
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return roles, nil
+}
+
+// ParseRoleAssignments parses the assignment of roles to users or groups in
+// the format used by the environment variables, i.e.
+// "<subject>=<role>[,<role>...][;<subject>=...]", for example
+// "editors=reader,logger;viewers=reader"
+func ParseRoleAssignments(in string) (map[string][]string, error) {
+	assignments := map[string][]string{}
+	err := parseAssignments(in, func(subject string, roles []string) error {
+		assignments[subject] = append(assignments[subject], roles...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+func parseAssignments(in string, add func(key string, values []string) error) error {
+	for _, assignment := range strings.Split(in, ";") {
+		assignment = strings.TrimSpace(assignment)
+		if assignment == "" {
+			continue
+		}
+
+		parts := strings.SplitN(assignment, "=", 2)
+		key := strings.TrimSpace(parts[0])
+		if len(parts) != 2 || key == "" {
+			return fmt.Errorf("'%s' must have the form <name>=<value>[,<value>...]",
+				assignment)
+		}
+
+		values := []string{}
+		for _, value := range strings.Split(parts[1], ",") {
+			if value = strings.TrimSpace(value); value != "" {
+				values = append(values, value)
+			}
+		}
+
+		if err := add(key, values); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
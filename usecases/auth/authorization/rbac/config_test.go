@@ -0,0 +1,118 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package rbac
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Validation(t *testing.T) {
+	t.Run("with a valid config", func(t *testing.T) {
+		cfg := Config{
+			Enabled: true,
+			Roles: map[string][]Permission{
+				"reader": {{Verbs: []string{"read"}, Resources: []string{"*"}}},
+			},
+			Groups: map[string][]string{"viewers": {"reader"}},
+			Users:  map[string][]string{"alice": {"reader"}},
+		}
+
+		assert.Nil(t, cfg.Validate())
+	})
+
+	t.Run("with an unknown verb", func(t *testing.T) {
+		cfg := Config{
+			Enabled: true,
+			Roles: map[string][]Permission{
+				"reader": {{Verbs: []string{"watch"}, Resources: []string{"*"}}},
+			},
+		}
+
+		err := cfg.Validate()
+		assert.Equal(t, fmt.Errorf("rbac: role 'reader': unknown verb 'watch'"), err)
+	})
+
+	t.Run("without resources", func(t *testing.T) {
+		cfg := Config{
+			Enabled: true,
+			Roles: map[string][]Permission{
+				"reader": {{Verbs: []string{"read"}}},
+			},
+		}
+
+		err := cfg.Validate()
+		assert.Equal(t, fmt.Errorf("rbac: role 'reader': permission has no resources"), err)
+	})
+
+	t.Run("with a group referencing an undefined role", func(t *testing.T) {
+		cfg := Config{
+			Enabled: true,
+			Groups:  map[string][]string{"viewers": {"reader"}},
+		}
+
+		err := cfg.Validate()
+		assert.Equal(t, fmt.Errorf("rbac: group 'viewers' references undefined role 'reader'"), err)
+	})
+
+	t.Run("with a user referencing an undefined role", func(t *testing.T) {
+		cfg := Config{
+			Enabled: true,
+			Users:   map[string][]string{"alice": {"reader"}},
+		}
+
+		err := cfg.Validate()
+		assert.Equal(t, fmt.Errorf("rbac: user 'alice' references undefined role 'reader'"), err)
+	})
+}
+
+func Test_ParseRoles(t *testing.T) {
+	t.Run("with multiple roles", func(t *testing.T) {
+		roles, err := ParseRoles("reader=read:traversal/*, read:objects/*;logger=write:objects/*")
+
+		require.Nil(t, err)
+		assert.Equal(t, map[string][]Permission{
+			"reader": {
+				{Verbs: []string{"read"}, Resources: []string{"traversal/*"}},
+				{Verbs: []string{"read"}, Resources: []string{"objects/*"}},
+			},
+			"logger": {
+				{Verbs: []string{"write"}, Resources: []string{"objects/*"}},
+			},
+		}, roles)
+	})
+
+	t.Run("with a permission without a pattern", func(t *testing.T) {
+		_, err := ParseRoles("reader=read")
+
+		assert.Equal(t, fmt.Errorf("role 'reader': permission 'read' must have the form <verb>:<pattern>"), err)
+	})
+
+	t.Run("without a role name", func(t *testing.T) {
+		_, err := ParseRoles("read:objects/*")
+
+		assert.Equal(t, fmt.Errorf("'read:objects/*' must have the form <name>=<value>[,<value>...]"), err)
+	})
+}
+
+func Test_ParseRoleAssignments(t *testing.T) {
+	assignments, err := ParseRoleAssignments("editors=reader,logger; viewers=reader;")
+
+	require.Nil(t, err)
+	assert.Equal(t, map[string][]string{
+		"editors": {"reader", "logger"},
+		"viewers": {"reader"},
+	}, assignments)
+}
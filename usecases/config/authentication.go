@@ -17,6 +17,7 @@ import "fmt"
 type Authentication struct {
 	OIDC            OIDC            `json:"oidc" yaml:"oidc"`
 	AnonymousAccess AnonymousAccess `json:"anonymous_access" yaml:"anonymous_access"`
+	APIKey          APIKey          `json:"apikey" yaml:"apikey"`
 }
 
 // Validate the Authentication configuration. This only validates at a general
@@ -31,7 +32,7 @@ func (a Authentication) Validate() error {
 }
 
 func (a Authentication) anyAuthMethodSelected() bool {
-	return a.AnonymousAccess.Enabled || a.OIDC.Enabled
+	return a.AnonymousAccess.Enabled || a.OIDC.Enabled || a.APIKey.Enabled
 }
 
 // AnonymousAccess considers users without any auth information as
@@ -51,3 +52,15 @@ type OIDC struct {
 	UsernameClaim     string `yaml:"username_claim" json:"username_claim"`
 	GroupsClaim       string `yaml:"groups_claim" json:"groups_claim"`
 }
+
+// APIKey authenticates clients which present one of the allowed keys as a
+// bearer token, e.g. services which cannot do an OIDC flow. Each key is
+// mapped to the user at the same position in Users, if only a single user is
+// configured, all keys are mapped to this user. Groups optionally assigns
+// groups to those users, so they can be used by the authorizer.
+type APIKey struct {
+	Enabled     bool                `json:"enabled" yaml:"enabled"`
+	AllowedKeys []string            `json:"allowed_keys" yaml:"allowed_keys"`
+	Users       []string            `json:"users" yaml:"users"`
+	Groups      map[string][]string `json:"groups" yaml:"groups"`
+}
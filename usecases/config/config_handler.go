@@ -20,6 +20,7 @@ import (
 	"github.com/go-openapi/swag"
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/deprecations"
+	"github.com/semi-technologies/weaviate/usecases/audit"
 	"github.com/sirupsen/logrus"
 	"gopkg.in/yaml.v2"
 )
@@ -37,18 +38,20 @@ type Flags struct {
 
 // Config outline of the config file
 type Config struct {
-	Name                    string         `json:"name" yaml:"name"`
-	Debug                   bool           `json:"debug" yaml:"debug"`
-	QueryDefaults           QueryDefaults  `json:"query_defaults" yaml:"query_defaults"`
-	Contextionary           Contextionary  `json:"contextionary" yaml:"contextionary"`
-	Authentication          Authentication `json:"authentication" yaml:"authentication"`
-	Authorization           Authorization  `json:"authorization" yaml:"authorization"`
-	Origin                  string         `json:"origin" yaml:"origin"`
-	Persistence             Persistence    `json:"persistence" yaml:"persistence"`
-	DefaultVectorizerModule string         `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
-	EnableModules           string         `json:"enable_modules" yaml:"enable_modules"`
-	ModulesPath             string         `json:"modules_path" yaml:"modules_path"`
-	AutoSchema              AutoSchema     `json:"auto_schema" yaml:"auto_schema"`
+	Name                    string          `json:"name" yaml:"name"`
+	Debug                   bool            `json:"debug" yaml:"debug"`
+	QueryDefaults           QueryDefaults   `json:"query_defaults" yaml:"query_defaults"`
+	Contextionary           Contextionary   `json:"contextionary" yaml:"contextionary"`
+	Authentication          Authentication  `json:"authentication" yaml:"authentication"`
+	Authorization           Authorization   `json:"authorization" yaml:"authorization"`
+	Origin                  string          `json:"origin" yaml:"origin"`
+	Persistence             Persistence     `json:"persistence" yaml:"persistence"`
+	DefaultVectorizerModule string          `json:"default_vectorizer_module" yaml:"default_vectorizer_module"`
+	EnableModules           string          `json:"enable_modules" yaml:"enable_modules"`
+	ModulesPath             string          `json:"modules_path" yaml:"modules_path"`
+	AutoSchema              AutoSchema      `json:"auto_schema" yaml:"auto_schema"`
+	VectorizerCache         VectorizerCache `json:"vectorizer_cache" yaml:"vectorizer_cache"`
+	Audit                   audit.Config    `json:"audit" yaml:"audit"`
 }
 
 type moduleProvider interface {
@@ -94,6 +97,12 @@ func (a AutoSchema) Validate() error {
 	return nil
 }
 
+// VectorizerCache configures the optional cache of vectorization results. A
+// MaxSize of 0 disables the cache.
+type VectorizerCache struct {
+	MaxSize int `json:"maxSize" yaml:"maxSize"`
+}
+
 // QueryDefaults for optional parameters
 type QueryDefaults struct {
 	Limit int64 `json:"limit" yaml:"limit"`
@@ -184,6 +193,10 @@ func (f *WeaviateConfig) LoadConfig(flags *swag.CommandLineOptionsGroup, logger
 		return fmt.Errorf("invalid config: %v", err)
 	}
 
+	if err := f.Config.Audit.Validate(); err != nil {
+		return fmt.Errorf("invalid config: %v", err)
+	}
+
 	return nil
 }
 
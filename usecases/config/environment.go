@@ -17,6 +17,7 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/usecases/auth/authorization/rbac"
 )
 
 // FromEnv takes a *Config as it will respect initial config that has been
@@ -51,6 +52,35 @@ func FromEnv(config *Config) error {
 		}
 	}
 
+	if enabled(os.Getenv("AUTHENTICATION_APIKEY_ENABLED")) {
+		config.Authentication.APIKey.Enabled = true
+
+		if v := os.Getenv("AUTHENTICATION_APIKEY_ALLOWED_KEYS"); v != "" {
+			keys, err := parseList(v)
+			if err != nil {
+				return errors.Wrap(err, "parse AUTHENTICATION_APIKEY_ALLOWED_KEYS")
+			}
+			config.Authentication.APIKey.AllowedKeys = keys
+		}
+
+		if v := os.Getenv("AUTHENTICATION_APIKEY_USERS"); v != "" {
+			users, err := parseList(v)
+			if err != nil {
+				return errors.Wrap(err, "parse AUTHENTICATION_APIKEY_USERS")
+			}
+			config.Authentication.APIKey.Users = users
+		}
+
+		if v := os.Getenv("AUTHENTICATION_APIKEY_GROUPS"); v != "" {
+			// groups are assigned to users in the same format as roles
+			groups, err := rbac.ParseRoleAssignments(v)
+			if err != nil {
+				return errors.Wrap(err, "parse AUTHENTICATION_APIKEY_GROUPS")
+			}
+			config.Authentication.APIKey.Groups = groups
+		}
+	}
+
 	if enabled(os.Getenv("AUTHORIZATION_ADMINLIST_ENABLED")) {
 		config.Authorization.AdminList.Enabled = true
 
@@ -62,6 +92,34 @@ func FromEnv(config *Config) error {
 		config.Authorization.AdminList.Users = users
 	}
 
+	if enabled(os.Getenv("AUTHORIZATION_RBAC_ENABLED")) {
+		config.Authorization.RBAC.Enabled = true
+
+		if v := os.Getenv("AUTHORIZATION_RBAC_ROLES"); v != "" {
+			roles, err := rbac.ParseRoles(v)
+			if err != nil {
+				return errors.Wrap(err, "parse AUTHORIZATION_RBAC_ROLES")
+			}
+			config.Authorization.RBAC.Roles = roles
+		}
+
+		if v := os.Getenv("AUTHORIZATION_RBAC_GROUPS"); v != "" {
+			groups, err := rbac.ParseRoleAssignments(v)
+			if err != nil {
+				return errors.Wrap(err, "parse AUTHORIZATION_RBAC_GROUPS")
+			}
+			config.Authorization.RBAC.Groups = groups
+		}
+
+		if v := os.Getenv("AUTHORIZATION_RBAC_USERS"); v != "" {
+			users, err := rbac.ParseRoleAssignments(v)
+			if err != nil {
+				return errors.Wrap(err, "parse AUTHORIZATION_RBAC_USERS")
+			}
+			config.Authorization.RBAC.Users = users
+		}
+	}
+
 	if v := os.Getenv("PERSISTENCE_DATA_PATH"); v != "" {
 		config.Persistence.DataPath = v
 	}
@@ -97,6 +155,45 @@ func FromEnv(config *Config) error {
 		config.EnableModules = v
 	}
 
+	if v := os.Getenv("VECTORIZER_CACHE_MAX_SIZE"); v != "" {
+		asInt, err := strconv.Atoi(v)
+		if err != nil {
+			return errors.Wrapf(err, "parse VECTORIZER_CACHE_MAX_SIZE as int")
+		}
+
+		config.VectorizerCache.MaxSize = asInt
+	}
+
+	if enabled(os.Getenv("AUDIT_LOG_ENABLED")) {
+		config.Audit.Enabled = true
+
+		if v := os.Getenv("AUDIT_LOG_OUTPUT"); v != "" {
+			config.Audit.Output = v
+		}
+
+		if v := os.Getenv("AUDIT_LOG_MAX_SIZE_MB"); v != "" {
+			asInt, err := strconv.Atoi(v)
+			if err != nil {
+				return errors.Wrapf(err, "parse AUDIT_LOG_MAX_SIZE_MB as int")
+			}
+
+			config.Audit.MaxSizeMB = asInt
+		}
+
+		if v := os.Getenv("AUDIT_LOG_MAX_BACKUPS"); v != "" {
+			asInt, err := strconv.Atoi(v)
+			if err != nil {
+				return errors.Wrapf(err, "parse AUDIT_LOG_MAX_BACKUPS as int")
+			}
+
+			config.Audit.MaxBackups = asInt
+		}
+
+		if v := os.Getenv("AUDIT_LOG_OPERATIONS"); v != "" {
+			config.Audit.Operations = strings.Split(v, ",")
+		}
+	}
+
 	config.AutoSchema.Enabled = true
 	if v := os.Getenv("AUTOSCHEMA_ENABLED"); v != "" {
 		config.AutoSchema.Enabled = !(strings.ToLower(v) == "false")
@@ -117,6 +214,21 @@ func FromEnv(config *Config) error {
 	return nil
 }
 
+// parseList parses a comma separated list and trims its entries. Empty entries
+// are rejected rather than skipped, as they would shift the position of all
+// following entries, e.g. the user an api key belongs to.
+func parseList(in string) ([]string, error) {
+	entries := strings.Split(in, ",")
+	for i, entry := range entries {
+		entries[i] = strings.TrimSpace(entry)
+		if entries[i] == "" {
+			return nil, errors.Errorf("entry %d is empty", i)
+		}
+	}
+
+	return entries, nil
+}
+
 const VectorizerModuleNone = "none"
 
 // TODO: This should be retrieved dynamically from all installed modules
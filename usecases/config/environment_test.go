@@ -0,0 +1,57 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvironment_APIKey(t *testing.T) {
+	t.Run("entries are trimmed", func(t *testing.T) {
+		t.Setenv("AUTHENTICATION_APIKEY_ENABLED", "true")
+		t.Setenv("AUTHENTICATION_APIKEY_ALLOWED_KEYS", "key-a, key-b ")
+		t.Setenv("AUTHENTICATION_APIKEY_USERS", " alice,bob")
+		t.Setenv("AUTHENTICATION_APIKEY_GROUPS", "alice = editors, viewers ;bob=viewers")
+
+		config := Config{}
+		require.Nil(t, FromEnv(&config))
+
+		apiKey := config.Authentication.APIKey
+		assert.Equal(t, []string{"key-a", "key-b"}, apiKey.AllowedKeys)
+		assert.Equal(t, []string{"alice", "bob"}, apiKey.Users)
+		assert.Equal(t, map[string][]string{
+			"alice": {"editors", "viewers"},
+			"bob":   {"viewers"},
+		}, apiKey.Groups)
+	})
+
+	t.Run("an empty key is rejected", func(t *testing.T) {
+		t.Setenv("AUTHENTICATION_APIKEY_ENABLED", "true")
+		t.Setenv("AUTHENTICATION_APIKEY_ALLOWED_KEYS", "key-a,,key-b")
+
+		err := FromEnv(&Config{})
+		assert.EqualError(t, err,
+			"parse AUTHENTICATION_APIKEY_ALLOWED_KEYS: entry 1 is empty")
+	})
+
+	t.Run("an empty user is rejected", func(t *testing.T) {
+		t.Setenv("AUTHENTICATION_APIKEY_ENABLED", "true")
+		t.Setenv("AUTHENTICATION_APIKEY_USERS", "alice, ")
+
+		err := FromEnv(&Config{})
+		assert.EqualError(t, err,
+			"parse AUTHENTICATION_APIKEY_USERS: entry 1 is empty")
+	})
+}
@@ -73,3 +73,18 @@ func (cbmc *ClassBasedModuleConfig) Property(propName string) map[string]interfa
 
 	return asMap
 }
+
+// cacheKey contains everything from the class which can influence the
+// output of the module, i.e. the class name and all module configs
+func (cbmc *ClassBasedModuleConfig) cacheKey() interface{} {
+	properties := map[string]map[string]interface{}{}
+	for _, prop := range cbmc.class.Properties {
+		properties[prop.Name] = cbmc.Property(prop.Name)
+	}
+
+	return map[string]interface{}{
+		"class":      cbmc.class.Class,
+		"config":     cbmc.Class(),
+		"properties": properties,
+	}
+}
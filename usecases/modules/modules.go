@@ -15,6 +15,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/graphql-go/graphql"
 	"github.com/graphql-go/graphql/language/ast"
 	"github.com/pkg/errors"
@@ -31,8 +32,9 @@ var (
 )
 
 type Provider struct {
-	registered   map[string]modulecapabilities.Module
-	schemaGetter schemaGetter
+	registered      map[string]modulecapabilities.Module
+	schemaGetter    schemaGetter
+	vectorizerCache *VectorizerCache
 }
 
 type schemaGetter interface {
@@ -78,6 +80,12 @@ func (m *Provider) SetSchemaGetter(sg schemaGetter) {
 	m.schemaGetter = sg
 }
 
+// SetVectorizerCache enables caching of vectorization results. Without a
+// cache every vectorization is sent to the module.
+func (m *Provider) SetVectorizerCache(cache *VectorizerCache) {
+	m.vectorizerCache = cache
+}
+
 func (m *Provider) Init(ctx context.Context,
 	params moduletools.ModuleInitParams) error {
 	for i, mod := range m.GetAll() {
@@ -214,6 +222,23 @@ func (m *Provider) GetArguments(class *models.Class) map[string]*graphql.Argumen
 	return arguments
 }
 
+// AggregateArguments provides GraphQL Aggregate arguments
+func (m *Provider) AggregateArguments(class *models.Class) map[string]*graphql.ArgumentConfig {
+	arguments := map[string]*graphql.ArgumentConfig{}
+	for _, module := range m.GetAll() {
+		if m.shouldIncludeClassArgument(class, module.Name()) {
+			if arg, ok := module.(modulecapabilities.GraphQLArguments); ok {
+				for name, argument := range arg.Arguments() {
+					if argument.AggregateArgumentsFunction != nil {
+						arguments[name] = argument.AggregateArgumentsFunction(class.Class)
+					}
+				}
+			}
+		}
+	}
+	return arguments
+}
+
 // ExploreArguments provides GraphQL Explore arguments
 func (m *Provider) ExploreArguments(schema *models.Schema) map[string]*graphql.ArgumentConfig {
 	arguments := map[string]*graphql.ArgumentConfig{}
@@ -440,7 +465,8 @@ func (m *Provider) VectorFromSearchParam(ctx context.Context,
 			if vectorSearches := searcher.VectorSearches(); vectorSearches != nil {
 				if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
 					cfg := NewClassBasedModuleConfig(class, mod.Name())
-					vector, err := searchVectorFn(ctx, params, findVectorFn, cfg)
+					vector, err := m.cachedVectorForParams(ctx, mod.Name(), param,
+						params, searchVectorFn, findVectorFn, cfg)
 					if err != nil {
 						return nil, errors.Errorf("vectorize params: %v", err)
 					}
@@ -463,7 +489,8 @@ func (m *Provider) CrossClassVectorFromSearchParam(ctx context.Context,
 		if searcher, ok := mod.(modulecapabilities.Searcher); ok {
 			if vectorSearches := searcher.VectorSearches(); vectorSearches != nil {
 				if searchVectorFn := vectorSearches[param]; searchVectorFn != nil {
-					vector, err := searchVectorFn(ctx, params, findVectorFn, nil)
+					vector, err := m.cachedVectorForParams(ctx, mod.Name(), param,
+						params, searchVectorFn, findVectorFn, nil)
 					if err != nil {
 						return nil, errors.Errorf("vectorize params: %v", err)
 					}
@@ -476,6 +503,55 @@ func (m *Provider) CrossClassVectorFromSearchParam(ctx context.Context,
 	panic("VectorFromParams was called without any known params present")
 }
 
+// cachedVectorForParams looks up the vector for the search params in the
+// vectorizer cache and only calls the module on a cache miss. Searches which
+// reference other objects (e.g. moveTo objects) are never cached as the
+// vectors of those objects can change at any time.
+func (m *Provider) cachedVectorForParams(ctx context.Context,
+	moduleName, param string, params interface{},
+	searchVectorFn modulecapabilities.VectorForParams,
+	findVectorFn modulecapabilities.FindVectorFn,
+	cfg *ClassBasedModuleConfig) ([]float32, error) {
+	var moduleCfg moduletools.ClassConfig
+	if cfg != nil {
+		moduleCfg = cfg
+	}
+
+	if m.vectorizerCache == nil {
+		return searchVectorFn(ctx, params, findVectorFn, moduleCfg)
+	}
+
+	key, err := m.vectorizerCache.key(moduleName, cfg, map[string]interface{}{
+		param: params,
+	})
+	if err != nil {
+		// the params cannot be used as a cache key, this is not an error, they
+		// simply can't be cached
+		return searchVectorFn(ctx, params, findVectorFn, moduleCfg)
+	}
+
+	if entry, ok := m.vectorizerCache.get(moduleName, key); ok {
+		return copyVector(entry.vector), nil
+	}
+
+	referencesObjects := false
+	trackingFindVectorFn := func(ctx context.Context, id strfmt.UUID) ([]float32, error) {
+		referencesObjects = true
+		return findVectorFn(ctx, id)
+	}
+
+	vector, err := searchVectorFn(ctx, params, trackingFindVectorFn, moduleCfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if !referencesObjects {
+		m.vectorizerCache.put(key, vector, nil)
+	}
+
+	return vector, nil
+}
+
 // ParseClassifierSettings parses and adds classifier specific settings
 func (m *Provider) ParseClassifierSettings(name string,
 	params *models.Classification) error {
@@ -515,8 +591,20 @@ func (m *Provider) GetMeta() (map[string]interface{}, error) {
 			if err != nil {
 				return nil, err
 			}
+			if m.vectorizerCache != nil && m.usesVectorizerCache(module) {
+				if meta == nil {
+					meta = map[string]interface{}{}
+				}
+				meta["vectorizerCache"] = m.vectorizerCache.MetaInfo(module.Name())
+			}
 			metaInfos[module.Name()] = meta
 		}
 	}
 	return metaInfos, nil
 }
+
+func (m *Provider) usesVectorizerCache(module modulecapabilities.Module) bool {
+	_, isVectorizer := module.(modulecapabilities.Vectorizer)
+	_, isSearcher := module.(modulecapabilities.Searcher)
+	return isVectorizer || isSearcher
+}
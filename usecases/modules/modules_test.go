@@ -42,6 +42,7 @@ func TestModulesProvider(t *testing.T) {
 		err := modulesProvider.Init(context.Background(), nil)
 		registered := modulesProvider.GetAll()
 		getArgs := modulesProvider.GetArguments(class)
+		aggregateArgs := modulesProvider.AggregateArguments(class)
 		exploreArgs := modulesProvider.ExploreArguments(schema)
 		extractedArgs := modulesProvider.ExtractSearchParams(arguments)
 
@@ -50,6 +51,7 @@ func TestModulesProvider(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, "mod1", mod1.Name())
 		assert.NotNil(t, getArgs["nearArgument"])
+		assert.NotNil(t, aggregateArgs["nearArgument"])
 		assert.NotNil(t, exploreArgs["nearArgument"])
 		assert.NotNil(t, extractedArgs["nearArgument"])
 	})
@@ -302,10 +304,11 @@ type dummyGraphQLModule struct {
 
 func (m *dummyGraphQLModule) withArg(argName string) *dummyGraphQLModule {
 	arg := modulecapabilities.GraphQLArgument{
-		GetArgumentsFunction:     func(classname string) *graphql.ArgumentConfig { return &graphql.ArgumentConfig{} },
-		ExploreArgumentsFunction: func() *graphql.ArgumentConfig { return &graphql.ArgumentConfig{} },
-		ExtractFunction:          fakeExtractFn,
-		ValidateFunction:         fakeValidateFn,
+		GetArgumentsFunction:       func(classname string) *graphql.ArgumentConfig { return &graphql.ArgumentConfig{} },
+		AggregateArgumentsFunction: func(classname string) *graphql.ArgumentConfig { return &graphql.ArgumentConfig{} },
+		ExploreArgumentsFunction:   func() *graphql.ArgumentConfig { return &graphql.ArgumentConfig{} },
+		ExtractFunction:            fakeExtractFn,
+		ValidateFunction:           fakeValidateFn,
 	}
 	m.arguments[argName] = arg
 	return m
@@ -55,20 +55,67 @@ func (m *Provider) Vectorizer(moduleName, className string) (objects.Vectorizer,
 	}
 
 	cfg := NewClassBasedModuleConfig(class, moduleName)
-	return NewObjectsVectorizer(vec, cfg), nil
+	return NewObjectsVectorizer(vec, cfg, moduleName, m.vectorizerCache), nil
 }
 
 type ObjectsVectorizer struct {
 	modVectorizer modulecapabilities.Vectorizer
 	cfg           *ClassBasedModuleConfig
+	moduleName    string
+	cache         *VectorizerCache
 }
 
+// NewObjectsVectorizer creates a vectorizer for the objects of a single
+// class. The cache is optional and may be nil.
 func NewObjectsVectorizer(vec modulecapabilities.Vectorizer,
-	cfg *ClassBasedModuleConfig) *ObjectsVectorizer {
-	return &ObjectsVectorizer{modVectorizer: vec, cfg: cfg}
+	cfg *ClassBasedModuleConfig, moduleName string,
+	cache *VectorizerCache) *ObjectsVectorizer {
+	return &ObjectsVectorizer{
+		modVectorizer: vec,
+		cfg:           cfg,
+		moduleName:    moduleName,
+		cache:         cache,
+	}
 }
 
 func (ov *ObjectsVectorizer) UpdateObject(ctx context.Context,
 	obj *models.Object) error {
-	return ov.modVectorizer.VectorizeObject(ctx, obj, ov.cfg)
+	if ov.cache == nil {
+		return ov.modVectorizer.VectorizeObject(ctx, obj, ov.cfg)
+	}
+
+	key, err := ov.cache.key(ov.moduleName, ov.cfg, obj.Properties)
+	if err != nil {
+		// the object cannot be used as a cache key, this is not an error, it
+		// simply can't be cached
+		return ov.modVectorizer.VectorizeObject(ctx, obj, ov.cfg)
+	}
+
+	if entry, ok := ov.cache.get(ov.moduleName, key); ok {
+		obj.Vector = copyVector(entry.vector)
+		if len(entry.additional) > 0 && obj.Additional == nil {
+			obj.Additional = models.AdditionalProperties{}
+		}
+		for name, value := range entry.additional {
+			obj.Additional[name] = value
+		}
+		return nil
+	}
+
+	before := copyAdditional(obj.Additional)
+	if err := ov.modVectorizer.VectorizeObject(ctx, obj, ov.cfg); err != nil {
+		return err
+	}
+
+	// only cache the additional properties set by the vectorizer, not the
+	// ones that were already present on the incoming object
+	added := models.AdditionalProperties{}
+	for name, value := range obj.Additional {
+		if _, ok := before[name]; !ok {
+			added[name] = value
+		}
+	}
+	ov.cache.put(key, obj.Vector, added)
+
+	return nil
 }
@@ -0,0 +1,167 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/json"
+	"sync"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// VectorizerCache is a size-bounded LRU cache for the results of vectorizer
+// modules. It is used to skip the (usually remote) vectorization when the
+// exact same input is vectorized again with the same module and class
+// configuration, for example on idempotent re-imports or repeated queries.
+type VectorizerCache struct {
+	sync.Mutex
+	maxSize int
+	entries map[vectorizerCacheKey]*list.Element
+	lru     *list.List
+	stats   map[string]*vectorizerCacheStats
+}
+
+type vectorizerCacheKey [sha256.Size]byte
+
+type vectorizerCacheEntry struct {
+	key        vectorizerCacheKey
+	vector     []float32
+	additional models.AdditionalProperties
+}
+
+type vectorizerCacheStats struct {
+	hits   uint64
+	misses uint64
+}
+
+func NewVectorizerCache(maxSize int) *VectorizerCache {
+	return &VectorizerCache{
+		maxSize: maxSize,
+		entries: map[vectorizerCacheKey]*list.Element{},
+		lru:     list.New(),
+		stats:   map[string]*vectorizerCacheStats{},
+	}
+}
+
+// key builds a cache key out of the module name, the module's configuration
+// for the class and the input that is being vectorized. The key is hashed,
+// so that long texts do not need to be kept in memory.
+func (c *VectorizerCache) key(moduleName string, cfg *ClassBasedModuleConfig,
+	input interface{}) (vectorizerCacheKey, error) {
+	keyInput := struct {
+		Module      string      `json:"module"`
+		ClassConfig interface{} `json:"classConfig"`
+		Input       interface{} `json:"input"`
+	}{
+		Module: moduleName,
+		Input:  input,
+	}
+	if cfg != nil {
+		keyInput.ClassConfig = cfg.cacheKey()
+	}
+
+	bytes, err := json.Marshal(keyInput)
+	if err != nil {
+		return vectorizerCacheKey{}, err
+	}
+
+	return sha256.Sum256(bytes), nil
+}
+
+func (c *VectorizerCache) get(moduleName string,
+	key vectorizerCacheKey) (*vectorizerCacheEntry, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	stats := c.moduleStats(moduleName)
+	elem, ok := c.entries[key]
+	if !ok {
+		stats.misses++
+		return nil, false
+	}
+
+	stats.hits++
+	c.lru.MoveToFront(elem)
+	return elem.Value.(*vectorizerCacheEntry), true
+}
+
+func (c *VectorizerCache) put(key vectorizerCacheKey, vector []float32,
+	additional models.AdditionalProperties) {
+	c.Lock()
+	defer c.Unlock()
+
+	entry := &vectorizerCacheEntry{
+		key:        key,
+		vector:     copyVector(vector),
+		additional: copyAdditional(additional),
+	}
+
+	if elem, ok := c.entries[key]; ok {
+		elem.Value = entry
+		c.lru.MoveToFront(elem)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(entry)
+	for c.lru.Len() > c.maxSize {
+		oldest := c.lru.Back()
+		c.lru.Remove(oldest)
+		delete(c.entries, oldest.Value.(*vectorizerCacheEntry).key)
+	}
+}
+
+// moduleStats must be called with the lock held
+func (c *VectorizerCache) moduleStats(moduleName string) *vectorizerCacheStats {
+	stats, ok := c.stats[moduleName]
+	if !ok {
+		stats = &vectorizerCacheStats{}
+		c.stats[moduleName] = stats
+	}
+	return stats
+}
+
+// MetaInfo returns the cache statistics for the given module, so they can be
+// displayed on the meta endpoint
+func (c *VectorizerCache) MetaInfo(moduleName string) map[string]interface{} {
+	c.Lock()
+	defer c.Unlock()
+
+	stats := c.moduleStats(moduleName)
+	return map[string]interface{}{
+		"hits":    stats.hits,
+		"misses":  stats.misses,
+		"size":    c.lru.Len(),
+		"maxSize": c.maxSize,
+	}
+}
+
+func copyVector(in []float32) []float32 {
+	if in == nil {
+		return nil
+	}
+	out := make([]float32, len(in))
+	copy(out, in)
+	return out
+}
+
+func copyAdditional(in models.AdditionalProperties) models.AdditionalProperties {
+	if in == nil {
+		return nil
+	}
+	out := make(models.AdditionalProperties, len(in))
+	for key, value := range in {
+		out[key] = value
+	}
+	return out
+}
@@ -0,0 +1,214 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package modules
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/modulecapabilities"
+	"github.com/semi-technologies/weaviate/entities/moduletools"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVectorizerCache(t *testing.T) {
+	class := &models.Class{
+		Class: "MyClass",
+		Properties: []*models.Property{
+			{Name: "title"},
+		},
+	}
+	cfg := NewClassBasedModuleConfig(class, "some-module")
+
+	t.Run("identical input leads to a hit", func(t *testing.T) {
+		c := NewVectorizerCache(10)
+		key, err := c.key("some-module", cfg, "some text")
+		require.Nil(t, err)
+
+		_, ok := c.get("some-module", key)
+		assert.False(t, ok)
+
+		c.put(key, []float32{1, 2, 3}, nil)
+		entry, ok := c.get("some-module", key)
+		require.True(t, ok)
+		assert.Equal(t, []float32{1, 2, 3}, entry.vector)
+
+		meta := c.MetaInfo("some-module")
+		assert.Equal(t, uint64(1), meta["hits"])
+		assert.Equal(t, uint64(1), meta["misses"])
+		assert.Equal(t, 1, meta["size"])
+		assert.Equal(t, 10, meta["maxSize"])
+	})
+
+	t.Run("different module, config or input lead to different keys", func(t *testing.T) {
+		c := NewVectorizerCache(10)
+		otherClass := &models.Class{
+			Class: "MyClass",
+			ModuleConfig: map[string]interface{}{
+				"some-module": map[string]interface{}{
+					"vectorizeClassName": false,
+				},
+			},
+			Properties: []*models.Property{
+				{Name: "title"},
+			},
+		}
+
+		base, err := c.key("some-module", cfg, "some text")
+		require.Nil(t, err)
+		otherModule, err := c.key("other-module", cfg, "some text")
+		require.Nil(t, err)
+		otherConfig, err := c.key("some-module",
+			NewClassBasedModuleConfig(otherClass, "some-module"), "some text")
+		require.Nil(t, err)
+		otherInput, err := c.key("some-module", cfg, "some other text")
+		require.Nil(t, err)
+
+		assert.NotEqual(t, base, otherModule)
+		assert.NotEqual(t, base, otherConfig)
+		assert.NotEqual(t, base, otherInput)
+	})
+
+	t.Run("the least recently used entry is evicted", func(t *testing.T) {
+		c := NewVectorizerCache(2)
+		first, _ := c.key("some-module", cfg, "first")
+		second, _ := c.key("some-module", cfg, "second")
+		third, _ := c.key("some-module", cfg, "third")
+
+		c.put(first, []float32{1}, nil)
+		c.put(second, []float32{2}, nil)
+		// access first, so that second is now the least recently used
+		c.get("some-module", first)
+		c.put(third, []float32{3}, nil)
+
+		_, ok := c.get("some-module", first)
+		assert.True(t, ok)
+		_, ok = c.get("some-module", second)
+		assert.False(t, ok)
+		_, ok = c.get("some-module", third)
+		assert.True(t, ok)
+		assert.Equal(t, 2, c.MetaInfo("some-module")["size"])
+	})
+}
+
+func TestVectorizerWithCache(t *testing.T) {
+	sch := schema.Schema{
+		Objects: &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "MyClass",
+					Properties: []*models.Property{
+						{Name: "title"},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("identical objects are only vectorized once", func(t *testing.T) {
+		mod := &countingVectorizerModule{
+			dummyModuleNoCapabilities: newDummyModuleWithName("some-module"),
+		}
+		p := NewProvider()
+		p.SetSchemaGetter(&fakeSchemaGetter{sch})
+		p.SetVectorizerCache(NewVectorizerCache(10))
+		p.Register(mod)
+
+		vec, err := p.Vectorizer("some-module", "MyClass")
+		require.Nil(t, err)
+
+		for i := 0; i < 3; i++ {
+			obj := &models.Object{
+				Class:      "MyClass",
+				Properties: map[string]interface{}{"title": "same title"},
+			}
+			require.Nil(t, vec.UpdateObject(context.Background(), obj))
+			assert.Equal(t, models.C11yVector{1, 2, 3}, obj.Vector)
+			assert.Equal(t, "vectorized", obj.Additional["interpretation"])
+		}
+
+		obj := &models.Object{
+			Class:      "MyClass",
+			Properties: map[string]interface{}{"title": "other title"},
+		}
+		require.Nil(t, vec.UpdateObject(context.Background(), obj))
+
+		assert.Equal(t, 2, mod.calls)
+	})
+
+	t.Run("repeated searches are only vectorized once", func(t *testing.T) {
+		calls := 0
+		p := NewProvider()
+		p.SetSchemaGetter(&fakeSchemaGetter{sch})
+		p.SetVectorizerCache(NewVectorizerCache(10))
+		p.Register(newSearcherModule("mod").
+			withArg("nearGrape").
+			withSearcher("nearGrape", func(ctx context.Context, params interface{},
+				findVectorFn modulecapabilities.FindVectorFn,
+				cfg moduletools.ClassConfig) ([]float32, error) {
+				calls++
+				return []float32{1, 2, 3}, nil
+			}),
+		)
+		p.Init(context.Background(), nil)
+
+		for i := 0; i < 3; i++ {
+			res, err := p.VectorFromSearchParam(context.Background(), "MyClass",
+				"nearGrape", map[string]interface{}{"concepts": "grape"}, fakeFindVector)
+			require.Nil(t, err)
+			assert.Equal(t, []float32{1, 2, 3}, res)
+		}
+
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("searches referencing objects are not cached", func(t *testing.T) {
+		calls := 0
+		p := NewProvider()
+		p.SetSchemaGetter(&fakeSchemaGetter{sch})
+		p.SetVectorizerCache(NewVectorizerCache(10))
+		p.Register(newSearcherModule("mod").
+			withArg("nearGrape").
+			withSearcher("nearGrape", func(ctx context.Context, params interface{},
+				findVectorFn modulecapabilities.FindVectorFn,
+				cfg moduletools.ClassConfig) ([]float32, error) {
+				calls++
+				return findVectorFn(ctx, "123")
+			}),
+		)
+		p.Init(context.Background(), nil)
+
+		for i := 0; i < 3; i++ {
+			_, err := p.CrossClassVectorFromSearchParam(context.Background(),
+				"nearGrape", nil, fakeFindVector)
+			require.Nil(t, err)
+		}
+
+		assert.Equal(t, 3, calls)
+	})
+}
+
+type countingVectorizerModule struct {
+	dummyModuleNoCapabilities
+	calls int
+}
+
+func (m *countingVectorizerModule) VectorizeObject(ctx context.Context,
+	in *models.Object, cfg moduletools.ClassConfig) error {
+	m.calls++
+	in.Vector = []float32{1, 2, 3}
+	in.Additional = models.AdditionalProperties{"interpretation": "vectorized"}
+	return nil
+}
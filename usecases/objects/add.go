@@ -29,14 +29,32 @@ type schemaManager interface {
 		class *models.Class) error
 	AddClassProperty(ctx context.Context, principal *models.Principal,
 		class string, property *models.Property) error
+	TenantsSkipAuth(className string) []*models.Tenant
 }
 
 // AddObject Class Instance to the connected DB. If the class contains a network
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
 func (m *Manager) AddObject(ctx context.Context, principal *models.Principal,
-	object *models.Object) (*models.Object, error) {
-	err := m.authorizer.Authorize(principal, "create", "objects")
+	object *models.Object) (_ *models.Object, err error) {
+	defer func() {
+		resource := "objects"
+		if object != nil {
+			resource = objectResource(object.ID)
+		}
+		m.auditor.Log(ctx, principal, "create", resource, err)
+	}()
+
+	var className string
+	var id strfmt.UUID
+	if object != nil {
+		if err := resolveAlias(m.schemaManager, principal, object); err != nil {
+			return nil, err
+		}
+		className, id = object.Class, object.ID
+	}
+
+	err = m.authorizer.Authorize(principal, "create", classObjectResource(className, id))
 	if err != nil {
 		return nil, err
 	}
@@ -51,7 +69,7 @@ func (m *Manager) AddObject(ctx context.Context, principal *models.Principal,
 }
 
 func (m *Manager) checkIDOrAssignNew(ctx context.Context,
-	id strfmt.UUID) (strfmt.UUID, error) {
+	id strfmt.UUID, tenant string) (strfmt.UUID, error) {
 	if id == "" {
 		newID, err := generateUUID()
 		if err != nil {
@@ -61,7 +79,7 @@ func (m *Manager) checkIDOrAssignNew(ctx context.Context,
 	}
 
 	// only validate ID uniqueness if explicitly set
-	if ok, err := m.exists(ctx, id); ok {
+	if ok, err := m.exists(ctx, id, tenant); ok {
 		return "", NewErrInvalidUserInput("id '%s' already exists", id)
 	} else if err != nil {
 		return "", NewErrInternal(err.Error())
@@ -71,7 +89,11 @@ func (m *Manager) checkIDOrAssignNew(ctx context.Context,
 
 func (m *Manager) addObjectToConnectorAndSchema(ctx context.Context, principal *models.Principal,
 	object *models.Object) (*models.Object, error) {
-	id, err := m.checkIDOrAssignNew(ctx, object.ID)
+	if err := resolveAlias(m.schemaManager, principal, object); err != nil {
+		return nil, err
+	}
+
+	id, err := m.checkIDOrAssignNew(ctx, object.ID, object.Tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -126,9 +148,22 @@ func (m *Manager) validateObject(ctx context.Context, principal *models.Principa
 		return err
 	}
 
-	return validation.New(s, m.exists, m.config).Object(ctx, object)
+	if err := validateTenant(m.schemaManager, s, object.Class, object.Tenant); err != nil {
+		return err
+	}
+
+	return validation.New(s, m.existsFn(object.Tenant), m.config).Object(ctx, object)
 }
 
-func (m *Manager) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
-	return m.vectorRepo.Exists(ctx, id)
+func (m *Manager) exists(ctx context.Context, id strfmt.UUID,
+	tenant string) (bool, error) {
+	return m.vectorRepo.Exists(ctx, id, tenant)
+}
+
+// existsFn binds the existence check to a tenant, so references can be
+// validated from within that tenant
+func (m *Manager) existsFn(tenant string) func(context.Context, strfmt.UUID) (bool, error) {
+	return func(ctx context.Context, id strfmt.UUID) (bool, error) {
+		return m.exists(ctx, id, tenant)
+	}
 }
@@ -34,6 +34,7 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 
 	schema := schema.Schema{
 		Objects: &models.Schema{
+			Aliases: []*models.Alias{{Alias: "Bar", Class: "Foo"}},
 			Classes: []*models.Class{
 				{
 					Class:             "Foo",
@@ -47,6 +48,12 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 						Skip: true,
 					},
 				},
+				{
+					Class:             "FooWithNamedVectors",
+					Vectorizer:        config.VectorizerModuleNone,
+					VectorIndexConfig: hnsw.UserConfig{},
+					NamedVectors:      []string{"title_vector"},
+				},
 			},
 		},
 	}
@@ -70,7 +77,7 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		logger, _ := test.NewNullLogger()
 		vectorizer := &fakeVectorizer{}
 		vecProvider := &fakeVectorizerProvider{vectorizer}
-		manager = NewManager(locks, schemaManager, cfg, logger, authorizer, vecProvider, vectorRepo, getFakeModulesProvider())
+		manager = NewManager(locks, schemaManager, cfg, logger, authorizer, &fakeAuditor{}, vecProvider, vectorRepo, getFakeModulesProvider())
 	}
 
 	reset := func() {
@@ -94,6 +101,23 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		assert.Equal(t, uuidDuringCreation, res.ID, "check that connector add ID and user response match")
 	})
 
+	t.Run("with an alias as class", func(t *testing.T) {
+		reset()
+
+		ctx := context.Background()
+		object := &models.Object{
+			Vector: []float32{0.1, 0.2, 0.3},
+			Class:  "Bar",
+		}
+
+		res, err := manager.AddObject(ctx, nil, object)
+		require.Nil(t, err)
+		stored := vectorRepo.Mock.Calls[0].Arguments.Get(0).(*models.Object)
+
+		assert.Equal(t, "Foo", stored.Class, "check that the alias was resolved")
+		assert.Equal(t, "Foo", res.Class)
+	})
+
 	t.Run("with an explicit (correct) ID set", func(t *testing.T) {
 		reset()
 
@@ -194,6 +218,42 @@ func Test_Add_Object_WithNoVectorizerModule(t *testing.T) {
 		_, err := manager.AddObject(ctx, nil, class)
 		assert.Nil(t, err)
 	})
+
+	t.Run("with a configured named vector", func(t *testing.T) {
+		reset()
+
+		ctx := context.Background()
+		class := &models.Object{
+			Class:  "FooWithNamedVectors",
+			Vector: []float32{0.1, 0.2, 0.3},
+			Vectors: models.C11yVectors{
+				"title_vector": {0.3, 0.2},
+			},
+		}
+
+		_, err := manager.AddObject(ctx, nil, class)
+		require.Nil(t, err)
+		stored := vectorRepo.Mock.Calls[0].Arguments.Get(0).(*models.Object)
+		assert.Equal(t, models.C11yVector{0.3, 0.2}, stored.Vectors["title_vector"])
+	})
+
+	t.Run("with a named vector the class does not have", func(t *testing.T) {
+		reset()
+
+		ctx := context.Background()
+		class := &models.Object{
+			Class:  "FooWithNamedVectors",
+			Vector: []float32{0.1, 0.2, 0.3},
+			Vectors: models.C11yVectors{
+				"body_vector": {0.3, 0.2},
+			},
+		}
+
+		_, err := manager.AddObject(ctx, nil, class)
+		_, ok := err.(ErrInvalidUserInput)
+		assert.True(t, ok)
+		assert.Contains(t, err.Error(), "named vector 'body_vector' is not configured")
+	})
 }
 
 // TODO: This currently always assumes the text2vec-vectorizer, but this could
@@ -229,7 +289,7 @@ func Test_Add_Object_WithExternalVectorizerModule(t *testing.T) {
 		vectorizer := &fakeVectorizer{}
 		vecProvider := &fakeVectorizerProvider{vectorizer}
 		vectorizer.On("UpdateObject", mock.Anything).Return([]float32{0, 1, 2}, nil)
-		manager = NewManager(locks, schemaManager, cfg, logger, authorizer, vecProvider, vectorRepo, getFakeModulesProvider())
+		manager = NewManager(locks, schemaManager, cfg, logger, authorizer, &fakeAuditor{}, vecProvider, vectorRepo, getFakeModulesProvider())
 	}
 
 	t.Run("without an id set", func(t *testing.T) {
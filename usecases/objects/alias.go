@@ -0,0 +1,30 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"github.com/semi-technologies/weaviate/entities/models"
+)
+
+// resolveAlias replaces a class alias in the object with the class it points
+// to, so the object is validated, vectorized and stored as an object of that
+// class. Objects of regular classes are left unchanged.
+func resolveAlias(sm schemaManager, principal *models.Principal,
+	object *models.Object) error {
+	s, err := sm.GetSchema(principal)
+	if err != nil {
+		return err
+	}
+
+	object.Class = s.ResolveAlias(object.Class)
+	return nil
+}
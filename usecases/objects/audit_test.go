@@ -0,0 +1,147 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Audit_Objects(t *testing.T) {
+	var (
+		vectorRepo *fakeVectorRepo
+		auditor    *fakeAuditor
+		manager    *Manager
+	)
+
+	reset := func() {
+		vectorRepo = &fakeVectorRepo{}
+		auditor = &fakeAuditor{}
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Objects: &models.Schema{
+					Classes: []*models.Class{
+						{
+							Class:             "Foo",
+							Vectorizer:        config.VectorizerModuleNone,
+							VectorIndexConfig: hnsw.UserConfig{},
+						},
+					},
+				},
+			},
+		}
+		logger, _ := test.NewNullLogger()
+		vecProvider := &fakeVectorizerProvider{&fakeVectorizer{}}
+		manager = NewManager(&fakeLocks{}, schemaManager, &config.WeaviateConfig{},
+			logger, &fakeAuthorizer{}, auditor, vecProvider, vectorRepo,
+			getFakeModulesProvider())
+	}
+
+	t.Run("adding an object is recorded with its id", func(t *testing.T) {
+		reset()
+		id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		vectorRepo.On("Exists", id).Return(false, nil).Once()
+		vectorRepo.On("PutObject", mock.Anything, mock.Anything).Return(nil).Once()
+
+		_, err := manager.AddObject(context.Background(), nil, &models.Object{
+			ID:     id,
+			Class:  "Foo",
+			Vector: []float32{0.1, 0.2},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []fakeAuditRecord{
+			{verb: "create", resource: "objects/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"},
+		}, auditor.records)
+	})
+
+	t.Run("a failed add is recorded with its error", func(t *testing.T) {
+		reset()
+
+		_, err := manager.AddObject(context.Background(), nil, &models.Object{
+			Class: "Foo",
+		})
+		require.NotNil(t, err)
+
+		require.Len(t, auditor.records, 1)
+		assert.Equal(t, "create", auditor.records[0].verb)
+		assert.Equal(t, err, auditor.records[0].err)
+	})
+
+	t.Run("deleting an object is recorded", func(t *testing.T) {
+		reset()
+		id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+		vectorRepo.On("ObjectByID", mock.Anything, mock.Anything, mock.Anything).
+			Return(&search.Result{ClassName: "Foo"}, nil).Once()
+		vectorRepo.On("DeleteObject", "Foo", id).Return(nil).Once()
+
+		err := manager.DeleteObject(context.Background(), nil, id, "")
+		require.Nil(t, err)
+
+		assert.Equal(t, []fakeAuditRecord{
+			{verb: "delete", resource: "objects/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc"},
+		}, auditor.records)
+	})
+}
+
+func Test_Audit_BatchObjects(t *testing.T) {
+	vectorRepo := &fakeVectorRepo{}
+	auditor := &fakeAuditor{}
+	schemaManager := &fakeSchemaManager{
+		GetSchemaResponse: schema.Schema{
+			Objects: &models.Schema{
+				Classes: []*models.Class{
+					{
+						Class:             "Foo",
+						Vectorizer:        config.VectorizerModuleNone,
+						VectorIndexConfig: hnsw.UserConfig{},
+					},
+				},
+			},
+		},
+	}
+	logger, _ := test.NewNullLogger()
+	vecProvider := &fakeVectorizerProvider{&fakeVectorizer{}}
+	manager := NewBatchManager(vectorRepo, vecProvider, &fakeLocks{}, schemaManager,
+		&config.WeaviateConfig{}, logger, &fakeAuthorizer{}, auditor)
+
+	vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil).Once()
+	_, err := manager.AddObjects(context.Background(), nil, []*models.Object{
+		{
+			ID:     "5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+			Class:  "Foo",
+			Vector: []float32{0.1, 0.2},
+		},
+		{
+			ID:    "8b1cd361-1e0d-42ae-bd52-ee09cb5f31cc",
+			Class: "Foo",
+		},
+	}, []*string{})
+	require.Nil(t, err)
+
+	require.Len(t, auditor.records, 2, "every object in the batch is recorded")
+	assert.Equal(t, "objects/5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc", auditor.records[0].resource)
+	assert.Nil(t, auditor.records[0].err)
+	assert.Equal(t, "objects/8b1cd361-1e0d-42ae-bd52-ee09cb5f31cc", auditor.records[1].resource)
+	assert.NotNil(t, auditor.records[1].err, "object without vector fails on its own")
+}
@@ -19,11 +19,15 @@ import (
 	"testing"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/adapters/repos/db/vector/hnsw"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/entities/search"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/semi-technologies/weaviate/usecases/traverser"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -42,45 +46,45 @@ func Test_Kinds_Authorization(t *testing.T) {
 		// single kind
 		testCase{
 			methodName:       "AddObject",
-			additionalArgs:   []interface{}{(*models.Object)(nil)},
+			additionalArgs:   []interface{}{&models.Object{Class: "Article"}},
 			expectedVerb:     "create",
-			expectedResource: "objects",
+			expectedResource: "objects/Article",
 		},
 		testCase{
 			methodName:       "ValidateObject",
-			additionalArgs:   []interface{}{(*models.Object)(nil)},
+			additionalArgs:   []interface{}{&models.Object{Class: "Article", ID: "foo"}},
 			expectedVerb:     "validate",
-			expectedResource: "objects",
+			expectedResource: "objects/Article/foo",
 		},
 		testCase{
 			methodName:       "GetObject",
-			additionalArgs:   []interface{}{strfmt.UUID("foo"), traverser.AdditionalProperties{}},
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), traverser.AdditionalProperties{}, ""},
 			expectedVerb:     "get",
-			expectedResource: "objects/foo",
+			expectedResource: "objects/Article/foo",
 		},
 		testCase{
 			methodName:       "DeleteObject",
-			additionalArgs:   []interface{}{strfmt.UUID("foo")},
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), ""},
 			expectedVerb:     "delete",
-			expectedResource: "objects/foo",
+			expectedResource: "objects/Article/foo",
 		},
 		testCase{
 			methodName:       "UpdateObject",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Object)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "objects/foo",
+			expectedResource: "objects/Article/foo",
 		},
 		testCase{
 			methodName:       "MergeObject",
 			additionalArgs:   []interface{}{strfmt.UUID("foo"), (*models.Object)(nil)},
 			expectedVerb:     "update",
-			expectedResource: "objects/foo",
+			expectedResource: "objects/Article/foo",
 		},
 
 		// list kinds
 		testCase{
 			methodName:       "GetObjects",
-			additionalArgs:   []interface{}{(*int64)(nil), traverser.AdditionalProperties{}},
+			additionalArgs:   []interface{}{(*int64)(nil), traverser.AdditionalProperties{}, ""},
 			expectedVerb:     "list",
 			expectedResource: "objects",
 		},
@@ -88,21 +92,21 @@ func Test_Kinds_Authorization(t *testing.T) {
 		// reference on kinds
 		testCase{
 			methodName:       "AddObjectReference",
-			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil)},
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil), ""},
 			expectedVerb:     "update",
-			expectedResource: "objects/foo",
+			expectedResource: "objects/Article/foo",
 		},
 		testCase{
 			methodName:       "DeleteObjectReference",
-			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil)},
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (*models.SingleRef)(nil), ""},
 			expectedVerb:     "update",
-			expectedResource: "objects/foo",
+			expectedResource: "objects/Article/foo",
 		},
 		testCase{
 			methodName:       "UpdateObjectReferences",
-			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (models.MultipleRef)(nil)},
+			additionalArgs:   []interface{}{strfmt.UUID("foo"), "some prop", (models.MultipleRef)(nil), ""},
 			expectedVerb:     "update",
-			expectedResource: "objects/foo",
+			expectedResource: "objects/Article/foo",
 		},
 	}
 
@@ -129,8 +133,10 @@ func Test_Kinds_Authorization(t *testing.T) {
 				vectorizer := &fakeVectorizer{}
 				vecProvider := &fakeVectorizerProvider{vectorizer}
 				vectorRepo := &fakeVectorRepo{}
+				vectorRepo.On("ObjectByID", mock.Anything, mock.Anything, mock.Anything).
+					Return(&search.Result{ClassName: "Article"}, nil)
 				manager := NewManager(locks, schemaManager,
-					cfg, logger, authorizer, vecProvider, vectorRepo, getFakeModulesProvider())
+					cfg, logger, authorizer, &fakeAuditor{}, vecProvider, vectorRepo, getFakeModulesProvider())
 
 				args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
 				out, _ := callFuncByName(manager, test.methodName, args...)
@@ -145,6 +151,148 @@ func Test_Kinds_Authorization(t *testing.T) {
 	})
 }
 
+func Test_Kinds_AuthorizationResources(t *testing.T) {
+	principal := &models.Principal{}
+	logger, _ := test.NewNullLogger()
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+
+	newManager := func(authorizer *authAllowList, stored *search.Result) *Manager {
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Objects: &models.Schema{
+					Aliases: []*models.Alias{{Alias: "Post", Class: "Article"}},
+				},
+			},
+		}
+		vectorRepo := &fakeVectorRepo{}
+		vectorRepo.On("ObjectByID", mock.Anything, mock.Anything, mock.Anything).
+			Return(stored, nil)
+		return NewManager(&fakeLocks{}, schemaManager, &config.WeaviateConfig{},
+			logger, authorizer, &fakeAuditor{}, &fakeVectorizerProvider{&fakeVectorizer{}},
+			vectorRepo, getFakeModulesProvider())
+	}
+
+	t.Run("an object which does not exist has no class", func(t *testing.T) {
+		authorizer := &authAllowList{}
+		manager := newManager(authorizer, nil)
+
+		_, err := manager.GetObject(context.Background(), principal, id,
+			traverser.AdditionalProperties{}, "")
+		assert.NotNil(t, err)
+		assert.Equal(t, []authorizeCall{{principal, "get", "objects/" + id.String()}},
+			authorizer.calls)
+	})
+
+	t.Run("an alias is resolved before authorizing", func(t *testing.T) {
+		authorizer := &authAllowList{}
+		manager := newManager(authorizer, nil)
+
+		_, err := manager.AddObject(context.Background(), principal,
+			&models.Object{Class: "Post"})
+		assert.NotNil(t, err)
+		assert.Equal(t, []authorizeCall{{principal, "create", "objects/Article"}},
+			authorizer.calls)
+	})
+
+	t.Run("moving an object to another class authorizes both", func(t *testing.T) {
+		authorizer := &authAllowList{allowed: []string{"objects/Article/" + id.String()}}
+		manager := newManager(authorizer, &search.Result{ID: id, ClassName: "Article"})
+
+		_, err := manager.UpdateObject(context.Background(), principal, id,
+			&models.Object{ID: id, Class: "Log"})
+		assert.NotNil(t, err)
+		assert.Equal(t, []authorizeCall{
+			{principal, "update", "objects/Article/" + id.String()},
+			{principal, "update", "objects/Log/" + id.String()},
+		}, authorizer.calls)
+	})
+}
+
+func Test_Kinds_AuthorizationListObjects(t *testing.T) {
+	principal := &models.Principal{}
+	logger, _ := test.NewNullLogger()
+	articleID := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+	logID := strfmt.UUID("9f5b3c4e-8d35-4a43-9c3b-70d0b2d1e0a7")
+
+	authorizer := &authAllowList{
+		allowed: []string{"objects", "objects/Article/" + articleID.String()},
+	}
+	vectorRepo := &fakeVectorRepo{}
+	vectorRepo.On("ObjectSearch", mock.Anything, mock.Anything, mock.Anything).
+		Return([]search.Result{
+			{ID: articleID, ClassName: "Article"},
+			{ID: logID, ClassName: "Log"},
+		}, nil)
+	manager := NewManager(&fakeLocks{}, &fakeSchemaManager{}, &config.WeaviateConfig{},
+		logger, authorizer, &fakeAuditor{}, &fakeVectorizerProvider{&fakeVectorizer{}},
+		vectorRepo, getFakeModulesProvider())
+
+	res, err := manager.GetObjects(context.Background(), principal, nil,
+		traverser.AdditionalProperties{}, "")
+	require.Nil(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, articleID, res[0].ID)
+}
+
+func Test_BatchKinds_AuthorizationResources(t *testing.T) {
+	principal := &models.Principal{}
+	logger, _ := test.NewNullLogger()
+	id := strfmt.UUID("5a1cd361-1e0d-42ae-bd52-ee09cb5f31cc")
+
+	newManager := func(authorizer *authAllowList) (*BatchManager, *fakeVectorRepo) {
+		schemaManager := &fakeSchemaManager{
+			GetSchemaResponse: schema.Schema{
+				Objects: &models.Schema{
+					Classes: []*models.Class{
+						{Class: "Log", Vectorizer: config.VectorizerModuleNone, VectorIndexConfig: hnsw.UserConfig{}},
+						{Class: "Article", Vectorizer: config.VectorizerModuleNone, VectorIndexConfig: hnsw.UserConfig{}},
+					},
+				},
+			},
+		}
+		vectorRepo := &fakeVectorRepo{}
+		return NewBatchManager(vectorRepo, &fakeVectorizerProvider{&fakeVectorizer{}},
+			&fakeLocks{}, schemaManager, &config.WeaviateConfig{}, logger, authorizer,
+			&fakeAuditor{}), vectorRepo
+	}
+
+	t.Run("objects are authorized per class", func(t *testing.T) {
+		authorizer := &authAllowList{
+			allowed: []string{"batch/objects", "objects/Log/" + id.String()},
+		}
+		manager, vectorRepo := newManager(authorizer)
+		vectorRepo.On("BatchPutObjects", mock.Anything).Return(nil)
+
+		res, err := manager.AddObjects(context.Background(), principal, []*models.Object{
+			{Class: "Log", ID: id, Vector: []float32{0.1}},
+			{Class: "Article", ID: id, Vector: []float32{0.1}},
+		}, []*string{})
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Nil(t, res[0].Err)
+		assert.Equal(t, errors.New("just a test fake"), res[1].Err)
+	})
+
+	t.Run("references are authorized on the class of their source", func(t *testing.T) {
+		authorizer := &authAllowList{
+			allowed: []string{"batch/*", "objects/Log/" + id.String()},
+		}
+		manager, vectorRepo := newManager(authorizer)
+		vectorRepo.On("AddBatchReferences", mock.Anything).Return(nil)
+
+		to := strfmt.URI("weaviate://localhost/" + id.String())
+		res, err := manager.AddReferences(context.Background(), principal,
+			[]*models.BatchReference{
+				{From: strfmt.URI("weaviate://localhost/Log/" + id.String() + "/about"), To: to},
+				{From: strfmt.URI("weaviate://localhost/Article/" + id.String() + "/about"), To: to},
+			})
+		require.Nil(t, err)
+		require.Len(t, res, 2)
+		assert.Nil(t, res[0].Err)
+		assert.NotNil(t, res[1].Err)
+	})
+}
+
 func Test_BatchKinds_Authorization(t *testing.T) {
 	type testCase struct {
 		methodName       string
@@ -198,7 +346,7 @@ func Test_BatchKinds_Authorization(t *testing.T) {
 			vectorRepo := &fakeVectorRepo{}
 			vectorizer := &fakeVectorizer{}
 			vecProvider := &fakeVectorizerProvider{vectorizer}
-			manager := NewBatchManager(vectorRepo, vecProvider, locks, schemaManager, cfg, logger, authorizer)
+			manager := NewBatchManager(vectorRepo, vecProvider, locks, schemaManager, cfg, logger, authorizer, &fakeAuditor{})
 
 			args := append([]interface{}{context.Background(), principal}, test.additionalArgs...)
 			out, _ := callFuncByName(manager, test.methodName, args...)
@@ -227,6 +375,22 @@ func (a *authDenier) Authorize(principal *models.Principal, verb, resource strin
 	return errors.New("just a test fake")
 }
 
+// authAllowList allows the resources on the list and denies all others
+type authAllowList struct {
+	allowed []string
+	calls   []authorizeCall
+}
+
+func (a *authAllowList) Authorize(principal *models.Principal, verb, resource string) error {
+	a.calls = append(a.calls, authorizeCall{principal, verb, resource})
+	for _, allowed := range a.allowed {
+		if allowed == resource {
+			return nil
+		}
+	}
+	return errors.New("just a test fake")
+}
+
 // inspired by https://stackoverflow.com/a/33008200
 func callFuncByName(manager interface{}, funcName string, params ...interface{}) (out []reflect.Value, err error) {
 	managerValue := reflect.ValueOf(manager)
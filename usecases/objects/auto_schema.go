@@ -173,6 +173,17 @@ func (m *autoSchemaManager) determineType(value interface{}) []schema.DataType {
 		return []schema.DataType{schema.DataTypeString}
 	case []interface{}:
 		if len(v) > 0 {
+			if _, ok := v[0].(map[string]interface{}); !ok {
+				// a list of primitive values, its type is determined by the first
+				// element, e.g. string[] for a list of strings
+				elemType := m.determineType(v[0])
+				if len(elemType) == 1 {
+					arrayType := schema.DataType(elemType[0] + "[]")
+					if schema.IsArrayDataType(arrayType) {
+						return []schema.DataType{arrayType}
+					}
+				}
+			}
 			crossRefs := []schema.DataType{}
 			for i := range v {
 				if values, ok := v[i].(map[string]interface{}); ok && len(values) > 0 {
@@ -182,7 +193,7 @@ func (m *autoSchemaManager) determineType(value interface{}) []schema.DataType {
 								ref, err := crossref.Parse(beacon)
 								if err == nil {
 									res, err := m.vectorRepo.ObjectByID(context.Background(), ref.TargetID,
-										traverser.SelectProperties{}, traverser.AdditionalProperties{})
+										traverser.SelectProperties{}, traverser.AdditionalProperties{}, "")
 									if err == nil && res != nil {
 										crossRefs = append(crossRefs, schema.DataType(res.ClassName))
 									}
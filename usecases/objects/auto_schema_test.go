@@ -149,6 +149,32 @@ func Test_autoSchemaManager_determineType(t *testing.T) {
 			},
 			want: []schema.DataType{schema.DataTypePhoneNumber},
 		},
+		{
+			name: "determine string array",
+			fields: fields{
+				config: config.AutoSchema{
+					Enabled:       true,
+					DefaultString: "string",
+				},
+			},
+			args: args{
+				value: []interface{}{"a", "b"},
+			},
+			want: []schema.DataType{schema.DataTypeStringArray},
+		},
+		{
+			name: "determine int array",
+			fields: fields{
+				config: config.AutoSchema{
+					Enabled:       true,
+					DefaultNumber: "int",
+				},
+			},
+			args: args{
+				value: []interface{}{json.Number("1"), json.Number("2")},
+			},
+			want: []schema.DataType{schema.DataTypeIntArray},
+		},
 		{
 			name: "determine cross reference",
 			fields: fields{
@@ -24,7 +24,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/usecases/objects/validation"
-	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
 // AddObjects Class Instances in batch to the connected DB
@@ -32,16 +31,31 @@ func (b *BatchManager) AddObjects(ctx context.Context, principal *models.Princip
 	objects []*models.Object, fields []*string) (BatchObjects, error) {
 	err := b.authorizer.Authorize(principal, "create", "batch/objects")
 	if err != nil {
+		b.auditor.Log(ctx, principal, "create", "objects", err)
 		return nil, err
 	}
 
 	unlock, err := b.locks.LockConnector()
 	if err != nil {
-		return nil, NewErrInternal("could not acquire lock: %v", err)
+		err = NewErrInternal("could not acquire lock: %v", err)
+		b.auditor.Log(ctx, principal, "create", "objects", err)
+		return nil, err
 	}
 	defer unlock()
 
-	return b.addObjects(ctx, principal, objects, fields)
+	res, err := b.addObjects(ctx, principal, objects, fields)
+	if err != nil {
+		b.auditor.Log(ctx, principal, "create", "objects", err)
+		return nil, err
+	}
+
+	// every object of the batch succeeds or fails on its own, so each of them
+	// gets its own record
+	for _, obj := range res {
+		b.auditor.Log(ctx, principal, "create", objectResource(obj.UUID), obj.Err)
+	}
+
+	return res, nil
 }
 
 func (b *BatchManager) addObjects(ctx context.Context, principal *models.Principal,
@@ -97,8 +111,25 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 
 	ec := &errorCompounder{}
 
+	err := resolveAlias(b.schemaManager, principal, concept)
+	ec.add(err)
+
+	// the batch permission does not grant access to every class, so each
+	// object is authorized on its class as well
+	err = b.authorizer.Authorize(principal, "create",
+		classObjectResource(concept.Class, concept.ID))
+	if err != nil {
+		*resultsC <- BatchObject{
+			UUID:          concept.ID,
+			Object:        &models.Object{ID: concept.ID, Class: concept.Class},
+			Err:           err,
+			OriginalIndex: originalIndex,
+		}
+		return
+	}
+
 	// Auto Schema
-	err := b.autoSchemaManager.autoSchema(ctx, principal, concept)
+	err = b.autoSchemaManager.autoSchema(ctx, principal, concept)
 	ec.add(err)
 
 	if concept.ID == "" {
@@ -122,6 +153,8 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	object.LastUpdateTimeUnix = 0
 	object.ID = id
 	object.Vector = concept.Vector
+	object.Vectors = concept.Vectors
+	object.Tenant = concept.Tenant
 
 	if _, ok := fieldsToKeep["class"]; ok {
 		object.Class = concept.Class
@@ -133,7 +166,9 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 		object.CreationTimeUnix = unixNow()
 	}
 
-	err = validation.New(s, b.exists, b.config).Object(ctx, object)
+	ec.add(validateTenant(b.schemaManager, s, concept.Class, concept.Tenant))
+
+	err = validation.New(s, b.existsFn(concept.Tenant), b.config).Object(ctx, object)
 	ec.add(err)
 
 	err = newVectorObtainer(b.vectorizerProvider, b.schemaManager,
@@ -149,9 +184,10 @@ func (b *BatchManager) validateObject(ctx context.Context, principal *models.Pri
 	}
 }
 
-func (b *BatchManager) exists(ctx context.Context, id strfmt.UUID) (bool, error) {
-	res, err := b.vectorRepo.ObjectByID(ctx, id, traverser.SelectProperties{}, traverser.AdditionalProperties{})
-	return res != nil, err
+func (b *BatchManager) existsFn(tenant string) func(context.Context, strfmt.UUID) (bool, error) {
+	return func(ctx context.Context, id strfmt.UUID) (bool, error) {
+		return b.vectorRepo.Exists(ctx, id, tenant)
+	}
 }
 
 func objectsChanToSlice(c chan BatchObject) BatchObjects {
@@ -26,6 +26,7 @@ type BatchManager struct {
 	schemaManager      schemaManager
 	logger             logrus.FieldLogger
 	authorizer         authorizer
+	auditor            auditor
 	vectorRepo         BatchVectorRepo
 	vectorizerProvider VectorizerProvider
 	autoSchemaManager  *autoSchemaManager
@@ -44,7 +45,7 @@ type batchRepoNew interface {
 // NewBatchManager creates a new manager
 func NewBatchManager(vectorRepo BatchVectorRepo, vectorizer VectorizerProvider,
 	locks locks, schemaManager schemaManager, config *config.WeaviateConfig,
-	logger logrus.FieldLogger, authorizer authorizer) *BatchManager {
+	logger logrus.FieldLogger, authorizer authorizer, auditor auditor) *BatchManager {
 	return &BatchManager{
 		config:             config,
 		locks:              locks,
@@ -53,6 +54,7 @@ func NewBatchManager(vectorRepo BatchVectorRepo, vectorizer VectorizerProvider,
 		vectorRepo:         vectorRepo,
 		vectorizerProvider: vectorizer,
 		authorizer:         authorizer,
+		auditor:            auditor,
 		autoSchemaManager:  newAutoSchemaManager(schemaManager, vectorRepo, config, logger),
 	}
 }
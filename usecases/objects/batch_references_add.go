@@ -26,24 +26,42 @@ func (b *BatchManager) AddReferences(ctx context.Context, principal *models.Prin
 	refs []*models.BatchReference) (BatchReferences, error) {
 	err := b.authorizer.Authorize(principal, "update", "batch/*")
 	if err != nil {
+		b.auditor.Log(ctx, principal, "update", "objects", err)
 		return nil, err
 	}
 
 	unlock, err := b.locks.LockSchema()
 	if err != nil {
-		return nil, NewErrInternal("could not acquire lock: %v", err)
+		err = NewErrInternal("could not acquire lock: %v", err)
+		b.auditor.Log(ctx, principal, "update", "objects", err)
+		return nil, err
 	}
 	defer unlock()
 
-	return b.addReferences(ctx, refs)
+	res, err := b.addReferences(ctx, principal, refs)
+	if err != nil {
+		b.auditor.Log(ctx, principal, "update", "objects", err)
+		return nil, err
+	}
+
+	for _, ref := range res {
+		resource := "objects"
+		if ref.From != nil {
+			resource = referenceResource(ref.From.TargetID, ref.From.Property.String())
+		}
+		b.auditor.Log(ctx, principal, "update", resource, ref.Err)
+	}
+
+	return res, nil
 }
 
-func (b *BatchManager) addReferences(ctx context.Context, refs []*models.BatchReference) (BatchReferences, error) {
+func (b *BatchManager) addReferences(ctx context.Context, principal *models.Principal,
+	refs []*models.BatchReference) (BatchReferences, error) {
 	if err := b.validateReferenceForm(refs); err != nil {
 		return nil, NewErrInvalidUserInput("invalid params: %v", err)
 	}
 
-	batchReferences := b.validateReferencesConcurrently(refs)
+	batchReferences := b.validateReferencesConcurrently(principal, refs)
 	if res, err := b.vectorRepo.AddBatchReferences(ctx, batchReferences); err != nil {
 		return nil, NewErrInternal("could not add batch request to connector: %v", err)
 	} else {
@@ -59,14 +77,15 @@ func (b *BatchManager) validateReferenceForm(refs []*models.BatchReference) erro
 	return nil
 }
 
-func (b *BatchManager) validateReferencesConcurrently(refs []*models.BatchReference) BatchReferences {
+func (b *BatchManager) validateReferencesConcurrently(principal *models.Principal,
+	refs []*models.BatchReference) BatchReferences {
 	c := make(chan BatchReference, len(refs))
 	wg := new(sync.WaitGroup)
 
 	// Generate a goroutine for each separate request
 	for i, ref := range refs {
 		wg.Add(1)
-		go b.validateReference(wg, ref, i, &c)
+		go b.validateReference(principal, wg, ref, i, &c)
 	}
 
 	wg.Wait()
@@ -74,8 +93,9 @@ func (b *BatchManager) validateReferencesConcurrently(refs []*models.BatchRefere
 	return referencesChanToSlice(c)
 }
 
-func (b *BatchManager) validateReference(wg *sync.WaitGroup, ref *models.BatchReference,
-	i int, resultsC *chan BatchReference) {
+func (b *BatchManager) validateReference(principal *models.Principal,
+	wg *sync.WaitGroup, ref *models.BatchReference, i int,
+	resultsC *chan BatchReference) {
 	defer wg.Done()
 	var errors []error
 	source, err := crossref.ParseSource(string(ref.From))
@@ -95,6 +115,16 @@ func (b *BatchManager) validateReference(wg *sync.WaitGroup, ref *models.BatchRe
 			target.PeerName))
 	}
 
+	if len(errors) == 0 {
+		// the batch permission does not grant access to every class, so the
+		// source object is authorized on its class as well
+		err = b.authorizer.Authorize(principal, "update",
+			classObjectResource(source.Class.String(), source.TargetID))
+		if err != nil {
+			errors = append(errors, err)
+		}
+	}
+
 	if len(errors) == 0 {
 		err = nil
 	} else {
@@ -106,6 +136,7 @@ func (b *BatchManager) validateReference(wg *sync.WaitGroup, ref *models.BatchRe
 		To:            target,
 		Err:           err,
 		OriginalIndex: i,
+		Tenant:        ref.Tenant,
 	}
 }
 
@@ -53,6 +53,7 @@ type BatchReference struct {
 	Err           error
 	From          *crossref.RefSource
 	To            *crossref.Ref
+	Tenant        string
 }
 
 // BatchReferences groups many Reference items together. The order matches the
@@ -13,16 +13,19 @@ package objects
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
-	"github.com/semi-technologies/weaviate/usecases/traverser"
 )
 
 // DeleteObject Class Instance from the conncected DB
-func (m *Manager) DeleteObject(ctx context.Context, principal *models.Principal, id strfmt.UUID) error {
-	err := m.authorizer.Authorize(principal, "delete", fmt.Sprintf("objects/%s", id.String()))
+func (m *Manager) DeleteObject(ctx context.Context, principal *models.Principal,
+	id strfmt.UUID, tenant string) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "delete", objectResource(id), err)
+	}()
+
+	className, err := m.authorizeStoredObject(ctx, principal, "delete", id, tenant)
 	if err != nil {
 		return err
 	}
@@ -33,17 +36,11 @@ func (m *Manager) DeleteObject(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.deleteObjectFromRepo(ctx, id)
-}
-
-func (m *Manager) deleteObjectFromRepo(ctx context.Context, id strfmt.UUID) error {
-	objectRes, err := m.getObjectFromRepo(ctx, id, traverser.AdditionalProperties{})
-	if err != nil {
-		return err
+	if className == "" {
+		return NewErrNotFound("no object with id '%s'", id)
 	}
 
-	object := objectRes.Object()
-	err = m.vectorRepo.DeleteObject(ctx, object.Class, id)
+	err = m.vectorRepo.DeleteObject(ctx, className, id, tenant)
 	if err != nil {
 		return NewErrInternal("could not delete object from vector repo: %v", err)
 	}
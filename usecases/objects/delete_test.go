@@ -41,7 +41,7 @@ func Test_Delete_Action(t *testing.T) {
 		logger, _ := test.NewNullLogger()
 		vectorizer := &fakeVectorizer{}
 		vecProvider := &fakeVectorizerProvider{vectorizer}
-		manager = NewManager(locks, schemaManager, cfg, logger, authorizer, vecProvider,
+		manager = NewManager(locks, schemaManager, cfg, logger, authorizer, &fakeAuditor{}, vecProvider,
 			vectorRepo, getFakeModulesProvider())
 	}
 
@@ -52,7 +52,7 @@ func Test_Delete_Action(t *testing.T) {
 	vectorRepo.On("DeleteObject", "MyAction", id).Return(nil).Once()
 
 	ctx := context.Background()
-	err := manager.DeleteObject(ctx, nil, id)
+	err := manager.DeleteObject(ctx, nil, id, "")
 
 	assert.Nil(t, err)
 
@@ -77,7 +77,7 @@ func Test_Delete_Thing(t *testing.T) {
 		logger, _ := test.NewNullLogger()
 		vectorizer := &fakeVectorizer{}
 		vecProvider := &fakeVectorizerProvider{vectorizer}
-		manager = NewManager(locks, schemaManager, cfg, logger, authorizer, vecProvider,
+		manager = NewManager(locks, schemaManager, cfg, logger, authorizer, &fakeAuditor{}, vecProvider,
 			vectorRepo, getFakeModulesProvider())
 	}
 
@@ -88,7 +88,7 @@ func Test_Delete_Thing(t *testing.T) {
 	vectorRepo.On("DeleteObject", "MyThing", id).Return(nil).Once()
 
 	ctx := context.Background()
-	err := manager.DeleteObject(ctx, nil, id)
+	err := manager.DeleteObject(ctx, nil, id, "")
 
 	assert.Nil(t, err)
 
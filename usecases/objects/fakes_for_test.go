@@ -39,6 +39,7 @@ type fakeSchemaManager struct {
 		toClass   string
 	}
 	GetSchemaResponse schema.Schema
+	TenantsResponse   map[string][]*models.Tenant
 }
 
 func (f *fakeSchemaManager) UpdatePropertyAddDataType(ctx context.Context, principal *models.Principal,
@@ -94,6 +95,10 @@ func (f *fakeSchemaManager) AddClassProperty(ctx context.Context, principal *mod
 	return nil
 }
 
+func (f *fakeSchemaManager) TenantsSkipAuth(className string) []*models.Tenant {
+	return f.TenantsResponse[className]
+}
+
 type fakeLocks struct{}
 
 func (f *fakeLocks) LockConnector() (func() error, error) {
@@ -132,24 +137,41 @@ func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource s
 	return nil
 }
 
+type fakeAuditRecord struct {
+	verb     string
+	resource string
+	err      error
+}
+
+type fakeAuditor struct {
+	records []fakeAuditRecord
+}
+
+func (f *fakeAuditor) Log(ctx context.Context, principal *models.Principal,
+	verb, resource string, err error) {
+	f.records = append(f.records, fakeAuditRecord{verb, resource, err})
+}
+
 type fakeVectorRepo struct {
 	mock.Mock
 }
 
 func (f *fakeVectorRepo) Exists(ctx context.Context,
-	id strfmt.UUID) (bool, error) {
+	id strfmt.UUID, tenant string) (bool, error) {
 	args := f.Called(id)
 	return args.Bool(0), args.Error(1)
 }
 
 func (f *fakeVectorRepo) ObjectByID(ctx context.Context,
-	id strfmt.UUID, props traverser.SelectProperties, additional traverser.AdditionalProperties) (*search.Result, error) {
+	id strfmt.UUID, props traverser.SelectProperties, additional traverser.AdditionalProperties,
+	tenant string) (*search.Result, error) {
 	args := f.Called(id, props, additional)
 	return args.Get(0).(*search.Result), args.Error(1)
 }
 
 func (f *fakeVectorRepo) ObjectSearch(ctx context.Context, limit int,
-	filters *filters.LocalFilter, additional traverser.AdditionalProperties) (search.Results, error) {
+	filters *filters.LocalFilter, additional traverser.AdditionalProperties,
+	tenant string) (search.Results, error) {
 	args := f.Called(limit, filters, additional)
 	return args.Get(0).([]search.Result), args.Error(1)
 }
@@ -176,14 +198,14 @@ func (f *fakeVectorRepo) Merge(ctx context.Context, merge MergeDocument) error {
 }
 
 func (f *fakeVectorRepo) DeleteObject(ctx context.Context,
-	className string, id strfmt.UUID) error {
+	className string, id strfmt.UUID, tenant string) error {
 	args := f.Called(className, id)
 	return args.Error(0)
 }
 
 func (f *fakeVectorRepo) AddReference(ctx context.Context,
 	class string, source strfmt.UUID, prop string,
-	ref *models.SingleRef) error {
+	ref *models.SingleRef, tenant string) error {
 	args := f.Called(source, prop, ref)
 	return args.Error(0)
 }
@@ -24,19 +24,37 @@ import (
 
 // GetObject Class from the connected DB
 func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, additional traverser.AdditionalProperties) (*models.Object, error) {
-	err := m.authorizer.Authorize(principal, "get", fmt.Sprintf("objects/%s", id.String()))
-	if err != nil {
-		return nil, err
-	}
-
+	id strfmt.UUID, additional traverser.AdditionalProperties,
+	tenant string) (*models.Object, error) {
 	unlock, err := m.locks.LockConnector()
 	if err != nil {
 		return nil, NewErrInternal("could not acquire lock: %v", err)
 	}
 	defer unlock()
 
-	res, err := m.getObjectFromRepo(ctx, id, additional)
+	// the object is read before it is authorized, as the permissions depend on
+	// its class
+	res, err := m.vectorRepo.ObjectByID(ctx, id, traverser.SelectProperties{},
+		additional, tenant)
+	if err != nil {
+		return nil, NewErrInternal("repo: object by id: %v", err)
+	}
+
+	className := ""
+	if res != nil {
+		className = res.ClassName
+	}
+
+	err = m.authorizer.Authorize(principal, "get", classObjectResource(className, id))
+	if err != nil {
+		return nil, err
+	}
+
+	if res == nil {
+		return nil, NewErrNotFound("no object with id '%s'", id)
+	}
+
+	res, err = m.extendObject(ctx, res, additional)
 	if err != nil {
 		return nil, err
 	}
@@ -46,7 +64,8 @@ func (m *Manager) GetObject(ctx context.Context, principal *models.Principal,
 
 // GetObjects Class from the connected DB
 func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
-	limit *int64, additional traverser.AdditionalProperties) ([]*models.Object, error) {
+	limit *int64, additional traverser.AdditionalProperties,
+	tenant string) ([]*models.Object, error) {
 	err := m.authorizer.Authorize(principal, "list", "objects")
 	if err != nil {
 		return nil, err
@@ -58,12 +77,35 @@ func (m *Manager) GetObjects(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
-	return m.getObjectsFromRepo(ctx, limit, additional)
+	objects, err := m.getObjectsFromRepo(ctx, limit, additional, tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.authorizedObjects(principal, objects), nil
+}
+
+// authorizedObjects removes the objects the principal may not read. Listing
+// objects is authorized across all classes, but permissions to read them can
+// be granted per class, so a page can contain fewer objects than the limit.
+func (m *Manager) authorizedObjects(principal *models.Principal,
+	objects []*models.Object) []*models.Object {
+	out := objects[:0]
+	for _, obj := range objects {
+		err := m.authorizer.Authorize(principal, "get",
+			classObjectResource(obj.Class, obj.ID))
+		if err == nil {
+			out = append(out, obj)
+		}
+	}
+
+	return out
 }
 
 func (m *Manager) getObjectFromRepo(ctx context.Context, id strfmt.UUID,
-	additional traverser.AdditionalProperties) (*search.Result, error) {
-	res, err := m.vectorRepo.ObjectByID(ctx, id, traverser.SelectProperties{}, additional)
+	additional traverser.AdditionalProperties, tenant string) (*search.Result, error) {
+	res, err := m.vectorRepo.ObjectByID(ctx, id, traverser.SelectProperties{},
+		additional, tenant)
 	if err != nil {
 		return nil, NewErrInternal("repo: object by id: %v", err)
 	}
@@ -72,21 +114,28 @@ func (m *Manager) getObjectFromRepo(ctx context.Context, id strfmt.UUID,
 		return nil, NewErrNotFound("no object with id '%s'", id)
 	}
 
-	if m.modulesProvider != nil {
-		res, err = m.modulesProvider.GetObjectAdditionalExtend(ctx, res, additional.ModuleParams)
-		if err != nil {
-			return nil, fmt.Errorf("get extend: %v", err)
-		}
+	return m.extendObject(ctx, res, additional)
+}
+
+func (m *Manager) extendObject(ctx context.Context, res *search.Result,
+	additional traverser.AdditionalProperties) (*search.Result, error) {
+	if m.modulesProvider == nil {
+		return res, nil
+	}
+
+	res, err := m.modulesProvider.GetObjectAdditionalExtend(ctx, res, additional.ModuleParams)
+	if err != nil {
+		return nil, fmt.Errorf("get extend: %v", err)
 	}
 
 	return res, nil
 }
 
 func (m *Manager) getObjectsFromRepo(ctx context.Context, limit *int64,
-	additional traverser.AdditionalProperties) ([]*models.Object, error) {
+	additional traverser.AdditionalProperties, tenant string) ([]*models.Object, error) {
 	smartLimit := m.localLimitOrGlobalLimit(limit)
 
-	res, err := m.vectorRepo.ObjectSearch(ctx, smartLimit, nil, additional)
+	res, err := m.vectorRepo.ObjectSearch(ctx, smartLimit, nil, additional, tenant)
 	if err != nil {
 		return nil, NewErrInternal("list objects: %v", err)
 	}
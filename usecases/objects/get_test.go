@@ -59,7 +59,7 @@ func Test_GetAction(t *testing.T) {
 		projectorFake = &fakeProjector{}
 		vectorizer := &fakeVectorizer{}
 		vecProvider := &fakeVectorizerProvider{vectorizer}
-		manager = NewManager(locks, schemaManager, cfg, logger, authorizer,
+		manager = NewManager(locks, schemaManager, cfg, logger, authorizer, &fakeAuditor{},
 			vecProvider, vectorRepo, getFakeModulesProviderWithCustomExtenders(extender, projectorFake))
 	}
 
@@ -69,7 +69,7 @@ func Test_GetAction(t *testing.T) {
 
 		vectorRepo.On("ObjectByID", id, mock.Anything, mock.Anything).Return((*search.Result)(nil), nil).Once()
 
-		_, err := manager.GetObject(context.Background(), &models.Principal{}, id, traverser.AdditionalProperties{})
+		_, err := manager.GetObject(context.Background(), &models.Principal{}, id, traverser.AdditionalProperties{}, "")
 		assert.Equal(t, NewErrNotFound("no object with id '99ee9968-22ec-416a-9032-cff80f2f7fdf'"), err)
 	})
 
@@ -91,7 +91,7 @@ func Test_GetAction(t *testing.T) {
 			VectorWeights: (map[string]string)(nil),
 		}
 
-		res, err := manager.GetObject(context.Background(), &models.Principal{}, id, traverser.AdditionalProperties{})
+		res, err := manager.GetObject(context.Background(), &models.Principal{}, id, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -118,7 +118,7 @@ func Test_GetAction(t *testing.T) {
 			},
 		}
 
-		res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, traverser.AdditionalProperties{})
+		res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -140,7 +140,7 @@ func Test_GetAction(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"featureProjection": getDefaultParam("featureProjection"),
 						},
-					})
+					}, "")
 				assert.Equal(t, errors.New("get extend: unknown capability: featureProjection"), err)
 			})
 
@@ -159,7 +159,7 @@ func Test_GetAction(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"semanticPath": getDefaultParam("semanticPath"),
 						},
-					})
+					}, "")
 				assert.Equal(t, errors.New("get extend: unknown capability: semanticPath"), err)
 			})
 
@@ -213,7 +213,7 @@ func Test_GetAction(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"nearestNeighbors": true,
 						},
-					})
+					}, "")
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -274,7 +274,7 @@ func Test_GetAction(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"nearestNeighbors": true,
 						},
-					})
+					}, "")
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -323,7 +323,7 @@ func Test_GetAction(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"featureProjection": getDefaultParam("featureProjection"),
 						},
-					})
+					}, "")
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -362,7 +362,7 @@ func Test_GetThing(t *testing.T) {
 		projectorFake = &fakeProjector{}
 		vectorizer := &fakeVectorizer{}
 		vecProvider := &fakeVectorizerProvider{vectorizer}
-		manager = NewManager(locks, schemaManager, cfg, logger, authorizer,
+		manager = NewManager(locks, schemaManager, cfg, logger, authorizer, &fakeAuditor{},
 			vecProvider, vectorRepo, getFakeModulesProviderWithCustomExtenders(extender, projectorFake))
 	}
 
@@ -372,7 +372,7 @@ func Test_GetThing(t *testing.T) {
 
 		vectorRepo.On("ObjectByID", id, mock.Anything, mock.Anything).Return((*search.Result)(nil), nil).Once()
 
-		_, err := manager.GetObject(context.Background(), &models.Principal{}, id, traverser.AdditionalProperties{})
+		_, err := manager.GetObject(context.Background(), &models.Principal{}, id, traverser.AdditionalProperties{}, "")
 		assert.Equal(t, NewErrNotFound("no object with id '99ee9968-22ec-416a-9032-cff80f2f7fdf'"), err)
 	})
 
@@ -394,7 +394,7 @@ func Test_GetThing(t *testing.T) {
 			VectorWeights: (map[string]string)(nil),
 		}
 
-		res, err := manager.GetObject(context.Background(), &models.Principal{}, id, traverser.AdditionalProperties{})
+		res, err := manager.GetObject(context.Background(), &models.Principal{}, id, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -421,7 +421,7 @@ func Test_GetThing(t *testing.T) {
 			},
 		}
 
-		res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, traverser.AdditionalProperties{})
+		res, err := manager.GetObjects(context.Background(), &models.Principal{}, nil, traverser.AdditionalProperties{}, "")
 		require.Nil(t, err)
 		assert.Equal(t, expected, res)
 	})
@@ -443,7 +443,7 @@ func Test_GetThing(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"featureProjection": getDefaultParam("featureProjection"),
 						},
-					})
+					}, "")
 				assert.Equal(t, errors.New("get extend: unknown capability: featureProjection"), err)
 			})
 
@@ -497,7 +497,7 @@ func Test_GetThing(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"nearestNeighbors": true,
 						},
-					})
+					}, "")
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -558,7 +558,7 @@ func Test_GetThing(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"nearestNeighbors": true,
 						},
-					})
+					}, "")
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -607,7 +607,7 @@ func Test_GetThing(t *testing.T) {
 						ModuleParams: map[string]interface{}{
 							"featureProjection": getDefaultParam("featureProjection"),
 						},
-					})
+					}, "")
 				require.Nil(t, err)
 				assert.Equal(t, expected, res)
 			})
@@ -38,6 +38,7 @@ type Manager struct {
 	schemaManager      schemaManager
 	logger             logrus.FieldLogger
 	authorizer         authorizer
+	auditor            auditor
 	vectorizerProvider VectorizerProvider
 	vectorRepo         VectorRepo
 	timeSource         timeSource
@@ -66,19 +67,78 @@ type authorizer interface {
 	Authorize(principal *models.Principal, verb, resource string) error
 }
 
+// auditor records the outcome of every change to the data
+type auditor interface {
+	Log(ctx context.Context, principal *models.Principal, verb, resource string, err error)
+}
+
+func objectResource(id strfmt.UUID) string {
+	if id == "" {
+		return "objects"
+	}
+	return fmt.Sprintf("objects/%s", id)
+}
+
+func referenceResource(id strfmt.UUID, propName string) string {
+	return fmt.Sprintf("objects/%s/references/%s", id, propName)
+}
+
+// classObjectResource is the resource an object is authorized on. It contains
+// the class, so that permissions can be granted per class, e.g.
+// "objects/Article/*". Without an id it refers to all objects of the class,
+// without a class to an object which does not exist.
+func classObjectResource(className string, id strfmt.UUID) string {
+	switch {
+	case className == "" && id == "":
+		return "objects"
+	case className == "":
+		return fmt.Sprintf("objects/%s", id)
+	case id == "":
+		return fmt.Sprintf("objects/%s", className)
+	default:
+		return fmt.Sprintf("objects/%s/%s", className, id)
+	}
+}
+
+// authorizeStoredObject authorizes the verb on an object which is only known
+// by its id, so its class is looked up first. The class is returned, it is
+// empty if the object does not exist.
+func (m *Manager) authorizeStoredObject(ctx context.Context,
+	principal *models.Principal, verb string, id strfmt.UUID,
+	tenant string) (string, error) {
+	res, err := m.vectorRepo.ObjectByID(ctx, id, nil,
+		traverser.AdditionalProperties{}, tenant)
+	if err != nil {
+		return "", NewErrInternal("repo: object by id: %v", err)
+	}
+
+	className := ""
+	if res != nil {
+		className = res.ClassName
+	}
+
+	err = m.authorizer.Authorize(principal, verb, classObjectResource(className, id))
+	if err != nil {
+		return "", err
+	}
+
+	return className, nil
+}
+
 type VectorRepo interface {
 	PutObject(ctx context.Context, concept *models.Object, vector []float32) error
-	DeleteObject(ctx context.Context, className string, id strfmt.UUID) error
+	DeleteObject(ctx context.Context, className string, id strfmt.UUID,
+		tenant string) error
 
 	ObjectByID(ctx context.Context, id strfmt.UUID, props traverser.SelectProperties,
-		additional traverser.AdditionalProperties) (*search.Result, error)
+		additional traverser.AdditionalProperties, tenant string) (*search.Result, error)
 	ObjectSearch(ctx context.Context, limit int, filters *filters.LocalFilter,
-		additional traverser.AdditionalProperties) (search.Results, error)
+		additional traverser.AdditionalProperties, tenant string) (search.Results, error)
 
-	Exists(ctx context.Context, id strfmt.UUID) (bool, error)
+	Exists(ctx context.Context, id strfmt.UUID, tenant string) (bool, error)
 
 	AddReference(ctx context.Context, className string,
-		source strfmt.UUID, propName string, ref *models.SingleRef) error
+		source strfmt.UUID, propName string, ref *models.SingleRef, tenant string) error
 	Merge(ctx context.Context, merge MergeDocument) error
 }
 
@@ -92,8 +152,8 @@ type ModulesProvider interface {
 // NewManager creates a new manager
 func NewManager(locks locks, schemaManager schemaManager,
 	config *config.WeaviateConfig, logger logrus.FieldLogger,
-	authorizer authorizer, vectorizer VectorizerProvider, vectorRepo VectorRepo,
-	modulesProvider ModulesProvider) *Manager {
+	authorizer authorizer, auditor auditor, vectorizer VectorizerProvider,
+	vectorRepo VectorRepo, modulesProvider ModulesProvider) *Manager {
 	return &Manager{
 		config:             config,
 		locks:              locks,
@@ -101,6 +161,7 @@ func NewManager(locks locks, schemaManager schemaManager,
 		logger:             logger,
 		vectorizerProvider: vectorizer,
 		authorizer:         authorizer,
+		auditor:            auditor,
 		vectorRepo:         vectorRepo,
 		timeSource:         defaultTimeSource{},
 		modulesProvider:    modulesProvider,
@@ -29,13 +29,24 @@ type MergeDocument struct {
 	PrimitiveSchema      map[string]interface{}
 	References           BatchReferences
 	Vector               []float32
+	Vectors              map[string][]float32
 	UpdateTime           int64
 	AdditionalProperties models.AdditionalProperties
+	Tenant               string
 }
 
 func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, updated *models.Object) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("objects/%s", id.String()))
+	id strfmt.UUID, updated *models.Object) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", objectResource(id), err)
+	}()
+
+	tenant := ""
+	if updated != nil {
+		tenant = updated.Tenant
+	}
+
+	_, err = m.authorizeStoredObject(ctx, principal, "update", id, tenant)
 	if err != nil {
 		return err
 	}
@@ -64,6 +75,16 @@ func (m *Manager) MergeObject(ctx context.Context, principal *models.Principal,
 		References:      refs,
 		Vector:          objWithVec.Vector,
 		UpdateTime:      m.timeSource.Now(),
+		Tenant:          updated.Tenant,
+	}
+
+	if len(updated.Vectors) > 0 {
+		// named vectors are merged by name, vectors which are not part of the
+		// update are left untouched
+		mergeDoc.Vectors = make(map[string][]float32, len(updated.Vectors))
+		for name, vector := range updated.Vectors {
+			mergeDoc.Vectors[name] = vector
+		}
 	}
 
 	if objWithVec.Additional != nil {
@@ -84,7 +105,12 @@ func (m *Manager) retrievePreviousAndValidateMergeObject(ctx context.Context, pr
 		return nil, fmt.Errorf("class is a required (and immutable) field")
 	}
 
-	object, err := m.vectorRepo.ObjectByID(ctx, id, nil, traverser.AdditionalProperties{})
+	if err := resolveAlias(m.schemaManager, principal, updated); err != nil {
+		return nil, err
+	}
+
+	object, err := m.vectorRepo.ObjectByID(ctx, id, nil, traverser.AdditionalProperties{},
+		updated.Tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -266,7 +266,7 @@ func Test_MergeObject(t *testing.T) {
 			vectorizer := &fakeVectorizer{}
 			vecProvider := &fakeVectorizerProvider{vectorizer}
 			manager := NewManager(locks, schemaManager,
-				cfg, logger, authorizer, vecProvider, vectorRepo, getFakeModulesProvider())
+				cfg, logger, authorizer, &fakeAuditor{}, vecProvider, vectorRepo, getFakeModulesProvider())
 			manager.timeSource = fakeTimeSource{}
 
 			if test.previous != nil {
@@ -465,7 +465,7 @@ func Test_MergeThing(t *testing.T) {
 			vectorizer := &fakeVectorizer{}
 			vecProvider := &fakeVectorizerProvider{vectorizer}
 			manager := NewManager(locks, schemaManager,
-				cfg, logger, authorizer, vecProvider, vectorRepo, getFakeModulesProvider())
+				cfg, logger, authorizer, &fakeAuditor{}, vecProvider, vectorRepo, getFakeModulesProvider())
 			manager.timeSource = fakeTimeSource{}
 
 			if test.previous != nil {
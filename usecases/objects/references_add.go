@@ -13,7 +13,6 @@ package objects
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -26,8 +25,13 @@ import (
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
 func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("objects/%s", id.String()))
+	id strfmt.UUID, propertyName string, property *models.SingleRef,
+	tenant string) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", referenceResource(id, propertyName), err)
+	}()
+
+	_, err = m.authorizeStoredObject(ctx, principal, "update", id, tenant)
 	if err != nil {
 		return err
 	}
@@ -38,20 +42,21 @@ func (m *Manager) AddObjectReference(ctx context.Context, principal *models.Prin
 	}
 	defer unlock()
 
-	return m.addObjectReferenceToConnectorAndSchema(ctx, principal, id, propertyName, property)
+	return m.addObjectReferenceToConnectorAndSchema(ctx, principal, id, propertyName,
+		property, tenant)
 }
 
 func (m *Manager) addObjectReferenceToConnectorAndSchema(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
+	id strfmt.UUID, propertyName string, property *models.SingleRef, tenant string) error {
 	// get object to see if it exists
-	objectRes, err := m.getObjectFromRepo(ctx, id, traverser.AdditionalProperties{})
+	objectRes, err := m.getObjectFromRepo(ctx, id, traverser.AdditionalProperties{}, tenant)
 	if err != nil {
 		return err
 	}
 
 	object := objectRes.Object()
 
-	err = m.validateReference(ctx, property)
+	err = m.validateReference(ctx, property, tenant)
 	if err != nil {
 		return err
 	}
@@ -62,7 +67,7 @@ func (m *Manager) addObjectReferenceToConnectorAndSchema(ctx context.Context, pr
 	}
 
 	err = m.vectorRepo.AddReference(ctx, object.Class, object.ID,
-		propertyName, property)
+		propertyName, property, tenant)
 	if err != nil {
 		return NewErrInternal("add reference to vector repo: %v", err)
 	}
@@ -70,8 +75,9 @@ func (m *Manager) addObjectReferenceToConnectorAndSchema(ctx context.Context, pr
 	return nil
 }
 
-func (m *Manager) validateReference(ctx context.Context, reference *models.SingleRef) error {
-	err := validation.New(schema.Schema{}, m.exists, m.config).
+func (m *Manager) validateReference(ctx context.Context, reference *models.SingleRef,
+	tenant string) error {
+	err := validation.New(schema.Schema{}, m.existsFn(tenant), m.config).
 		ValidateSingleRef(ctx, reference, "reference not found")
 	if err != nil {
 		return NewErrInvalidUserInput("invalid reference: %v", err)
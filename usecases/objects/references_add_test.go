@@ -48,7 +48,7 @@ func Test_ReferencesAdd(t *testing.T) {
 		vectorizer = &fakeVectorizer{}
 		vecProvider := &fakeVectorizerProvider{vectorizer}
 		manager = NewManager(locks, schemaManager,
-			cfg, logger, authorizer, vecProvider, vectorRepo, getFakeModulesProvider())
+			cfg, logger, authorizer, &fakeAuditor{}, vecProvider, vectorRepo, getFakeModulesProvider())
 	}
 
 	t.Run("without prior refs", func(t *testing.T) {
@@ -70,7 +70,7 @@ func Test_ReferencesAdd(t *testing.T) {
 		expectedRefProperty := "hasAnimals"
 		vectorRepo.On("AddReference", mock.Anything, expectedRefProperty, expectedRef).Return(nil)
 
-		err := manager.AddObjectReference(context.Background(), nil, strfmt.UUID("my-id"), "hasAnimals", newRef)
+		err := manager.AddObjectReference(context.Background(), nil, strfmt.UUID("my-id"), "hasAnimals", newRef, "")
 		require.Nil(t, err)
 		vectorRepo.AssertExpectations(t)
 	})
@@ -13,7 +13,6 @@ package objects
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -22,8 +21,13 @@ import (
 
 // DeleteObjectReference from connected DB
 func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("objects/%s", id.String()))
+	id strfmt.UUID, propertyName string, property *models.SingleRef,
+	tenant string) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", referenceResource(id, propertyName), err)
+	}()
+
+	_, err = m.authorizeStoredObject(ctx, principal, "update", id, tenant)
 	if err != nil {
 		return err
 	}
@@ -34,13 +38,14 @@ func (m *Manager) DeleteObjectReference(ctx context.Context, principal *models.P
 	}
 	defer unlock()
 
-	return m.deleteObjectReferenceFromConnector(ctx, principal, id, propertyName, property)
+	return m.deleteObjectReferenceFromConnector(ctx, principal, id, propertyName,
+		property, tenant)
 }
 
 func (m *Manager) deleteObjectReferenceFromConnector(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, property *models.SingleRef) error {
+	id strfmt.UUID, propertyName string, property *models.SingleRef, tenant string) error {
 	// get object to see if it exists
-	objectRes, err := m.getObjectFromRepo(ctx, id, traverser.AdditionalProperties{})
+	objectRes, err := m.getObjectFromRepo(ctx, id, traverser.AdditionalProperties{}, tenant)
 	if err != nil {
 		return err
 	}
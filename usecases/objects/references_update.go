@@ -13,7 +13,6 @@ package objects
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -26,8 +25,13 @@ import (
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
 func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, refs models.MultipleRef) error {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("objects/%s", id.String()))
+	id strfmt.UUID, propertyName string, refs models.MultipleRef,
+	tenant string) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", referenceResource(id, propertyName), err)
+	}()
+
+	_, err = m.authorizeStoredObject(ctx, principal, "update", id, tenant)
 	if err != nil {
 		return err
 	}
@@ -38,19 +42,20 @@ func (m *Manager) UpdateObjectReferences(ctx context.Context, principal *models.
 	}
 	defer unlock()
 
-	return m.updateObjectReferenceToConnectorAndSchema(ctx, principal, id, propertyName, refs)
+	return m.updateObjectReferenceToConnectorAndSchema(ctx, principal, id, propertyName,
+		refs, tenant)
 }
 
 func (m *Manager) updateObjectReferenceToConnectorAndSchema(ctx context.Context, principal *models.Principal,
-	id strfmt.UUID, propertyName string, refs models.MultipleRef) error {
+	id strfmt.UUID, propertyName string, refs models.MultipleRef, tenant string) error {
 	// get object to see if it exists
-	objectRes, err := m.getObjectFromRepo(ctx, id, traverser.AdditionalProperties{})
+	objectRes, err := m.getObjectFromRepo(ctx, id, traverser.AdditionalProperties{}, tenant)
 	if err != nil {
 		return err
 	}
 
 	object := objectRes.Object()
-	err = m.validateReferences(ctx, refs)
+	err = m.validateReferences(ctx, refs, tenant)
 	if err != nil {
 		return err
 	}
@@ -75,8 +80,9 @@ func (m *Manager) updateObjectReferenceToConnectorAndSchema(ctx context.Context,
 	return nil
 }
 
-func (m *Manager) validateReferences(ctx context.Context, references models.MultipleRef) error {
-	err := validation.New(schema.Schema{}, m.exists, m.config).
+func (m *Manager) validateReferences(ctx context.Context, references models.MultipleRef,
+	tenant string) error {
+	err := validation.New(schema.Schema{}, m.existsFn(tenant), m.config).
 		ValidateMultipleRef(ctx, references, "reference not found")
 	if err != nil {
 		return NewErrInvalidUserInput("invalid references: %v", err)
@@ -0,0 +1,59 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package objects
+
+import (
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+type tenantsGetter interface {
+	TenantsSkipAuth(className string) []*models.Tenant
+}
+
+// validateTenant makes sure that an object of a class with multi-tenancy
+// enabled belongs to an active tenant and that objects of any other class
+// are not assigned to a tenant
+func validateTenant(tg tenantsGetter, s schema.Schema, className,
+	tenant string) error {
+	class := s.FindClassByName(schema.ClassName(className))
+	if class == nil {
+		// unknown classes are reported by the regular object validation
+		return nil
+	}
+
+	if !schema.MultiTenancyEnabled(class) {
+		if tenant != "" {
+			return NewErrInvalidUserInput("class %s does not have multi-tenancy "+
+				"enabled, but request was made with tenant %q", class.Class, tenant)
+		}
+		return nil
+	}
+
+	if tenant == "" {
+		return NewErrInvalidUserInput("class %s has multi-tenancy enabled, but "+
+			"request was made without tenant", class.Class)
+	}
+
+	for _, t := range tg.TenantsSkipAuth(class.Class) {
+		if t.Name != tenant {
+			continue
+		}
+
+		if t.ActivityStatus == models.TenantActivityStatusINACTIVE {
+			return NewErrInvalidUserInput("tenant %q is not active", tenant)
+		}
+		return nil
+	}
+
+	return NewErrInvalidUserInput("tenant %q not found", tenant)
+}
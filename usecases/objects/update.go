@@ -13,7 +13,6 @@ package objects
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -24,8 +23,17 @@ import (
 // ref, it has a side-effect on the schema: The schema will be updated to
 // include this particular network ref class.
 func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal, id strfmt.UUID,
-	class *models.Object) (*models.Object, error) {
-	err := m.authorizer.Authorize(principal, "update", fmt.Sprintf("objects/%s", id.String()))
+	class *models.Object) (_ *models.Object, err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", objectResource(id), err)
+	}()
+
+	tenant := ""
+	if class != nil {
+		tenant = class.Tenant
+	}
+
+	_, err = m.authorizeStoredObject(ctx, principal, "update", id, tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -45,11 +53,26 @@ func (m *Manager) updateObjectToConnectorAndSchema(ctx context.Context, principa
 		return nil, NewErrInvalidUserInput("invalid update: field 'id' is immutable")
 	}
 
-	originalObject, err := m.getObjectFromRepo(ctx, id, traverser.AdditionalProperties{})
+	if err := resolveAlias(m.schemaManager, principal, class); err != nil {
+		return nil, err
+	}
+
+	originalObject, err := m.getObjectFromRepo(ctx, id, traverser.AdditionalProperties{},
+		class.Tenant)
 	if err != nil {
 		return nil, err
 	}
 
+	if class.Class != originalObject.ClassName {
+		// the object is written to another class than the one it is stored in,
+		// which has to be authorized as well
+		err = m.authorizer.Authorize(principal, "update",
+			classObjectResource(class.Class, id))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	m.logger.
 		WithField("object", "kinds_update_requested").
 		WithField("original", originalObject).
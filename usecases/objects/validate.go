@@ -14,6 +14,7 @@ package objects
 import (
 	"context"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
 )
 
@@ -21,7 +22,17 @@ import (
 // async validation before submitting
 func (m *Manager) ValidateObject(ctx context.Context, principal *models.Principal,
 	class *models.Object) error {
-	err := m.authorizer.Authorize(principal, "validate", "objects")
+	var className string
+	var id strfmt.UUID
+	if class != nil {
+		if err := resolveAlias(m.schemaManager, principal, class); err != nil {
+			return err
+		}
+		className, id = class.Class, class.ID
+	}
+
+	err := m.authorizer.Authorize(principal, "validate",
+		classObjectResource(className, id))
 	if err != nil {
 		return err
 	}
@@ -32,6 +43,11 @@ func (m *Manager) ValidateObject(ctx context.Context, principal *models.Principa
 	}
 	defer unlock()
 
+	err = resolveAlias(m.schemaManager, principal, class)
+	if err != nil {
+		return err
+	}
+
 	err = m.validateObject(ctx, principal, class)
 	if err != nil {
 		return NewErrInvalidUserInput("invalid object: %v", err)
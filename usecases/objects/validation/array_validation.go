@@ -0,0 +1,62 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// arrayVal validates the value of an array property, such as string[] or
+// int[]. Each element is parsed like a value of the corresponding
+// non-array data type.
+func arrayVal(val interface{}, dataType schema.DataType) ([]interface{}, error) {
+	elemType, ok := schema.ArrayElementDataType(dataType)
+	if !ok {
+		return nil, fmt.Errorf("unrecognized array data type '%s'", dataType)
+	}
+
+	list, ok := val.([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("not a list, but %T", val)
+	}
+
+	out := make([]interface{}, len(list))
+	for i, elem := range list {
+		data, err := arrayElementVal(elem, elemType)
+		if err != nil {
+			return nil, fmt.Errorf("element %d: %s", i, err)
+		}
+
+		out[i] = data
+	}
+
+	return out, nil
+}
+
+func arrayElementVal(val interface{}, elemType schema.DataType) (interface{}, error) {
+	switch elemType {
+	case schema.DataTypeString, schema.DataTypeText:
+		return stringVal(val)
+	case schema.DataTypeInt:
+		return intVal(val)
+	case schema.DataTypeNumber:
+		return numberVal(val)
+	case schema.DataTypeBoolean:
+		return boolVal(val)
+	case schema.DataTypeDate:
+		return dateVal(val)
+	default:
+		return nil, fmt.Errorf("unrecognized data type '%s'", elemType)
+	}
+}
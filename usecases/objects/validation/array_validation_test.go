@@ -0,0 +1,97 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropertyOfArrayTypeValidation(t *testing.T) {
+	type test struct {
+		name           string
+		propName       string
+		value          interface{}
+		expectedErr    error
+		expectedResult interface{}
+	}
+
+	tests := []test{
+		test{
+			name:     "string[] of wrong type",
+			propName: "tags",
+			value:    "green",
+			expectedErr: errors.New("invalid string[] property 'tags' on class 'Person': " +
+				"not a list, but string"),
+		},
+		test{
+			name:     "string[] with element of wrong type",
+			propName: "tags",
+			value:    []interface{}{"green", float64(7)},
+			expectedErr: errors.New("invalid string[] property 'tags' on class 'Person': " +
+				"element 1: not a string, but float64"),
+		},
+		test{
+			name:           "valid string[]",
+			propName:       "tags",
+			value:          []interface{}{"green", "blue"},
+			expectedResult: []interface{}{"green", "blue"},
+		},
+		test{
+			name:     "int[] with decimal element",
+			propName: "scores",
+			value:    []interface{}{float64(1), float64(2.5)},
+			expectedErr: errors.New("invalid int[] property 'scores' on class 'Person': " +
+				"element 1: requires an integer, the given value is '2.5'"),
+		},
+		test{
+			name:           "valid int[]",
+			propName:       "scores",
+			value:          []interface{}{float64(1), float64(2)},
+			expectedResult: []interface{}{float64(1), float64(2)},
+		},
+		test{
+			name:           "empty int[]",
+			propName:       "scores",
+			value:          []interface{}{},
+			expectedResult: []interface{}{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := &config.WeaviateConfig{}
+			validator := New(testSchema(), fakeExists, config)
+
+			obj := &models.Object{
+				Class: "Person",
+				Properties: map[string]interface{}{
+					test.propName: test.value,
+				},
+			}
+			err := validator.properties(context.Background(), obj)
+			assert.Equal(t, test.expectedErr, err)
+			if err != nil {
+				return
+			}
+			value, ok := obj.Properties.(map[string]interface{})[test.propName]
+			require.True(t, ok)
+			assert.Equal(t, test.expectedResult, value)
+		})
+	}
+}
@@ -34,6 +34,38 @@ func testSchema() schema.Schema {
 							Name:     "phone",
 							DataType: []string{"phoneNumber"},
 						},
+						&models.Property{
+							Name:     "tags",
+							DataType: []string{string(schema.DataTypeStringArray)},
+						},
+						&models.Property{
+							Name:     "scores",
+							DataType: []string{string(schema.DataTypeIntArray)},
+						},
+						&models.Property{
+							Name:     "addresses",
+							DataType: []string{string(schema.DataTypeObjectArray)},
+							NestedProperties: []*models.NestedProperty{
+								{
+									Name:     "city",
+									DataType: []string{string(schema.DataTypeString)},
+								},
+								{
+									Name:     "since",
+									DataType: []string{string(schema.DataTypeDate)},
+								},
+								{
+									Name:     "location",
+									DataType: []string{string(schema.DataTypeObject)},
+									NestedProperties: []*models.NestedProperty{
+										{
+											Name:     "floor",
+											DataType: []string{string(schema.DataTypeInt)},
+										},
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -77,9 +77,42 @@ func (v *Validator) Object(ctx context.Context, object *models.Object) error {
 		return err
 	}
 
+	if err := v.namedVectors(object); err != nil {
+		return err
+	}
+
 	return v.properties(ctx, object)
 }
 
+func (v *Validator) namedVectors(object *models.Object) error {
+	if len(object.Vectors) == 0 {
+		return nil
+	}
+
+	class := v.schema.GetClass(schema.ClassName(object.Class))
+	if class == nil {
+		return fmt.Errorf("class '%s' not present in schema", object.Class)
+	}
+
+	allowed := map[string]bool{}
+	for _, name := range class.NamedVectors {
+		allowed[name] = true
+	}
+
+	for name, vector := range object.Vectors {
+		if !allowed[name] {
+			return fmt.Errorf("named vector '%s' is not configured in the namedVectors "+
+				"of class '%s'", name, object.Class)
+		}
+
+		if len(vector) == 0 {
+			return fmt.Errorf("named vector '%s' is empty", name)
+		}
+	}
+
+	return nil
+}
+
 func validateClass(class string) error {
 	// If the given class is empty, return an error
 	if class == "" {
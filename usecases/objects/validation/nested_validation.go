@@ -0,0 +1,109 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package validation
+
+import (
+	"fmt"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// nestedVal validates the value of an object or object[] property against
+// the nested properties of the schema. Nested values are parsed like their
+// top-level counterparts, values of unknown nested properties are rejected.
+func nestedVal(val interface{}, dataType schema.DataType,
+	nested []*models.NestedProperty) (interface{}, error) {
+	switch dataType {
+	case schema.DataTypeObject:
+		return nestedObjectVal(val, nested)
+	case schema.DataTypeObjectArray:
+		list, ok := val.([]interface{})
+		if !ok {
+			return nil, fmt.Errorf("not a list of objects, but %T", val)
+		}
+
+		out := make([]interface{}, len(list))
+		for i, elem := range list {
+			obj, err := nestedObjectVal(elem, nested)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %s", i, err)
+			}
+
+			out[i] = obj
+		}
+
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unrecognized nested data type '%s'", dataType)
+	}
+}
+
+func nestedObjectVal(val interface{},
+	nested []*models.NestedProperty) (map[string]interface{}, error) {
+	obj, ok := val.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("not an object, but %T", val)
+	}
+
+	out := make(map[string]interface{}, len(obj))
+	for key, value := range obj {
+		prop := findNestedProperty(nested, key)
+		if prop == nil {
+			return nil, fmt.Errorf("no such nested property '%s'", key)
+		}
+
+		data, err := nestedPropertyVal(prop, value)
+		if err != nil {
+			return nil, fmt.Errorf("nested property '%s': %s", key, err)
+		}
+
+		out[key] = data
+	}
+
+	return out, nil
+}
+
+func nestedPropertyVal(prop *models.NestedProperty, val interface{}) (interface{}, error) {
+	if len(prop.DataType) != 1 {
+		return nil, fmt.Errorf("invalid dataType %v", prop.DataType)
+	}
+
+	dataType := schema.DataType(prop.DataType[0])
+	switch dataType {
+	case schema.DataTypeString, schema.DataTypeText:
+		return stringVal(val)
+	case schema.DataTypeInt:
+		return intVal(val)
+	case schema.DataTypeNumber:
+		return numberVal(val)
+	case schema.DataTypeBoolean:
+		return boolVal(val)
+	case schema.DataTypeDate:
+		return dateVal(val)
+	case schema.DataTypeObject, schema.DataTypeObjectArray:
+		return nestedVal(val, dataType, prop.NestedProperties)
+	default:
+		return nil, fmt.Errorf("unrecognized data type '%s'", dataType)
+	}
+}
+
+func findNestedProperty(nested []*models.NestedProperty,
+	name string) *models.NestedProperty {
+	for _, prop := range nested {
+		if prop.Name == name {
+			return prop
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package validation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropertyOfTypeObjectArrayValidation(t *testing.T) {
+	type test struct {
+		name           string
+		addresses      interface{} // "addresses" property in schema
+		expectedErr    error
+		expectedResult interface{}
+	}
+
+	tests := []test{
+		test{
+			name:      "addresses of wrong type",
+			addresses: map[string]interface{}{"city": "Amsterdam"},
+			expectedErr: errors.New("invalid object[] property 'addresses' on class 'Person': " +
+				"not a list of objects, but map[string]interface {}"),
+		},
+		test{
+			name:      "element of wrong type",
+			addresses: []interface{}{"Amsterdam"},
+			expectedErr: errors.New("invalid object[] property 'addresses' on class 'Person': " +
+				"element 0: not an object, but string"),
+		},
+		test{
+			name: "unknown nested property",
+			addresses: []interface{}{
+				map[string]interface{}{"country": "Netherlands"},
+			},
+			expectedErr: errors.New("invalid object[] property 'addresses' on class 'Person': " +
+				"element 0: no such nested property 'country'"),
+		},
+		test{
+			name: "deeply nested value of wrong type",
+			addresses: []interface{}{
+				map[string]interface{}{
+					"location": map[string]interface{}{"floor": "third"},
+				},
+			},
+			expectedErr: errors.New("invalid object[] property 'addresses' on class 'Person': " +
+				"element 0: nested property 'location': nested property 'floor': " +
+				"requires an integer, the given value is 'third'"),
+		},
+		test{
+			name: "valid nested objects",
+			addresses: []interface{}{
+				map[string]interface{}{
+					"city":  "Amsterdam",
+					"since": "2021-01-01T00:00:00Z",
+					"location": map[string]interface{}{
+						"floor": float64(3),
+					},
+				},
+				map[string]interface{}{
+					"city": "Berlin",
+				},
+			},
+			expectedResult: []interface{}{
+				map[string]interface{}{
+					"city":  "Amsterdam",
+					"since": time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+					"location": map[string]interface{}{
+						"floor": float64(3),
+					},
+				},
+				map[string]interface{}{
+					"city": "Berlin",
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			config := &config.WeaviateConfig{}
+			validator := New(testSchema(), fakeExists, config)
+
+			obj := &models.Object{
+				Class: "Person",
+				Properties: map[string]interface{}{
+					"addresses": test.addresses,
+				},
+			}
+			err := validator.properties(context.Background(), obj)
+			assert.Equal(t, test.expectedErr, err)
+			if err != nil {
+				return
+			}
+			addresses, ok := obj.Properties.(map[string]interface{})["addresses"]
+			require.True(t, ok)
+			assert.Equal(t, test.expectedResult, addresses)
+		})
+	}
+}
@@ -71,9 +71,23 @@ func (v *Validator) properties(ctx context.Context, object interface{}) error {
 			return err
 		}
 
-		data, err := v.extractAndValidateProperty(ctx, propertyKey, propertyValue, className, dataType)
-		if err != nil {
-			return err
+		var data interface{}
+		if *dataType == schema.DataTypeObject || *dataType == schema.DataTypeObjectArray {
+			prop, err := schema.GetPropertyByName(class, propertyKey)
+			if err != nil {
+				return err
+			}
+
+			data, err = nestedVal(propertyValue, *dataType, prop.NestedProperties)
+			if err != nil {
+				return fmt.Errorf("invalid %s property '%s' on class '%s': %s",
+					*dataType, propertyKey, className, err)
+			}
+		} else {
+			data, err = v.extractAndValidateProperty(ctx, propertyKey, propertyValue, className, dataType)
+			if err != nil {
+				return err
+			}
 		}
 
 		returnSchema[propertyKey] = data
@@ -143,6 +157,12 @@ func (v *Validator) extractAndValidateProperty(ctx context.Context, propertyName
 		if err != nil {
 			return nil, fmt.Errorf("invalid blob property '%s' on class '%s': %s", propertyName, className, err)
 		}
+	case schema.DataTypeStringArray, schema.DataTypeTextArray, schema.DataTypeIntArray,
+		schema.DataTypeNumberArray, schema.DataTypeBooleanArray, schema.DataTypeDateArray:
+		data, err = arrayVal(pv, *dataType)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s property '%s' on class '%s': %s", *dataType, propertyName, className, err)
+		}
 
 	default:
 		return nil, fmt.Errorf("unrecognized data type '%s'", *dataType)
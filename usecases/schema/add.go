@@ -18,13 +18,19 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/usecases/config"
 )
 
 // AddClass to the schema
 func (m *Manager) AddClass(ctx context.Context, principal *models.Principal,
-	class *models.Class) error {
-	err := m.authorizer.Authorize(principal, "create", "schema/objects")
+	class *models.Class) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "create", classResource(class.Class), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "create",
+		classResource(upperCaseClassName(class.Class)))
 	if err != nil {
 		return err
 	}
@@ -39,6 +45,7 @@ func (m *Manager) addClass(ctx context.Context, principal *models.Principal,
 
 	class.Class = upperCaseClassName(class.Class)
 	class.Properties = lowerCaseAllPropertyNames(class.Properties)
+	lowerCasePropertyAnalyzerNames(class.InvertedIndexConfig)
 	m.setClassDefaults(class)
 
 	err := m.validateCanAddClass(ctx, principal, class)
@@ -89,7 +96,19 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 		return err
 	}
 
-	err = m.validateClassName(ctx, class.Class)
+	sch, err := m.GetSchema(principal)
+	if err != nil {
+		return err
+	}
+
+	return m.validateClass(ctx, class, sch)
+}
+
+// validateClass validates a new class, the data types of its properties are
+// looked up in the given schema
+func (m *Manager) validateClass(ctx context.Context, class *models.Class,
+	sch schema.Schema) error {
+	err := m.validateClassName(ctx, class.Class)
 	if err != nil {
 		return err
 	}
@@ -110,15 +129,26 @@ func (m *Manager) validateCanAddClass(ctx context.Context, principal *models.Pri
 		foundNames[property.Name] = true
 
 		// Validate data type of property.
-		schema, err := m.GetSchema(principal)
+		_, err = (&sch).FindPropertyDataType(property.DataType)
 		if err != nil {
-			return err
+			return fmt.Errorf("property '%s': invalid dataType: %v", property.Name, err)
 		}
 
-		_, err = (&schema).FindPropertyDataType(property.DataType)
-		if err != nil {
-			return fmt.Errorf("property '%s': invalid dataType: %v", property.Name, err)
+		if err := validateNestedProperties(property); err != nil {
+			return fmt.Errorf("property '%s': %v", property.Name, err)
 		}
+
+		if err := schema.ValidateTokenization(property); err != nil {
+			return fmt.Errorf("property '%s': %v", property.Name, err)
+		}
+
+		if err := schema.ValidateIndexTrigrams(property); err != nil {
+			return fmt.Errorf("property '%s': %v", property.Name, err)
+		}
+	}
+
+	if err := validateInvertedIndexConfig(class); err != nil {
+		return err
 	}
 
 	err = m.validateVectorSettings(ctx, class)
@@ -173,6 +203,20 @@ func lowerCaseAllPropertyNames(props []*models.Property) []*models.Property {
 	return props
 }
 
+// lowerCasePropertyAnalyzerNames makes sure the analyzers of individual
+// properties match the lowercased property names
+func lowerCasePropertyAnalyzerNames(cfg *models.InvertedIndexConfig) {
+	if cfg == nil || len(cfg.PropertyAnalyzers) == 0 {
+		return
+	}
+
+	analyzers := make(map[string]models.AnalyzerConfig, len(cfg.PropertyAnalyzers))
+	for name, analyzer := range cfg.PropertyAnalyzers {
+		analyzers[lowerCaseFirstLetter(name)] = analyzer
+	}
+	cfg.PropertyAnalyzers = analyzers
+}
+
 func lowerCaseFirstLetter(name string) string {
 	if len(name) < 1 {
 		return name
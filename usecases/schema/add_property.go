@@ -21,8 +21,12 @@ import (
 
 // AddClassProperty to an existing Class
 func (m *Manager) AddClassProperty(ctx context.Context, principal *models.Principal,
-	class string, property *models.Property) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	class string, property *models.Property) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", propertyResource(class, property.Name), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "update", classResource(class))
 	if err != nil {
 		return err
 	}
@@ -60,6 +64,18 @@ func (m *Manager) addClassProperty(ctx context.Context, principal *models.Princi
 
 func (m *Manager) validateCanAddProperty(ctx context.Context, principal *models.Principal,
 	property *models.Property, class *models.Class) error {
+	sch, err := m.GetSchema(principal)
+	if err != nil {
+		return err
+	}
+
+	return m.validateProperty(ctx, property, class, sch)
+}
+
+// validateProperty validates a new property of an existing class, its data
+// type is looked up in the given schema
+func (m *Manager) validateProperty(ctx context.Context, property *models.Property,
+	class *models.Class, sch schema.Schema) error {
 	// Verify format of property.
 	_, err := schema.ValidatePropertyName(property.Name)
 	if err != nil {
@@ -79,14 +95,21 @@ func (m *Manager) validateCanAddProperty(ctx context.Context, principal *models.
 	}
 
 	// Validate data type of property.
-	schema, err := m.GetSchema(principal)
+	_, err = (&sch).FindPropertyDataType(property.DataType)
 	if err != nil {
-		return err
+		return fmt.Errorf("Data type of property '%s' is invalid; %v", property.Name, err)
 	}
 
-	_, err = (&schema).FindPropertyDataType(property.DataType)
-	if err != nil {
-		return fmt.Errorf("Data type of property '%s' is invalid; %v", property.Name, err)
+	if err := validateNestedProperties(property); err != nil {
+		return fmt.Errorf("property '%s': %v", property.Name, err)
+	}
+
+	if err := schema.ValidateTokenization(property); err != nil {
+		return fmt.Errorf("property '%s': %v", property.Name, err)
+	}
+
+	if err := schema.ValidateIndexTrigrams(property); err != nil {
+		return fmt.Errorf("property '%s': %v", property.Name, err)
 	}
 
 	// all is fine!
@@ -0,0 +1,188 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+func aliasResource(alias string) string {
+	return fmt.Sprintf("schema/aliases/%s", alias)
+}
+
+// CreateAlias adds an alternative name for an existing class. Queries and
+// objects using the alias are resolved to the class it points to.
+func (m *Manager) CreateAlias(ctx context.Context, principal *models.Principal,
+	alias *models.Alias) (_ *models.Alias, err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "create", aliasResource(alias.Alias), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "create", aliasResource(alias.Alias))
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	created := &models.Alias{
+		Alias: upperCaseClassName(alias.Alias),
+		Class: upperCaseClassName(alias.Class),
+	}
+
+	if _, err := schema.ValidateClassName(created.Alias); err != nil {
+		return nil, errors.Wrap(err, "invalid alias")
+	}
+
+	if err := m.validateClassNameUniqueness(created.Alias); err != nil {
+		return nil, err
+	}
+
+	if err := m.validateAliasTarget(created.Class); err != nil {
+		return nil, err
+	}
+
+	m.state.ObjectSchema.Aliases = append(m.state.ObjectSchema.Aliases, created)
+	if err := m.saveSchema(ctx); err != nil {
+		return nil, err
+	}
+
+	return copyAlias(created), nil
+}
+
+// UpdateAlias points an existing alias to another class. All subsequent
+// queries and objects using the alias are resolved to the new class, which
+// allows switching clients over to a rebuilt class at once.
+func (m *Manager) UpdateAlias(ctx context.Context, principal *models.Principal,
+	aliasName string, alias *models.Alias) (_ *models.Alias, err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", aliasResource(aliasName), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "update", aliasResource(aliasName))
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	existing := m.getAlias(upperCaseClassName(aliasName))
+	if existing == nil {
+		return nil, ErrNotFound
+	}
+
+	if alias.Alias != "" && upperCaseClassName(alias.Alias) != existing.Alias {
+		return nil, errors.Errorf("alias name is immutable: attempted change from %q to %q",
+			existing.Alias, alias.Alias)
+	}
+
+	className := upperCaseClassName(alias.Class)
+	if err := m.validateAliasTarget(className); err != nil {
+		return nil, err
+	}
+
+	previous := existing.Class
+	existing.Class = className
+	if err := m.saveSchema(ctx); err != nil {
+		existing.Class = previous
+		return nil, err
+	}
+
+	return copyAlias(existing), nil
+}
+
+// DeleteAlias removes an alias, the class it points to is not affected
+func (m *Manager) DeleteAlias(ctx context.Context, principal *models.Principal,
+	aliasName string) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "delete", aliasResource(aliasName), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "delete", aliasResource(aliasName))
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	aliasName = upperCaseClassName(aliasName)
+	aliases := m.state.ObjectSchema.Aliases
+	for i, alias := range aliases {
+		if alias.Alias != aliasName {
+			continue
+		}
+
+		remaining := make([]*models.Alias, 0, len(aliases)-1)
+		remaining = append(remaining, aliases[:i]...)
+		remaining = append(remaining, aliases[i+1:]...)
+		m.state.ObjectSchema.Aliases = remaining
+		if err := m.saveSchema(ctx); err != nil {
+			m.state.ObjectSchema.Aliases = aliases
+			return err
+		}
+
+		return nil
+	}
+
+	return ErrNotFound
+}
+
+func (m *Manager) getAlias(name string) *models.Alias {
+	s := schema.Schema{
+		Objects: m.state.ObjectSchema,
+	}
+
+	return s.GetAlias(name)
+}
+
+// validateAliasTarget makes sure an alias points to an actual class, aliases
+// of aliases are not supported, so an alias can always be resolved in a
+// single step
+func (m *Manager) validateAliasTarget(className string) error {
+	if className == "" {
+		return errors.New("alias needs to point to a class")
+	}
+
+	if m.getClassByName(className) == nil {
+		if m.getAlias(className) != nil {
+			return errors.Errorf("%q is an alias itself, aliases need to point to a class",
+				className)
+		}
+		return errors.Errorf("class %q does not exist", className)
+	}
+
+	return nil
+}
+
+// aliasesOfClass returns the names of all aliases pointing to the given class
+func (m *Manager) aliasesOfClass(className string) []string {
+	var names []string
+	for _, alias := range m.state.ObjectSchema.Aliases {
+		if alias.Class == className {
+			names = append(names, alias.Alias)
+		}
+	}
+
+	return names
+}
+
+func copyAlias(alias *models.Alias) *models.Alias {
+	return &models.Alias{Alias: alias.Alias, Class: alias.Class}
+}
@@ -0,0 +1,133 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Aliases(t *testing.T) {
+	ctx := context.Background()
+	m := newSchemaManager()
+
+	for _, name := range []string{"OldArticle", "NewArticle"} {
+		require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+			Class:      name,
+			Vectorizer: "none",
+		}))
+	}
+
+	t.Run("creating invalid aliases", func(t *testing.T) {
+		tests := []struct {
+			name  string
+			alias *models.Alias
+		}{
+			{name: "invalid name", alias: &models.Alias{Alias: "Art-icle", Class: "OldArticle"}},
+			{name: "name of a class", alias: &models.Alias{Alias: "NewArticle", Class: "OldArticle"}},
+			{name: "missing class", alias: &models.Alias{Alias: "Article"}},
+			{name: "non-existing class", alias: &models.Alias{Alias: "Article", Class: "Unknown"}},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := m.CreateAlias(ctx, nil, test.alias)
+				assert.NotNil(t, err)
+			})
+		}
+
+		assert.Len(t, m.GetSchemaSkipAuth().Objects.Aliases, 0)
+	})
+
+	t.Run("creating a valid alias", func(t *testing.T) {
+		version := m.GetSchemaSkipAuth().Objects.Version
+
+		alias, err := m.CreateAlias(ctx, nil,
+			&models.Alias{Alias: "article", Class: "OldArticle"})
+		require.Nil(t, err)
+		assert.Equal(t, &models.Alias{Alias: "Article", Class: "OldArticle"}, alias)
+
+		s := m.GetSchemaSkipAuth()
+		assert.Equal(t, "OldArticle", s.ResolveAlias("Article"))
+		assert.Equal(t, version+1, s.Objects.Version)
+	})
+
+	t.Run("creating the same alias again", func(t *testing.T) {
+		_, err := m.CreateAlias(ctx, nil,
+			&models.Alias{Alias: "Article", Class: "NewArticle"})
+		assert.EqualError(t, err, "Name 'Article' already used as a name for an alias")
+	})
+
+	t.Run("adding a class with the name of an alias", func(t *testing.T) {
+		err := m.AddClass(ctx, nil, &models.Class{Class: "Article", Vectorizer: "none"})
+		assert.EqualError(t, err, "Name 'Article' already used as a name for an alias")
+	})
+
+	t.Run("pointing an alias to another alias", func(t *testing.T) {
+		_, err := m.CreateAlias(ctx, nil,
+			&models.Alias{Alias: "Post", Class: "Article"})
+		assert.EqualError(t, err,
+			`"Article" is an alias itself, aliases need to point to a class`)
+	})
+
+	t.Run("repointing the alias", func(t *testing.T) {
+		alias, err := m.UpdateAlias(ctx, nil, "Article",
+			&models.Alias{Class: "NewArticle"})
+		require.Nil(t, err)
+		assert.Equal(t, &models.Alias{Alias: "Article", Class: "NewArticle"}, alias)
+
+		s := m.GetSchemaSkipAuth()
+		assert.Equal(t, "NewArticle", s.ResolveAlias("Article"))
+	})
+
+	t.Run("repointing the alias to a non-existing class", func(t *testing.T) {
+		_, err := m.UpdateAlias(ctx, nil, "Article",
+			&models.Alias{Class: "Unknown"})
+		assert.EqualError(t, err, `class "Unknown" does not exist`)
+
+		s := m.GetSchemaSkipAuth()
+		assert.Equal(t, "NewArticle", s.ResolveAlias("Article"))
+	})
+
+	t.Run("renaming the alias", func(t *testing.T) {
+		_, err := m.UpdateAlias(ctx, nil, "Article",
+			&models.Alias{Alias: "Post", Class: "NewArticle"})
+		assert.EqualError(t, err,
+			`alias name is immutable: attempted change from "Article" to "Post"`)
+	})
+
+	t.Run("updating a non-existing alias", func(t *testing.T) {
+		_, err := m.UpdateAlias(ctx, nil, "Unknown",
+			&models.Alias{Class: "NewArticle"})
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("deleting the class the alias points to", func(t *testing.T) {
+		err := m.DeleteClass(ctx, nil, "NewArticle")
+		assert.EqualError(t, err, "class 'NewArticle' is still used by alias(es) "+
+			"Article, repoint or delete them first")
+	})
+
+	t.Run("deleting the previous class", func(t *testing.T) {
+		require.Nil(t, m.DeleteClass(ctx, nil, "OldArticle"))
+	})
+
+	t.Run("deleting the alias", func(t *testing.T) {
+		require.Nil(t, m.DeleteAlias(ctx, nil, "Article"))
+		assert.Len(t, m.GetSchemaSkipAuth().Objects.Aliases, 0)
+		assert.Equal(t, ErrNotFound, m.DeleteAlias(ctx, nil, "Article"))
+	})
+}
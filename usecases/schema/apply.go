@@ -0,0 +1,234 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// ApplySchema executes the additive changes between the live schema and the
+// submitted one, i.e. it adds missing classes and properties. Either all of
+// them are applied or none. All other changes are only reported. On a dry
+// run the changes are validated, but not executed. If a version is set, the
+// changes are only applied if the live schema still has this version. As the
+// diff reveals the whole schema, it requires the permission to list it, the
+// changes themselves are authorized for each class they touch.
+func (m *Manager) ApplySchema(ctx context.Context, principal *models.Principal,
+	submitted *models.Schema, dryRun bool, version *int64) (diff *models.SchemaDiff, err error) {
+	defer func() {
+		if !dryRun {
+			m.auditor.Log(ctx, principal, "create", "schema/*", err)
+		}
+	}()
+
+	err = m.authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	return m.applySchema(ctx, principal, submitted, dryRun, version)
+}
+
+func (m *Manager) applySchema(ctx context.Context, principal *models.Principal,
+	submitted *models.Schema, dryRun bool, version *int64) (*models.SchemaDiff, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	live := m.state.ObjectSchema
+	if version != nil && *version != live.Version {
+		return nil, errors.Wrapf(ErrSchemaVersionConflict,
+			"live schema is at version %d, expected version %d", live.Version, *version)
+	}
+
+	changes, err := diffSchema(live, submitted)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := m.planSchemaChanges(ctx, submitted, changes)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := m.authorizeSchemaChanges(principal, plan); err != nil {
+		return nil, err
+	}
+
+	diff := &models.SchemaDiff{Changes: changes, Version: live.Version}
+	if dryRun {
+		return diff, nil
+	}
+
+	if len(plan.classes) > 0 || len(plan.properties) > 0 {
+		if err := m.executeSchemaChanges(ctx, plan); err != nil {
+			return nil, err
+		}
+	}
+
+	diff.Applied = true
+	diff.Version = live.Version
+	return diff, nil
+}
+
+// authorizeSchemaChanges authorizes the creation of every class the plan adds
+// and the update of every class it adds properties to
+func (m *Manager) authorizeSchemaChanges(principal *models.Principal,
+	plan *schemaChangePlan) error {
+	for _, class := range plan.classes {
+		err := m.authorizer.Authorize(principal, "create", classResource(class.Class))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, planned := range plan.properties {
+		err := m.authorizer.Authorize(principal, "update",
+			classResource(planned.class.Class))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// schemaChangePlan contains the validated additive changes of a diff
+type schemaChangePlan struct {
+	classes    []*models.Class
+	properties []plannedProperty
+}
+
+type plannedProperty struct {
+	class *models.Class
+	prop  *models.Property
+}
+
+func (m *Manager) planSchemaChanges(ctx context.Context,
+	submitted *models.Schema, changes []*models.SchemaChange) (*schemaChangePlan, error) {
+	plan := &schemaChangePlan{}
+	for _, change := range changes {
+		if change.Action == models.SchemaChangeActionAddClass {
+			plan.classes = append(plan.classes, findClass(submitted, change.Class))
+		}
+	}
+
+	// the new classes may reference each other, so data types are looked up in
+	// the schema as it will be once all changes are applied
+	live := m.state.ObjectSchema
+	classes := make([]*models.Class, 0, len(live.Classes)+len(plan.classes))
+	classes = append(classes, live.Classes...)
+	classes = append(classes, plan.classes...)
+	applied := schema.Schema{Objects: &models.Schema{Classes: classes}}
+
+	for _, class := range plan.classes {
+		if err := m.validateClassNameUniqueness(class.Class); err != nil {
+			return nil, errors.Wrapf(err, "add class %q", class.Class)
+		}
+
+		m.setClassDefaults(class)
+
+		if err := m.validateClass(ctx, class, applied); err != nil {
+			return nil, errors.Wrapf(err, "add class %q", class.Class)
+		}
+
+		if err := m.parseVectorIndexConfig(ctx, class); err != nil {
+			return nil, errors.Wrapf(err, "add class %q", class.Class)
+		}
+	}
+
+	for _, change := range changes {
+		if change.Action != models.SchemaChangeActionAddProperty {
+			continue
+		}
+
+		class := findClass(live, change.Class)
+		prop := findProperty(findClass(submitted, change.Class), change.Property)
+		if err := m.validateProperty(ctx, prop, class, applied); err != nil {
+			return nil, errors.Wrapf(err, "add property %q to class %q",
+				prop.Name, class.Class)
+		}
+
+		plan.properties = append(plan.properties, plannedProperty{class, prop})
+	}
+
+	return plan, nil
+}
+
+// executeSchemaChanges migrates and persists the planned changes. If any of
+// them fails, the ones executed so far are rolled back.
+func (m *Manager) executeSchemaChanges(ctx context.Context,
+	plan *schemaChangePlan) (err error) {
+	live := m.state.ObjectSchema
+	classes := live.Classes
+	props := map[*models.Class][]*models.Property{}
+	var rollbacks []func() error
+
+	defer func() {
+		if err == nil {
+			return
+		}
+
+		live.Classes = classes
+		for class, classProps := range props {
+			class.Properties = classProps
+		}
+
+		for i := len(rollbacks) - 1; i >= 0; i-- {
+			if rollbackErr := rollbacks[i](); rollbackErr != nil {
+				m.logger.
+					WithField("action", "schema_apply").
+					WithError(rollbackErr).
+					Error("could not roll back schema change")
+			}
+		}
+	}()
+
+	for _, class := range plan.classes {
+		live.Classes = append(live.Classes, class)
+		if err := m.migrator.AddClass(ctx, class); err != nil {
+			return errors.Wrapf(err, "add class %q", class.Class)
+		}
+
+		className := class.Class
+		rollbacks = append(rollbacks, func() error {
+			return m.migrator.DropClass(context.Background(), className)
+		})
+	}
+
+	for _, planned := range plan.properties {
+		class, prop := planned.class, planned.prop
+		if _, ok := props[class]; !ok {
+			props[class] = class.Properties
+		}
+
+		class.Properties = append(class.Properties, prop)
+		if err := m.migrator.AddProperty(ctx, class.Class, prop); err != nil {
+			return errors.Wrapf(err, "add property %q to class %q",
+				prop.Name, class.Class)
+		}
+
+		className, propName := class.Class, prop.Name
+		rollbacks = append(rollbacks, func() error {
+			return m.migrator.DropProperty(context.Background(), className, propName)
+		})
+	}
+
+	if err := m.saveSchema(ctx); err != nil {
+		return errors.Wrap(err, "save schema")
+	}
+
+	return nil
+}
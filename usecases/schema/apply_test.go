@@ -0,0 +1,192 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ApplySchema(t *testing.T) {
+	ctx := context.Background()
+
+	liveClass := func() *models.Class {
+		return &models.Class{
+			Class: "Article",
+			Properties: []*models.Property{
+				{Name: "title", DataType: []string{"string"}},
+				{Name: "wordCount", DataType: []string{"int"}},
+			},
+		}
+	}
+
+	submitted := func() *models.Schema {
+		return &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Article",
+					Properties: []*models.Property{
+						{Name: "title", DataType: []string{"string"}},
+						{Name: "wordCount", DataType: []string{"number"}},
+						{Name: "publishedIn", DataType: []string{"Publication"}},
+					},
+				},
+				{
+					Class: "Publication",
+					Properties: []*models.Property{
+						{Name: "name", DataType: []string{"string"}},
+					},
+				},
+			},
+		}
+	}
+
+	t.Run("a dry run does not change the schema", func(t *testing.T) {
+		m := newSchemaManager()
+		require.Nil(t, m.AddClass(ctx, nil, liveClass()))
+
+		diff, err := m.ApplySchema(ctx, nil, submitted(), true, nil)
+		require.Nil(t, err)
+
+		assert.False(t, diff.Applied)
+		assert.Equal(t, int64(1), diff.Version)
+		assert.Len(t, diff.Changes, 3)
+
+		live := m.GetSchemaSkipAuth()
+		assert.Len(t, live.Objects.Classes, 1)
+		assert.Len(t, live.Objects.Classes[0].Properties, 2)
+		assert.Equal(t, int64(1), live.Objects.Version)
+	})
+
+	t.Run("a dry run validates the changes", func(t *testing.T) {
+		m := newSchemaManager()
+		require.Nil(t, m.AddClass(ctx, nil, liveClass()))
+
+		invalid := submitted()
+		invalid.Classes[0].Properties[2].DataType = []string{"Unknown"}
+
+		_, err := m.ApplySchema(ctx, nil, invalid, true, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("applying the additive changes", func(t *testing.T) {
+		m := newSchemaManager()
+		require.Nil(t, m.AddClass(ctx, nil, liveClass()))
+
+		diff, err := m.ApplySchema(ctx, nil, submitted(), false, nil)
+		require.Nil(t, err)
+
+		assert.True(t, diff.Applied)
+		assert.Equal(t, int64(2), diff.Version, "all changes result in one version")
+
+		live := m.GetSchemaSkipAuth()
+		assert.Equal(t, int64(2), live.Objects.Version)
+		require.Len(t, live.Objects.Classes, 2)
+		assert.Equal(t, "Publication", live.Objects.Classes[1].Class)
+
+		article := live.FindClassByName("Article")
+		require.NotNil(t, article)
+		require.Len(t, article.Properties, 3)
+		assert.Equal(t, "publishedIn", article.Properties[2].Name)
+		assert.Equal(t, []string{"int"}, article.Properties[1].DataType,
+			"non-additive changes are not applied")
+
+		t.Run("applying the same schema again", func(t *testing.T) {
+			diff, err := m.ApplySchema(ctx, nil, submitted(), false, nil)
+			require.Nil(t, err)
+
+			assert.True(t, diff.Applied)
+			assert.Equal(t, int64(2), diff.Version)
+			assert.Equal(t, []*models.SchemaChange{{
+				Action:      models.SchemaChangeActionUpdateProperty,
+				Class:       "Article",
+				Property:    "wordCount",
+				Description: "settings differ: dataType",
+			}}, diff.Changes)
+		})
+	})
+
+	t.Run("applying against an outdated version", func(t *testing.T) {
+		m := newSchemaManager()
+		require.Nil(t, m.AddClass(ctx, nil, liveClass()))
+
+		version := int64(0)
+		_, err := m.ApplySchema(ctx, nil, submitted(), false, &version)
+		assert.Equal(t, ErrSchemaVersionConflict, errors.Cause(err))
+
+		version = 1
+		_, err = m.ApplySchema(ctx, nil, submitted(), false, &version)
+		assert.Nil(t, err)
+	})
+
+	t.Run("a failing migration rolls back all changes", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		migrator := &failingAddPropertyMigrator{}
+		m, err := NewManager(migrator, newFakeRepo(), logger, &fakeAuthorizer{},
+			&fakeAuditor{}, config.Config{DefaultVectorizerModule: config.VectorizerModuleNone},
+			dummyParseVectorConfig, &fakeVectorizerValidator{}, &fakeModuleConfig{})
+		require.Nil(t, err)
+		require.Nil(t, m.AddClass(ctx, nil, liveClass()))
+
+		_, err = m.ApplySchema(ctx, nil, submitted(), false, nil)
+		assert.NotNil(t, err)
+
+		assert.Equal(t, []string{"Publication"}, migrator.droppedClasses)
+		live := m.GetSchemaSkipAuth()
+		assert.Equal(t, int64(1), live.Objects.Version)
+		require.Len(t, live.Objects.Classes, 1)
+		assert.Len(t, live.Objects.Classes[0].Properties, 2)
+	})
+
+	t.Run("a failing save does not change the version", func(t *testing.T) {
+		logger, _ := test.NewNullLogger()
+		repo := newFakeRepo()
+		m, err := NewManager(&NilMigrator{}, repo, logger, &fakeAuthorizer{},
+			&fakeAuditor{}, config.Config{DefaultVectorizerModule: config.VectorizerModuleNone},
+			dummyParseVectorConfig, &fakeVectorizerValidator{}, &fakeModuleConfig{})
+		require.Nil(t, err)
+		require.Nil(t, m.AddClass(ctx, nil, liveClass()))
+
+		repo.saveErr = errors.New("storage unavailable")
+		_, err = m.ApplySchema(ctx, nil, submitted(), false, nil)
+		assert.NotNil(t, err)
+
+		assert.NotNil(t, m.AddClass(ctx, nil, &models.Class{Class: "Publication"}))
+
+		live := m.GetSchemaSkipAuth()
+		assert.Equal(t, int64(1), live.Objects.Version)
+		assert.Equal(t, int64(1), repo.schema.ObjectSchema.Version)
+	})
+}
+
+type failingAddPropertyMigrator struct {
+	NilMigrator
+	droppedClasses []string
+}
+
+func (f *failingAddPropertyMigrator) AddProperty(ctx context.Context,
+	className string, prop *models.Property) error {
+	return errors.New("add property failed")
+}
+
+func (f *failingAddPropertyMigrator) DropClass(ctx context.Context,
+	className string) error {
+	f.droppedClasses = append(f.droppedClasses, className)
+	return nil
+}
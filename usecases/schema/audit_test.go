@@ -0,0 +1,73 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Audit(t *testing.T) {
+	logger, _ := test.NewNullLogger()
+	ctx := context.Background()
+
+	t.Run("schema changes are recorded", func(t *testing.T) {
+		auditor := &fakeAuditor{}
+		sm, err := NewManager(&NilMigrator{}, newFakeRepo(), logger, &fakeAuthorizer{},
+			auditor, config.Config{DefaultVectorizerModule: config.VectorizerModuleNone},
+			dummyParseVectorConfig, &fakeVectorizerValidator{}, &fakeModuleConfig{})
+		require.Nil(t, err)
+
+		err = sm.AddClass(ctx, nil, &models.Class{
+			Class:             "article",
+			VectorIndexConfig: "config",
+		})
+		require.Nil(t, err)
+
+		err = sm.AddClassProperty(ctx, nil, "Article", &models.Property{
+			Name:     "title",
+			DataType: []string{"string"},
+		})
+		require.Nil(t, err)
+
+		err = sm.DeleteClass(ctx, nil, "Article")
+		require.Nil(t, err)
+
+		assert.Equal(t, []fakeAuditRecord{
+			{verb: "create", resource: "schema/Article"},
+			{verb: "update", resource: "schema/Article/properties/title"},
+			{verb: "delete", resource: "schema/Article"},
+		}, auditor.records)
+	})
+
+	t.Run("denied schema changes are recorded", func(t *testing.T) {
+		auditor := &fakeAuditor{}
+		sm, err := NewManager(&NilMigrator{}, newFakeRepo(), logger, &authDenier{},
+			auditor, config.Config{DefaultVectorizerModule: config.VectorizerModuleNone},
+			dummyParseVectorConfig, &fakeVectorizerValidator{}, &fakeModuleConfig{})
+		require.Nil(t, err)
+
+		err = sm.DeleteClass(ctx, nil, "Article")
+		require.NotNil(t, err)
+
+		assert.Equal(t, []fakeAuditRecord{
+			{verb: "delete", resource: "schema/Article", err: errors.New("just a test fake")},
+		}, auditor.records)
+	})
+}
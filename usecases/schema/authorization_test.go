@@ -18,6 +18,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/go-openapi/strfmt"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/usecases/config"
 	"github.com/sirupsen/logrus/hooks/test"
@@ -50,51 +51,117 @@ func Test_Schema_Authorization(t *testing.T) {
 		},
 		testCase{
 			methodName:       "AddClass",
-			additionalArgs:   []interface{}{&models.Class{}},
+			additionalArgs:   []interface{}{&models.Class{Class: "somename"}},
 			expectedVerb:     "create",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/Somename",
 		},
 		testCase{
 			methodName:       "UpdateClass",
 			additionalArgs:   []interface{}{"somename", &models.Class{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/somename",
 		},
 		testCase{
 			methodName:       "UpdateObject",
 			additionalArgs:   []interface{}{"somename", &models.Class{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/somename",
 		},
 		testCase{
 			methodName:       "DeleteClass",
 			additionalArgs:   []interface{}{"somename"},
 			expectedVerb:     "delete",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/somename",
 		},
 		testCase{
 			methodName:       "AddClassProperty",
 			additionalArgs:   []interface{}{"somename", &models.Property{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/somename",
 		},
 		testCase{
 			methodName:       "UpdateClassProperty",
 			additionalArgs:   []interface{}{"somename", "someprop", &models.Property{}},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/somename",
 		},
 		testCase{
 			methodName:       "UpdatePropertyAddDataType",
 			additionalArgs:   []interface{}{"somename", "someprop", "datatype"},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/somename",
 		},
 		testCase{
 			methodName:       "DeleteClassProperty",
 			additionalArgs:   []interface{}{"somename", "someprop"},
 			expectedVerb:     "update",
-			expectedResource: "schema/objects",
+			expectedResource: "schema/somename",
+		},
+		testCase{
+			methodName:       "AddTenants",
+			additionalArgs:   []interface{}{"somename", []*models.Tenant{}},
+			expectedVerb:     "create",
+			expectedResource: "schema/somename/tenants",
+		},
+		testCase{
+			methodName:       "GetTenants",
+			additionalArgs:   []interface{}{"somename"},
+			expectedVerb:     "list",
+			expectedResource: "schema/somename/tenants",
+		},
+		testCase{
+			methodName:       "UpdateTenants",
+			additionalArgs:   []interface{}{"somename", []*models.Tenant{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/somename/tenants",
+		},
+		testCase{
+			methodName:       "DeleteTenants",
+			additionalArgs:   []interface{}{"somename", []string{}},
+			expectedVerb:     "delete",
+			expectedResource: "schema/somename/tenants",
+		},
+		testCase{
+			methodName:       "Reindex",
+			additionalArgs:   []interface{}{"somename", &models.ReindexJob{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/somename/reindex",
+		},
+		testCase{
+			methodName:       "GetReindexJob",
+			additionalArgs:   []interface{}{"somename", strfmt.UUID("")},
+			expectedVerb:     "get",
+			expectedResource: "schema/somename/reindex",
+		},
+		testCase{
+			methodName:       "DiffSchema",
+			additionalArgs:   []interface{}{&models.Schema{}},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		testCase{
+			methodName:       "ApplySchema",
+			additionalArgs:   []interface{}{&models.Schema{}, false, (*int64)(nil)},
+			expectedVerb:     "list",
+			expectedResource: "schema/*",
+		},
+		testCase{
+			methodName:       "CreateAlias",
+			additionalArgs:   []interface{}{&models.Alias{Alias: "Somealias"}},
+			expectedVerb:     "create",
+			expectedResource: "schema/aliases/Somealias",
+		},
+		testCase{
+			methodName:       "UpdateAlias",
+			additionalArgs:   []interface{}{"Somealias", &models.Alias{}},
+			expectedVerb:     "update",
+			expectedResource: "schema/aliases/Somealias",
+		},
+		testCase{
+			methodName:       "DeleteAlias",
+			additionalArgs:   []interface{}{"Somealias"},
+			expectedVerb:     "delete",
+			expectedResource: "schema/aliases/Somealias",
 		},
 	}
 
@@ -108,7 +175,8 @@ func Test_Schema_Authorization(t *testing.T) {
 		for _, method := range allExportedMethods(&Manager{}) {
 			switch method {
 			case "TriggerSchemaUpdateCallbacks", "RegisterSchemaUpdateCallback",
-				"UpdateMeta", "GetSchemaSkipAuth", "IndexedInverted", "Lock", "Unlock":
+				"UpdateMeta", "GetSchemaSkipAuth", "TenantsSkipAuth", "IndexedInverted", "Lock", "Unlock",
+				"TryLock":
 				// don't require auth on methods which are exported because other
 				// packages need to call them for maintenance and other regular jobs,
 				// but aren't user facing
@@ -125,7 +193,7 @@ func Test_Schema_Authorization(t *testing.T) {
 			t.Run(test.methodName, func(t *testing.T) {
 				authorizer := &authDenier{}
 				manager, err := NewManager(&NilMigrator{}, newFakeRepo(),
-					logger, authorizer, config.Config{},
+					logger, authorizer, &fakeAuditor{}, config.Config{},
 					dummyParseVectorConfig, &fakeVectorizerValidator{}, &fakeModuleConfig{})
 				require.Nil(t, err)
 
@@ -14,13 +14,18 @@ package schema
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/semi-technologies/weaviate/entities/models"
 )
 
 // DeleteClass from the schema
-func (m *Manager) DeleteClass(ctx context.Context, principal *models.Principal, class string) error {
-	err := m.authorizer.Authorize(principal, "delete", "schema/objects")
+func (m *Manager) DeleteClass(ctx context.Context, principal *models.Principal, class string) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "delete", classResource(class), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "delete", classResource(class))
 	if err != nil {
 		return err
 	}
@@ -45,9 +50,22 @@ func (m *Manager) deleteClass(ctx context.Context, className string) error {
 		return fmt.Errorf("could not find class '%s'", className)
 	}
 
+	// dropping the class waits for a running reindex job, which needs the
+	// schema lock to complete
+	if id, ok := m.reindexJobs.runningFor(className); ok {
+		return fmt.Errorf("class '%s' is being reindexed by job %s, it can be deleted "+
+			"once the job has completed", className, id)
+	}
+
+	if aliases := m.aliasesOfClass(className); len(aliases) > 0 {
+		return fmt.Errorf("class '%s' is still used by alias(es) %s, repoint or delete them first",
+			className, strings.Join(aliases, ", "))
+	}
+
 	semanticSchema.Classes[classIdx] = semanticSchema.Classes[len(semanticSchema.Classes)-1]
 	semanticSchema.Classes[len(semanticSchema.Classes)-1] = nil // to prevent leaking this pointer.
 	semanticSchema.Classes = semanticSchema.Classes[:len(semanticSchema.Classes)-1]
+	delete(m.state.Tenants, className)
 
 	err := m.saveSchema(ctx)
 	if err != nil {
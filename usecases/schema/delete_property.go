@@ -13,20 +13,59 @@ package schema
 
 import (
 	"context"
-	"fmt"
 
+	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
 )
 
-// DeleteClassProperty from existing Schema
+// DeleteClassProperty from existing Schema. The property's index is removed
+// right away, the values are stripped from the stored objects in the
+// background.
 func (m *Manager) DeleteClassProperty(ctx context.Context, principal *models.Principal,
-	class string, property string) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	class string, property string) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", propertyResource(class, property), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "update", classResource(class))
+	if err != nil {
+		return err
+	}
+
+	return m.deleteClassProperty(ctx, class, property)
+}
+
+func (m *Manager) deleteClassProperty(ctx context.Context, className string,
+	propName string) error {
+	m.Lock()
+	defer m.Unlock()
+
+	semanticSchema := m.state.SchemaFor()
+	class, err := schema.GetClassByName(semanticSchema, className)
 	if err != nil {
 		return err
 	}
 
-	return fmt.Errorf("deleting a property is currently not supported, see " +
-		"https://github.com/semi-technologies/weaviate/issues/973 for details.")
-	// return m.deleteClassProperty(ctx, class, property, kind.Action)
+	propIdx := -1
+	for i, prop := range class.Properties {
+		if prop.Name == propName {
+			propIdx = i
+			break
+		}
+	}
+
+	if propIdx == -1 {
+		return errors.Errorf(schema.ErrorNoSuchProperty, propName, className)
+	}
+
+	// the migrator needs to run before the state is updated, as it relies on
+	// the property still being present in the schema to find its indices
+	if err := m.migrator.DropProperty(ctx, className, propName); err != nil {
+		return errors.Wrap(err, "drop property")
+	}
+
+	class.Properties = append(class.Properties[:propIdx], class.Properties[propIdx+1:]...)
+
+	return m.saveSchema(ctx)
 }
@@ -0,0 +1,54 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropertyDeletion(t *testing.T) {
+	ctx := context.Background()
+	m := newSchemaManager()
+
+	require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+		Class:      "MyClass",
+		Vectorizer: "none",
+		Properties: []*models.Property{
+			{Name: "keep", DataType: []string{"string"}},
+			{Name: "remove", DataType: []string{"int"}},
+		},
+	}))
+
+	t.Run("deleting a property which doesn't exist", func(t *testing.T) {
+		err := m.DeleteClassProperty(ctx, nil, "MyClass", "unknown")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("deleting a property of a class which doesn't exist", func(t *testing.T) {
+		err := m.DeleteClassProperty(ctx, nil, "WrongClass", "remove")
+		assert.NotNil(t, err)
+	})
+
+	t.Run("deleting an existing property", func(t *testing.T) {
+		require.Nil(t, m.DeleteClassProperty(ctx, nil, "MyClass", "remove"))
+
+		class, err := m.GetClass(ctx, nil, "MyClass")
+		require.Nil(t, err)
+		require.Len(t, class.Properties, 1)
+		assert.Equal(t, "keep", class.Properties[0].Name)
+	})
+}
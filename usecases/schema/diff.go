@@ -0,0 +1,265 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// DiffSchema computes the changes required to turn the live schema into the
+// submitted one, e.g. a schema exported from another environment
+func (m *Manager) DiffSchema(ctx context.Context, principal *models.Principal,
+	submitted *models.Schema) (*models.SchemaDiff, error) {
+	err := m.authorizer.Authorize(principal, "list", "schema/*")
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	changes, err := diffSchema(m.state.ObjectSchema, submitted)
+	if err != nil {
+		return nil, err
+	}
+
+	return &models.SchemaDiff{
+		Changes: changes,
+		Version: m.state.ObjectSchema.Version,
+	}, nil
+}
+
+// diffSchema lists the classes and properties which have to be added to,
+// deleted from or updated in the live schema to match the submitted schema.
+// Settings which are not present in the submitted schema are not compared.
+// The names in the submitted schema are normalized in place.
+func diffSchema(live, submitted *models.Schema) ([]*models.SchemaChange, error) {
+	if submitted == nil {
+		return nil, errors.Errorf("no schema submitted")
+	}
+
+	changes := []*models.SchemaChange{}
+	seen := map[string]bool{}
+	for _, class := range submitted.Classes {
+		if class == nil {
+			return nil, errors.Errorf("submitted schema contains an empty class")
+		}
+
+		class.Class = upperCaseClassName(class.Class)
+		class.Properties = lowerCaseAllPropertyNames(class.Properties)
+		if seen[class.Class] {
+			return nil, errors.Errorf("class %q is defined more than once", class.Class)
+		}
+		seen[class.Class] = true
+
+		liveClass := findClass(live, class.Class)
+		if liveClass == nil {
+			changes = append(changes, &models.SchemaChange{
+				Action:   models.SchemaChangeActionAddClass,
+				Class:    class.Class,
+				Additive: true,
+			})
+			continue
+		}
+
+		classChanges, err := diffClass(liveClass, class)
+		if err != nil {
+			return nil, errors.Wrapf(err, "class %q", class.Class)
+		}
+		changes = append(changes, classChanges...)
+	}
+
+	for _, class := range live.Classes {
+		if !seen[class.Class] {
+			changes = append(changes, &models.SchemaChange{
+				Action: models.SchemaChangeActionDeleteClass,
+				Class:  class.Class,
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+func diffClass(live, submitted *models.Class) ([]*models.SchemaChange, error) {
+	var changes []*models.SchemaChange
+
+	settings, err := differentSettings(classSettings(live), classSettings(submitted))
+	if err != nil {
+		return nil, err
+	}
+	if len(settings) > 0 {
+		changes = append(changes, &models.SchemaChange{
+			Action:      models.SchemaChangeActionUpdateClass,
+			Class:       live.Class,
+			Description: fmt.Sprintf("settings differ: %s", strings.Join(settings, ", ")),
+		})
+	}
+
+	seen := map[string]bool{}
+	for _, prop := range submitted.Properties {
+		if seen[prop.Name] {
+			return nil, errors.Errorf("property %q is defined more than once", prop.Name)
+		}
+		seen[prop.Name] = true
+
+		liveProp := findProperty(live, prop.Name)
+		if liveProp == nil {
+			changes = append(changes, &models.SchemaChange{
+				Action:   models.SchemaChangeActionAddProperty,
+				Class:    live.Class,
+				Property: prop.Name,
+				Additive: true,
+			})
+			continue
+		}
+
+		settings, err := differentSettings(propertySettings(liveProp),
+			propertySettings(prop))
+		if err != nil {
+			return nil, errors.Wrapf(err, "property %q", prop.Name)
+		}
+		if len(settings) > 0 {
+			changes = append(changes, &models.SchemaChange{
+				Action:      models.SchemaChangeActionUpdateProperty,
+				Class:       live.Class,
+				Property:    prop.Name,
+				Description: fmt.Sprintf("settings differ: %s", strings.Join(settings, ", ")),
+			})
+		}
+	}
+
+	for _, prop := range live.Properties {
+		if !seen[prop.Name] {
+			changes = append(changes, &models.SchemaChange{
+				Action:   models.SchemaChangeActionDeleteProperty,
+				Class:    live.Class,
+				Property: prop.Name,
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+// classSettings strips the name and the properties of a class, so that only
+// its settings are compared
+func classSettings(class *models.Class) *models.Class {
+	settings := *class
+	settings.Class = ""
+	settings.Properties = nil
+	return &settings
+}
+
+// propertySettings strips the name of a property and sets the defaults which
+// are implicit in the live schema, so that only its settings are compared
+func propertySettings(prop *models.Property) *models.Property {
+	settings := *prop
+	settings.Name = ""
+	if settings.IndexInverted == nil {
+		indexInverted := true
+		settings.IndexInverted = &indexInverted
+	}
+	settings.Tokenization = schema.PropertyTokenization(prop)
+	return &settings
+}
+
+// differentSettings compares the JSON representations of the live and the
+// submitted settings. Only settings present and non-null in the submitted
+// ones are compared, the names of the differing settings are returned in
+// order.
+func differentSettings(live, submitted interface{}) ([]string, error) {
+	liveMap, err := settingsMap(live)
+	if err != nil {
+		return nil, err
+	}
+
+	submittedMap, err := settingsMap(submitted)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []string
+	for key, value := range submittedMap {
+		if value != nil && !containsSettings(liveMap[key], value) {
+			out = append(out, key)
+		}
+	}
+
+	sort.Strings(out)
+	return out, nil
+}
+
+func settingsMap(in interface{}) (map[string]interface{}, error) {
+	asJSON, err := json.Marshal(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "marshal settings")
+	}
+
+	var out map[string]interface{}
+	if err := json.Unmarshal(asJSON, &out); err != nil {
+		return nil, errors.Wrap(err, "unmarshal settings")
+	}
+
+	return out, nil
+}
+
+// containsSettings checks whether all submitted settings match the live
+// ones, nested objects are compared key by key
+func containsSettings(live, submitted interface{}) bool {
+	submittedMap, ok := submitted.(map[string]interface{})
+	if !ok {
+		return reflect.DeepEqual(live, submitted)
+	}
+
+	liveMap, ok := live.(map[string]interface{})
+	if !ok {
+		return false
+	}
+
+	for key, value := range submittedMap {
+		if !containsSettings(liveMap[key], value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func findClass(s *models.Schema, className string) *models.Class {
+	for _, class := range s.Classes {
+		if class.Class == className {
+			return class
+		}
+	}
+
+	return nil
+}
+
+func findProperty(class *models.Class, propName string) *models.Property {
+	for _, prop := range class.Properties {
+		if prop.Name == propName {
+			return prop
+		}
+	}
+
+	return nil
+}
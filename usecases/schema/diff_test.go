@@ -0,0 +1,155 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DiffSchema(t *testing.T) {
+	ctx := context.Background()
+	m := newSchemaManager()
+	require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+		Class: "Article",
+		Properties: []*models.Property{
+			{Name: "title", DataType: []string{"string"}},
+			{Name: "wordCount", DataType: []string{"int"}},
+			{Name: "obsolete", DataType: []string{"string"}},
+		},
+	}))
+	require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+		Class: "Author",
+		Properties: []*models.Property{
+			{Name: "name", DataType: []string{"string"}},
+		},
+	}))
+
+	t.Run("computing the changes", func(t *testing.T) {
+		submitted := &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class:      "article",
+					Vectorizer: "text2vec-contextionary",
+					Properties: []*models.Property{
+						{Name: "title", DataType: []string{"string"}},
+						{Name: "wordCount", DataType: []string{"number"}},
+						{Name: "Summary", DataType: []string{"text"}},
+					},
+				},
+				{
+					Class: "Publication",
+					Properties: []*models.Property{
+						{Name: "articles", DataType: []string{"Article"}},
+					},
+				},
+			},
+		}
+
+		diff, err := m.DiffSchema(ctx, nil, submitted)
+		require.Nil(t, err)
+
+		assert.Equal(t, int64(2), diff.Version)
+		assert.False(t, diff.Applied)
+		assert.Equal(t, []*models.SchemaChange{
+			{
+				Action:      models.SchemaChangeActionUpdateClass,
+				Class:       "Article",
+				Description: "settings differ: vectorizer",
+			},
+			{
+				Action:      models.SchemaChangeActionUpdateProperty,
+				Class:       "Article",
+				Property:    "wordCount",
+				Description: "settings differ: dataType",
+			},
+			{
+				Action:   models.SchemaChangeActionAddProperty,
+				Class:    "Article",
+				Property: "summary",
+				Additive: true,
+			},
+			{
+				Action:   models.SchemaChangeActionDeleteProperty,
+				Class:    "Article",
+				Property: "obsolete",
+			},
+			{
+				Action:   models.SchemaChangeActionAddClass,
+				Class:    "Publication",
+				Additive: true,
+			},
+			{
+				Action: models.SchemaChangeActionDeleteClass,
+				Class:  "Author",
+			},
+		}, diff.Changes)
+	})
+
+	t.Run("comparing the live schema with itself", func(t *testing.T) {
+		exported, err := m.GetSchema(nil)
+		require.Nil(t, err)
+
+		diff, err := m.DiffSchema(ctx, nil, exported.Objects)
+		require.Nil(t, err)
+		assert.Empty(t, diff.Changes)
+	})
+
+	t.Run("settings which are not submitted are not compared", func(t *testing.T) {
+		indexInverted := true
+		diff, err := m.DiffSchema(ctx, nil, &models.Schema{
+			Classes: []*models.Class{
+				{
+					Class: "Author",
+					InvertedIndexConfig: &models.InvertedIndexConfig{
+						CleanupIntervalSeconds: 60,
+					},
+					Properties: []*models.Property{
+						{Name: "name", IndexInverted: &indexInverted},
+					},
+				},
+			},
+		})
+		require.Nil(t, err)
+
+		assert.Equal(t, []*models.SchemaChange{
+			{
+				Action: models.SchemaChangeActionDeleteClass,
+				Class:  "Article",
+			},
+		}, diff.Changes)
+	})
+
+	t.Run("submitting a class twice", func(t *testing.T) {
+		_, err := m.DiffSchema(ctx, nil, &models.Schema{
+			Classes: []*models.Class{{Class: "Book"}, {Class: "book"}},
+		})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("submitting a property twice", func(t *testing.T) {
+		_, err := m.DiffSchema(ctx, nil, &models.Schema{
+			Classes: []*models.Class{{
+				Class: "Author",
+				Properties: []*models.Property{
+					{Name: "name", DataType: []string{"string"}},
+					{Name: "Name", DataType: []string{"string"}},
+				},
+			}},
+		})
+		assert.NotNil(t, err)
+	})
+}
@@ -14,3 +14,7 @@ package schema
 import "errors"
 
 var ErrNotFound = errors.New("not found")
+
+// ErrSchemaVersionConflict indicates that the live schema has changed since
+// the version a change was based on
+var ErrSchemaVersionConflict = errors.New("schema version conflict")
@@ -20,7 +20,8 @@ import (
 )
 
 type fakeRepo struct {
-	schema *State
+	schema  *State
+	saveErr error
 }
 
 func newFakeRepo() *fakeRepo {
@@ -32,6 +33,10 @@ func (f *fakeRepo) LoadSchema(context.Context) (*State, error) {
 }
 
 func (f *fakeRepo) SaveSchema(ctx context.Context, schema State) error {
+	if f.saveErr != nil {
+		return f.saveErr
+	}
+
 	f.schema = &schema
 	return nil
 }
@@ -42,6 +47,21 @@ func (f *fakeAuthorizer) Authorize(principal *models.Principal, verb, resource s
 	return nil
 }
 
+type fakeAuditRecord struct {
+	verb     string
+	resource string
+	err      error
+}
+
+type fakeAuditor struct {
+	records []fakeAuditRecord
+}
+
+func (f *fakeAuditor) Log(ctx context.Context, principal *models.Principal,
+	verb, resource string, err error) {
+	f.records = append(f.records, fakeAuditRecord{verb, resource, err})
+}
+
 type fakeVectorConfig struct {
 	raw interface{}
 }
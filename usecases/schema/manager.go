@@ -33,9 +33,11 @@ type Manager struct {
 	callbacks           []func(updatedSchema schema.Schema)
 	logger              logrus.FieldLogger
 	authorizer          authorizer
+	auditor             auditor
 	config              config.Config
 	vectorizerValidator VectorizerValidator
 	moduleConfig        ModuleConfig
+	reindexJobs         *reindexJobs
 	sync.Mutex
 
 	hnswConfigParser VectorConfigParser
@@ -45,6 +47,7 @@ type VectorConfigParser func(in interface{}) (schema.VectorIndexConfig, error)
 
 type SchemaGetter interface {
 	GetSchemaSkipAuth() schema.Schema
+	TenantsSkipAuth(className string) []*models.Tenant
 }
 
 type VectorizerValidator interface {
@@ -68,7 +71,7 @@ type Repo interface {
 
 // NewManager creates a new manager
 func NewManager(migrator migrate.Migrator, repo Repo,
-	logger logrus.FieldLogger, authorizer authorizer, config config.Config,
+	logger logrus.FieldLogger, authorizer authorizer, auditor auditor, config config.Config,
 	hnswConfigParser VectorConfigParser, vectorizerValidator VectorizerValidator,
 	moduleConfig ModuleConfig) (*Manager, error) {
 	m := &Manager{
@@ -78,9 +81,11 @@ func NewManager(migrator migrate.Migrator, repo Repo,
 		state:               State{},
 		logger:              logger,
 		authorizer:          authorizer,
+		auditor:             auditor,
 		hnswConfigParser:    hnswConfigParser,
 		vectorizerValidator: vectorizerValidator,
 		moduleConfig:        moduleConfig,
+		reindexJobs:         newReindexJobs(),
 	}
 
 	err := m.loadOrInitializeSchema(context.Background())
@@ -95,10 +100,26 @@ type authorizer interface {
 	Authorize(principal *models.Principal, verb, resource string) error
 }
 
+// auditor records the outcome of every change to the schema
+type auditor interface {
+	Log(ctx context.Context, principal *models.Principal, verb, resource string, err error)
+}
+
+func classResource(className string) string {
+	return fmt.Sprintf("schema/%s", className)
+}
+
+func propertyResource(className, propName string) string {
+	return fmt.Sprintf("schema/%s/properties/%s", className, propName)
+}
+
 // State is a cached copy of the schema that can also be saved into a remote
 // storage, as specified by Repo
 type State struct {
 	ObjectSchema *models.Schema `json:"object"`
+
+	// Tenants of all classes with multi-tenancy enabled, keyed by class name
+	Tenants map[string][]*models.Tenant `json:"tenants,omitempty"`
 }
 
 // SchemaFor a specific kind
@@ -111,11 +132,19 @@ func (m *Manager) saveSchema(ctx context.Context) error {
 		WithField("action", "schema_update").
 		Debug("saving updated schema to configuration store")
 
-	err := m.repo.SaveSchema(ctx, m.state)
+	// every persisted change results in a new version of the schema, which
+	// only becomes live once it has been saved
+	objectSchema := *m.state.ObjectSchema
+	objectSchema.Version++
+	state := m.state
+	state.ObjectSchema = &objectSchema
+
+	err := m.repo.SaveSchema(ctx, state)
 	if err != nil {
 		return err
 	}
 
+	m.state.ObjectSchema.Version = objectSchema.Version
 	m.TriggerSchemaUpdateCallbacks()
 	return nil
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/schema/migrate"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -52,6 +53,15 @@ func (n *NilMigrator) UpdatePropertyAddDataType(ctx context.Context, className s
 	return nil
 }
 
+func (n *NilMigrator) UpdatePropertyDataType(ctx context.Context, className string, propName string, newDataType []string) error {
+	return nil
+}
+
+func (n *NilMigrator) Reindex(ctx context.Context, className string,
+	params migrate.ReindexParams, commit func() error) error {
+	return commit()
+}
+
 func (n *NilMigrator) DropProperty(ctx context.Context, className string, propName string) error {
 	return nil
 }
@@ -64,6 +74,18 @@ func (n *NilMigrator) UpdateVectorIndexConfig(ctx context.Context, className str
 	return nil
 }
 
+func (n *NilMigrator) NewTenants(ctx context.Context, className string, tenants []*models.Tenant) error {
+	return nil
+}
+
+func (n *NilMigrator) UpdateTenants(ctx context.Context, className string, tenants []*models.Tenant) error {
+	return nil
+}
+
+func (n *NilMigrator) DeleteTenants(ctx context.Context, className string, tenants []string) error {
+	return nil
+}
+
 var schemaTests = []struct {
 	name string
 	fn   func(*testing.T, *Manager)
@@ -400,11 +422,6 @@ func testAddInvalidPropertyWithEmptyDataTypeDuringCreation(t *testing.T, lsm *Ma
 }
 
 func testDropProperty(t *testing.T, lsm *Manager) {
-	// TODO: https://github.com/semi-technologies/weaviate/issues/973
-	// Remove skip
-
-	t.Skip()
-
 	t.Parallel()
 
 	var properties []*models.Property = []*models.Property{
@@ -422,7 +439,8 @@ func testDropProperty(t *testing.T, lsm *Manager) {
 	assert.Len(t, objectClasses[0].Properties, 1)
 
 	// Now drop the property
-	lsm.DeleteClassProperty(context.Background(), nil, "Car", "color")
+	err = lsm.DeleteClassProperty(context.Background(), nil, "Car", "color")
+	require.Nil(t, err)
 
 	objectClasses = testGetClasses(lsm)
 	require.Len(t, objectClasses, 1)
@@ -506,7 +524,7 @@ func newSchemaManager() *Manager {
 	vectorizerValidator := &fakeVectorizerValidator{
 		valid: []string{"text2vec-contextionary", "model1", "model2"},
 	}
-	sm, err := NewManager(&NilMigrator{}, newFakeRepo(), logger, &fakeAuthorizer{},
+	sm, err := NewManager(&NilMigrator{}, newFakeRepo(), logger, &fakeAuthorizer{}, &fakeAuditor{},
 		config.Config{DefaultVectorizerModule: config.VectorizerModuleNone},
 		dummyParseVectorConfig, // only option for now
 		vectorizerValidator, &fakeModuleConfig{},
@@ -552,7 +570,7 @@ func Test_ParseVectorConfigOnDiskLoad(t *testing.T) {
 			}},
 		},
 	}
-	sm, err := NewManager(&NilMigrator{}, repo, logger, &fakeAuthorizer{},
+	sm, err := NewManager(&NilMigrator{}, repo, logger, &fakeAuthorizer{}, &fakeAuditor{},
 		config.Config{DefaultVectorizerModule: config.VectorizerModuleNone},
 		dummyParseVectorConfig, // only option for now
 		&fakeVectorizerValidator{}, &fakeModuleConfig{},
@@ -35,9 +35,32 @@ type Migrator interface {
 	UpdateProperty(ctx context.Context, className string,
 		propName string, newName *string) error
 	UpdatePropertyAddDataType(ctx context.Context, className string, propName string, newDataType string) error
+	UpdatePropertyDataType(ctx context.Context, className string,
+		propName string, newDataType []string) error
 
 	ValidateVectorIndexConfigUpdate(ctx context.Context,
 		old, updated schema.VectorIndexConfig) error
 	UpdateVectorIndexConfig(ctx context.Context, className string,
 		updated schema.VectorIndexConfig) error
+
+	NewTenants(ctx context.Context, className string,
+		tenants []*models.Tenant) error
+	UpdateTenants(ctx context.Context, className string,
+		tenants []*models.Tenant) error
+	DeleteTenants(ctx context.Context, className string,
+		tenants []string) error
+
+	Reindex(ctx context.Context, className string, params ReindexParams,
+		commit func() error) error
+}
+
+// ReindexParams describe the indices which are rebuilt by a reindex job.
+// Either Properties or VectorIndexConfig is set.
+type ReindexParams struct {
+	// Properties are the definitions of the properties after the reindex job,
+	// their inverted indices are rebuilt
+	Properties []*models.Property
+
+	// VectorIndexConfig is the config of the rebuilt vector index
+	VectorIndexConfig schema.VectorIndexConfig
 }
@@ -0,0 +1,323 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/schema/migrate"
+)
+
+func reindexResource(className string) string {
+	return fmt.Sprintf("schema/%s/reindex", className)
+}
+
+// Reindex starts a job which rebuilds either the inverted indices of
+// properties or the vector index of a class in the background. The rebuilt
+// indices replace the existing ones once they are complete. The returned
+// job can be used to check on the status of the job with GetReindexJob.
+func (m *Manager) Reindex(ctx context.Context, principal *models.Principal,
+	className string, job *models.ReindexJob) (started *models.ReindexJob, err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", reindexResource(className), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "update", reindexResource(className))
+	if err != nil {
+		return nil, err
+	}
+
+	return m.reindex(className, job)
+}
+
+// GetReindexJob returns the current status of a reindex job. Jobs are only
+// known to the instance they were started on and only until it is restarted.
+func (m *Manager) GetReindexJob(ctx context.Context, principal *models.Principal,
+	className string, id strfmt.UUID) (*models.ReindexJob, error) {
+	err := m.authorizer.Authorize(principal, "get", reindexResource(className))
+	if err != nil {
+		return nil, err
+	}
+
+	job := m.reindexJobs.get(id)
+	if job == nil || job.Class != className {
+		return nil, ErrNotFound
+	}
+
+	return job, nil
+}
+
+func (m *Manager) reindex(className string,
+	job *models.ReindexJob) (*models.ReindexJob, error) {
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errors.Wrap(err, "assign id")
+	}
+
+	started := &models.ReindexJob{
+		ID:                strfmt.UUID(id.String()),
+		Class:             className,
+		Target:            job.Target,
+		Properties:        job.Properties,
+		IndexInverted:     job.IndexInverted,
+		VectorIndexConfig: job.VectorIndexConfig,
+		Status:            models.ReindexJobStatusRunning,
+		Started:           strfmt.DateTime(time.Now()),
+	}
+
+	params, apply, err := m.startReindexJob(started)
+	if err != nil {
+		return nil, err
+	}
+
+	go m.runReindexJob(started.ID, className, params, apply)
+
+	return m.reindexJobs.get(started.ID), nil
+}
+
+// startReindexJob validates and registers the job under the schema lock, so
+// the class can not be deleted in between
+func (m *Manager) startReindexJob(
+	job *models.ReindexJob) (migrate.ReindexParams, applyReindexFunc, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	params, apply, err := m.validateReindexJob(job.Class, job)
+	if err != nil {
+		return migrate.ReindexParams{}, nil, err
+	}
+
+	if err := m.reindexJobs.start(job); err != nil {
+		return migrate.ReindexParams{}, nil, err
+	}
+
+	return params, apply, nil
+}
+
+// applyReindexFunc applies the changes of a reindex job to the class
+type applyReindexFunc func(class *models.Class)
+
+// validateReindexJob returns the params for the migrator and a func which
+// applies the changes of the job to the schema. It must be called with the
+// schema lock held.
+func (m *Manager) validateReindexJob(className string,
+	job *models.ReindexJob) (migrate.ReindexParams, applyReindexFunc, error) {
+	class := m.getClassByName(className)
+	if class == nil {
+		return migrate.ReindexParams{}, nil, ErrNotFound
+	}
+
+	switch job.Target {
+	case models.ReindexJobTargetInverted:
+		return m.validateInvertedReindexJob(class, job)
+	case models.ReindexJobTargetVector:
+		return m.validateVectorReindexJob(class, job)
+	default:
+		return migrate.ReindexParams{}, nil, errors.Errorf(
+			"reindex target must be one of %q or %q, got %q",
+			models.ReindexJobTargetInverted, models.ReindexJobTargetVector, job.Target)
+	}
+}
+
+func (m *Manager) validateInvertedReindexJob(class *models.Class,
+	job *models.ReindexJob) (migrate.ReindexParams, applyReindexFunc, error) {
+	if len(job.Properties) == 0 {
+		return migrate.ReindexParams{}, nil, errors.Errorf(
+			"reindex target %q requires at least one property", job.Target)
+	}
+
+	if job.VectorIndexConfig != nil {
+		return migrate.ReindexParams{}, nil, errors.Errorf(
+			"vectorIndexConfig can only be set for reindex target %q",
+			models.ReindexJobTargetVector)
+	}
+
+	props := make([]*models.Property, len(job.Properties))
+	for i, name := range job.Properties {
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return migrate.ReindexParams{}, nil, err
+		}
+
+		if schema.DataType(prop.DataType[0]) == schema.DataTypeGeoCoordinates {
+			return migrate.ReindexParams{}, nil, errors.Errorf(
+				"property %q: geo properties are not indexed in the inverted index",
+				name)
+		}
+
+		updated := *prop
+		if job.IndexInverted != nil {
+			updated.IndexInverted = job.IndexInverted
+		}
+		if err := schema.ValidateIndexTrigrams(&updated); err != nil {
+			return migrate.ReindexParams{}, nil, errors.Errorf("property %q: %v",
+				name, err)
+		}
+		props[i] = &updated
+	}
+
+	apply := func(class *models.Class) {
+		if job.IndexInverted == nil {
+			return
+		}
+
+		for _, name := range job.Properties {
+			prop, err := schema.GetPropertyByName(class, name)
+			if err != nil {
+				// deleted in the meantime
+				continue
+			}
+
+			indexInverted := *job.IndexInverted
+			prop.IndexInverted = &indexInverted
+		}
+	}
+
+	return migrate.ReindexParams{Properties: props}, apply, nil
+}
+
+func (m *Manager) validateVectorReindexJob(class *models.Class,
+	job *models.ReindexJob) (migrate.ReindexParams, applyReindexFunc, error) {
+	if len(job.Properties) > 0 || job.IndexInverted != nil {
+		return migrate.ReindexParams{}, nil, errors.Errorf(
+			"properties and indexInverted can only be set for reindex target %q",
+			models.ReindexJobTargetInverted)
+	}
+
+	if job.VectorIndexConfig == nil {
+		return migrate.ReindexParams{
+			VectorIndexConfig: class.VectorIndexConfig.(schema.VectorIndexConfig),
+		}, func(*models.Class) {}, nil
+	}
+
+	parsed := &models.Class{
+		VectorIndexType:   class.VectorIndexType,
+		VectorIndexConfig: job.VectorIndexConfig,
+	}
+	if err := m.parseVectorIndexConfig(context.Background(), parsed); err != nil {
+		return migrate.ReindexParams{}, nil, err
+	}
+
+	config := parsed.VectorIndexConfig.(schema.VectorIndexConfig)
+	apply := func(class *models.Class) {
+		class.VectorIndexConfig = config
+	}
+
+	return migrate.ReindexParams{VectorIndexConfig: config}, apply, nil
+}
+
+// runReindexJob applies the changes to the schema while writes to the class
+// are paused, so no write can observe the new schema with the old indices or
+// vice versa. The class is resolved again under the schema lock, as the
+// schema may have been changed while the indices were rebuilt. Operations
+// which hold the schema lock and wait for the reindex job, such as deleting
+// the class, are rejected while the job is running.
+func (m *Manager) runReindexJob(id strfmt.UUID, className string,
+	params migrate.ReindexParams, apply applyReindexFunc) {
+	err := m.migrator.Reindex(context.Background(), className, params,
+		func() error {
+			m.Lock()
+			defer m.Unlock()
+
+			class := m.getClassByName(className)
+			if class == nil {
+				return errors.Errorf("class %q was deleted", className)
+			}
+
+			apply(class)
+			return m.saveSchema(context.Background())
+		})
+	if err != nil {
+		m.logger.WithField("action", "reindex").
+			WithField("class", className).
+			WithError(err).
+			Error("reindex job failed")
+	}
+
+	m.reindexJobs.complete(id, err)
+}
+
+// reindexJobs tracks all reindex jobs since the start of the instance
+type reindexJobs struct {
+	sync.Mutex
+	byID    map[strfmt.UUID]*models.ReindexJob
+	running map[string]strfmt.UUID
+}
+
+func newReindexJobs() *reindexJobs {
+	return &reindexJobs{
+		byID:    map[strfmt.UUID]*models.ReindexJob{},
+		running: map[string]strfmt.UUID{},
+	}
+}
+
+func (r *reindexJobs) start(job *models.ReindexJob) error {
+	r.Lock()
+	defer r.Unlock()
+
+	if id, ok := r.running[job.Class]; ok {
+		return errors.Errorf("class %q is already being reindexed by job %s",
+			job.Class, id)
+	}
+
+	r.byID[job.ID] = job
+	r.running[job.Class] = job.ID
+	return nil
+}
+
+// runningFor returns the id of the job which is reindexing the class
+func (r *reindexJobs) runningFor(className string) (strfmt.UUID, bool) {
+	r.Lock()
+	defer r.Unlock()
+
+	id, ok := r.running[className]
+	return id, ok
+}
+
+func (r *reindexJobs) complete(id strfmt.UUID, err error) {
+	r.Lock()
+	defer r.Unlock()
+
+	job := r.byID[id]
+	delete(r.running, job.Class)
+
+	job.Completed = strfmt.DateTime(time.Now())
+	if err != nil {
+		job.Status = models.ReindexJobStatusFailed
+		job.Error = err.Error()
+		return
+	}
+
+	job.Status = models.ReindexJobStatusCompleted
+}
+
+// get returns a copy of the job, so it can be read while the job is updated
+func (r *reindexJobs) get(id strfmt.UUID) *models.ReindexJob {
+	r.Lock()
+	defer r.Unlock()
+
+	job, ok := r.byID[id]
+	if !ok {
+		return nil
+	}
+
+	out := *job
+	return &out
+}
@@ -0,0 +1,211 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/semi-technologies/weaviate/usecases/schema/migrate"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Reindex(t *testing.T) {
+	ctx := context.Background()
+	m := newSchemaManager()
+
+	require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+		Class:      "Reindexed",
+		Vectorizer: "none",
+		Properties: []*models.Property{
+			{Name: "name", DataType: []string{"string"}},
+			{Name: "location", DataType: []string{"geoCoordinates"}},
+		},
+	}))
+
+	t.Run("invalid jobs", func(t *testing.T) {
+		tests := []struct {
+			name      string
+			className string
+			job       *models.ReindexJob
+		}{
+			{
+				name:      "unknown class",
+				className: "Unknown",
+				job:       &models.ReindexJob{Target: "vector"},
+			},
+			{
+				name:      "missing target",
+				className: "Reindexed",
+				job:       &models.ReindexJob{},
+			},
+			{
+				name:      "inverted without properties",
+				className: "Reindexed",
+				job:       &models.ReindexJob{Target: "inverted"},
+			},
+			{
+				name:      "inverted with an unknown property",
+				className: "Reindexed",
+				job:       &models.ReindexJob{Target: "inverted", Properties: []string{"unknown"}},
+			},
+			{
+				name:      "inverted with a geo property",
+				className: "Reindexed",
+				job:       &models.ReindexJob{Target: "inverted", Properties: []string{"location"}},
+			},
+			{
+				name:      "vector with properties",
+				className: "Reindexed",
+				job:       &models.ReindexJob{Target: "vector", Properties: []string{"name"}},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := m.Reindex(ctx, nil, test.className, test.job)
+				assert.NotNil(t, err)
+			})
+		}
+	})
+
+	t.Run("disabling the inverted index of a property", func(t *testing.T) {
+		indexInverted := false
+		started, err := m.Reindex(ctx, nil, "Reindexed", &models.ReindexJob{
+			Target:        "inverted",
+			Properties:    []string{"name"},
+			IndexInverted: &indexInverted,
+		})
+		require.Nil(t, err)
+		assert.NotEmpty(t, started.ID)
+		assert.Equal(t, "Reindexed", started.Class)
+
+		job := waitForReindexJob(t, m, started.ID)
+		assert.Equal(t, models.ReindexJobStatusCompleted, job.Status)
+		assert.Empty(t, job.Error)
+
+		class, err := m.GetClass(ctx, nil, "Reindexed")
+		require.Nil(t, err)
+		prop, err := schema.GetPropertyByName(class, "name")
+		require.Nil(t, err)
+		require.NotNil(t, prop.IndexInverted)
+		assert.False(t, *prop.IndexInverted)
+	})
+
+	t.Run("rebuilding the vector index with a new config", func(t *testing.T) {
+		started, err := m.Reindex(ctx, nil, "Reindexed", &models.ReindexJob{
+			Target:            "vector",
+			VectorIndexConfig: map[string]interface{}{"maxConnections": 16},
+		})
+		require.Nil(t, err)
+
+		job := waitForReindexJob(t, m, started.ID)
+		assert.Equal(t, models.ReindexJobStatusCompleted, job.Status)
+
+		class, err := m.GetClass(ctx, nil, "Reindexed")
+		require.Nil(t, err)
+		assert.Equal(t, fakeVectorConfig{
+			raw: map[string]interface{}{"maxConnections": 16},
+		}, class.VectorIndexConfig)
+	})
+
+	t.Run("getting a job of a different class", func(t *testing.T) {
+		started, err := m.Reindex(ctx, nil, "Reindexed",
+			&models.ReindexJob{Target: "vector"})
+		require.Nil(t, err)
+		waitForReindexJob(t, m, started.ID)
+
+		_, err = m.GetReindexJob(ctx, nil, "Other", started.ID)
+		assert.Equal(t, ErrNotFound, err)
+	})
+
+	t.Run("getting an unknown job", func(t *testing.T) {
+		_, err := m.GetReindexJob(ctx, nil, "Reindexed",
+			strfmt.UUID("a9b4cc2b-4d5b-4cc4-a7d8-7ed2f0e1f5a1"))
+		assert.Equal(t, ErrNotFound, err)
+	})
+}
+
+func Test_Reindex_DeleteClassWhileRunning(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	migrator := &blockingReindexMigrator{release: make(chan struct{})}
+	m, err := NewManager(migrator, newFakeRepo(), logger, &fakeAuthorizer{},
+		&fakeAuditor{}, config.Config{DefaultVectorizerModule: config.VectorizerModuleNone},
+		dummyParseVectorConfig, &fakeVectorizerValidator{}, &fakeModuleConfig{})
+	require.Nil(t, err)
+
+	require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+		Class:      "Reindexed",
+		Vectorizer: "none",
+		Properties: []*models.Property{
+			{Name: "name", DataType: []string{"string"}},
+		},
+	}))
+
+	indexInverted := false
+	started, err := m.Reindex(ctx, nil, "Reindexed", &models.ReindexJob{
+		Target:        "inverted",
+		Properties:    []string{"name"},
+		IndexInverted: &indexInverted,
+	})
+	require.Nil(t, err)
+
+	err = m.DeleteClass(ctx, nil, "Reindexed")
+	assert.NotNil(t, err)
+
+	close(migrator.release)
+	job := waitForReindexJob(t, m, started.ID)
+	assert.Equal(t, models.ReindexJobStatusCompleted, job.Status)
+
+	class, err := m.GetClass(ctx, nil, "Reindexed")
+	require.Nil(t, err)
+	require.NotNil(t, class.Properties[0].IndexInverted)
+	assert.False(t, *class.Properties[0].IndexInverted)
+
+	assert.Nil(t, m.DeleteClass(ctx, nil, "Reindexed"))
+}
+
+// blockingReindexMigrator completes reindex jobs once release is closed
+type blockingReindexMigrator struct {
+	NilMigrator
+	release chan struct{}
+}
+
+func (b *blockingReindexMigrator) Reindex(ctx context.Context, className string,
+	params migrate.ReindexParams, commit func() error) error {
+	<-b.release
+	return commit()
+}
+
+func waitForReindexJob(t *testing.T, m *Manager,
+	id strfmt.UUID) *models.ReindexJob {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		job, err := m.GetReindexJob(context.Background(), nil, "Reindexed", id)
+		require.Nil(t, err)
+		if job.Status != models.ReindexJobStatusRunning {
+			return job
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	t.Fatalf("reindex job %s did not complete", id)
+	return nil
+}
@@ -0,0 +1,267 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+var validateTenantNameRegex = regexp.MustCompile(`^[A-Za-z0-9\-_]{1,64}$`)
+
+func tenantsResource(className string) string {
+	return fmt.Sprintf("schema/%s/tenants", className)
+}
+
+// AddTenants to a class with multi-tenancy enabled. Every tenant is backed by
+// its own shard. Tenants without an activity status are created as active.
+func (m *Manager) AddTenants(ctx context.Context, principal *models.Principal,
+	className string, tenants []*models.Tenant) (created []*models.Tenant, err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "create", tenantsResource(className), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "create", tenantsResource(className))
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	class, err := m.multiTenantClass(className)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := m.tenantsByName(class.Class)
+	seen := map[string]struct{}{}
+	created = make([]*models.Tenant, len(tenants))
+	for i, tenant := range tenants {
+		if err := validateTenant(tenant); err != nil {
+			return nil, err
+		}
+
+		if _, ok := seen[tenant.Name]; ok {
+			return nil, errors.Errorf("tenant %q is contained more than once", tenant.Name)
+		}
+		seen[tenant.Name] = struct{}{}
+
+		if _, ok := existing[tenant.Name]; ok {
+			return nil, errors.Errorf("tenant %q already exists", tenant.Name)
+		}
+
+		status := tenant.ActivityStatus
+		if status == "" {
+			status = models.TenantActivityStatusACTIVE
+		}
+
+		created[i] = &models.Tenant{Name: tenant.Name, ActivityStatus: status}
+	}
+
+	if err := m.migrator.NewTenants(ctx, class.Class, created); err != nil {
+		return nil, errors.Wrap(err, "create tenants")
+	}
+
+	if m.state.Tenants == nil {
+		m.state.Tenants = map[string][]*models.Tenant{}
+	}
+	m.state.Tenants[class.Class] = append(m.state.Tenants[class.Class], created...)
+
+	if err := m.saveSchema(ctx); err != nil {
+		return nil, err
+	}
+
+	return copyTenants(created), nil
+}
+
+// GetTenants of a class with multi-tenancy enabled
+func (m *Manager) GetTenants(ctx context.Context, principal *models.Principal,
+	className string) ([]*models.Tenant, error) {
+	err := m.authorizer.Authorize(principal, "list", tenantsResource(className))
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	class, err := m.multiTenantClass(className)
+	if err != nil {
+		return nil, err
+	}
+
+	return copyTenants(m.state.Tenants[class.Class]), nil
+}
+
+// TenantsSkipAuth can never be used as a response to a user request as it
+// could leak tenant names to an unauthorized user. It is intended for
+// non-user triggered processes, such as loading the tenant shards on startup
+func (m *Manager) TenantsSkipAuth(className string) []*models.Tenant {
+	m.Lock()
+	defer m.Unlock()
+
+	return copyTenants(m.state.Tenants[className])
+}
+
+// UpdateTenants changes the activity status of existing tenants. Deactivated
+// tenants are unloaded from memory, but their data remains on disk.
+func (m *Manager) UpdateTenants(ctx context.Context, principal *models.Principal,
+	className string, tenants []*models.Tenant) (updated []*models.Tenant, err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", tenantsResource(className), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "update", tenantsResource(className))
+	if err != nil {
+		return nil, err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	class, err := m.multiTenantClass(className)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := m.tenantsByName(class.Class)
+	updated = make([]*models.Tenant, len(tenants))
+	for i, tenant := range tenants {
+		if err := validateTenant(tenant); err != nil {
+			return nil, err
+		}
+
+		if tenant.ActivityStatus == "" {
+			return nil, errors.Errorf("tenant %q: activityStatus must be set", tenant.Name)
+		}
+
+		if _, ok := existing[tenant.Name]; !ok {
+			return nil, errors.Errorf("tenant %q not found", tenant.Name)
+		}
+
+		updated[i] = &models.Tenant{Name: tenant.Name, ActivityStatus: tenant.ActivityStatus}
+	}
+
+	if err := m.migrator.UpdateTenants(ctx, class.Class, updated); err != nil {
+		return nil, errors.Wrap(err, "update tenants")
+	}
+
+	for _, tenant := range updated {
+		existing[tenant.Name].ActivityStatus = tenant.ActivityStatus
+	}
+
+	if err := m.saveSchema(ctx); err != nil {
+		return nil, err
+	}
+
+	return copyTenants(updated), nil
+}
+
+// DeleteTenants removes the tenants including all of their data
+func (m *Manager) DeleteTenants(ctx context.Context, principal *models.Principal,
+	className string, names []string) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "delete", tenantsResource(className), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "delete", tenantsResource(className))
+	if err != nil {
+		return err
+	}
+
+	m.Lock()
+	defer m.Unlock()
+
+	class, err := m.multiTenantClass(className)
+	if err != nil {
+		return err
+	}
+
+	existing := m.tenantsByName(class.Class)
+	toDelete := map[string]struct{}{}
+	for _, name := range names {
+		if _, ok := existing[name]; !ok {
+			return errors.Errorf("tenant %q not found", name)
+		}
+		toDelete[name] = struct{}{}
+	}
+
+	if err := m.migrator.DeleteTenants(ctx, class.Class, names); err != nil {
+		return errors.Wrap(err, "delete tenants")
+	}
+
+	remaining := m.state.Tenants[class.Class][:0]
+	for _, tenant := range m.state.Tenants[class.Class] {
+		if _, ok := toDelete[tenant.Name]; !ok {
+			remaining = append(remaining, tenant)
+		}
+	}
+	m.state.Tenants[class.Class] = remaining
+
+	return m.saveSchema(ctx)
+}
+
+func (m *Manager) multiTenantClass(className string) (*models.Class, error) {
+	class := m.getClassByName(className)
+	if class == nil {
+		return nil, errors.Errorf("class %q not found", className)
+	}
+
+	if !schema.MultiTenancyEnabled(class) {
+		return nil, errors.Errorf("multi-tenancy is not enabled for class %q", class.Class)
+	}
+
+	return class, nil
+}
+
+func (m *Manager) tenantsByName(className string) map[string]*models.Tenant {
+	out := map[string]*models.Tenant{}
+	for _, tenant := range m.state.Tenants[className] {
+		out[tenant.Name] = tenant
+	}
+
+	return out
+}
+
+func validateTenant(tenant *models.Tenant) error {
+	if tenant == nil {
+		return errors.Errorf("tenant must not be empty")
+	}
+
+	if !validateTenantNameRegex.MatchString(tenant.Name) {
+		return errors.Errorf("tenant name %q is invalid, must match %s",
+			tenant.Name, validateTenantNameRegex.String())
+	}
+
+	switch tenant.ActivityStatus {
+	case "", models.TenantActivityStatusACTIVE, models.TenantActivityStatusINACTIVE:
+		return nil
+	default:
+		return errors.Errorf("tenant %q: invalid activityStatus %q", tenant.Name,
+			tenant.ActivityStatus)
+	}
+}
+
+func copyTenants(in []*models.Tenant) []*models.Tenant {
+	out := make([]*models.Tenant, len(in))
+	for i, tenant := range in {
+		out[i] = &models.Tenant{Name: tenant.Name, ActivityStatus: tenant.ActivityStatus}
+	}
+
+	return out
+}
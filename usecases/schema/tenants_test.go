@@ -0,0 +1,138 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Tenants(t *testing.T) {
+	ctx := context.Background()
+	m := newSchemaManager()
+
+	require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+		Class:              "MultiTenant",
+		Vectorizer:         "none",
+		MultiTenancyConfig: &models.MultiTenancyConfig{Enabled: true},
+	}))
+	require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+		Class:      "SingleTenant",
+		Vectorizer: "none",
+	}))
+
+	t.Run("adding tenants to a class without multi-tenancy", func(t *testing.T) {
+		_, err := m.AddTenants(ctx, nil, "SingleTenant",
+			[]*models.Tenant{{Name: "tenant1"}})
+		assert.EqualError(t, err, `multi-tenancy is not enabled for class "SingleTenant"`)
+	})
+
+	t.Run("adding tenants to a non-existing class", func(t *testing.T) {
+		_, err := m.AddTenants(ctx, nil, "Unknown",
+			[]*models.Tenant{{Name: "tenant1"}})
+		assert.EqualError(t, err, `class "Unknown" not found`)
+	})
+
+	t.Run("adding tenants with invalid input", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			tenants []*models.Tenant
+		}{
+			{name: "empty name", tenants: []*models.Tenant{{Name: ""}}},
+			{name: "invalid characters", tenants: []*models.Tenant{{Name: "a/b"}}},
+			{name: "invalid status", tenants: []*models.Tenant{{Name: "a", ActivityStatus: "FROZEN"}}},
+			{name: "duplicate", tenants: []*models.Tenant{{Name: "a"}, {Name: "a"}}},
+		}
+
+		for _, test := range tests {
+			t.Run(test.name, func(t *testing.T) {
+				_, err := m.AddTenants(ctx, nil, "MultiTenant", test.tenants)
+				assert.NotNil(t, err)
+			})
+		}
+
+		tenants, err := m.GetTenants(ctx, nil, "MultiTenant")
+		require.Nil(t, err)
+		assert.Len(t, tenants, 0)
+	})
+
+	t.Run("adding valid tenants", func(t *testing.T) {
+		created, err := m.AddTenants(ctx, nil, "MultiTenant", []*models.Tenant{
+			{Name: "tenant1"},
+			{Name: "tenant2", ActivityStatus: models.TenantActivityStatusINACTIVE},
+		})
+		require.Nil(t, err)
+
+		expected := []*models.Tenant{
+			{Name: "tenant1", ActivityStatus: models.TenantActivityStatusACTIVE},
+			{Name: "tenant2", ActivityStatus: models.TenantActivityStatusINACTIVE},
+		}
+		assert.Equal(t, expected, created)
+
+		tenants, err := m.GetTenants(ctx, nil, "MultiTenant")
+		require.Nil(t, err)
+		assert.Equal(t, expected, tenants)
+		assert.Equal(t, expected, m.TenantsSkipAuth("MultiTenant"))
+	})
+
+	t.Run("adding an existing tenant", func(t *testing.T) {
+		_, err := m.AddTenants(ctx, nil, "MultiTenant",
+			[]*models.Tenant{{Name: "tenant1"}})
+		assert.EqualError(t, err, `tenant "tenant1" already exists`)
+	})
+
+	t.Run("updating tenants", func(t *testing.T) {
+		_, err := m.UpdateTenants(ctx, nil, "MultiTenant",
+			[]*models.Tenant{{Name: "tenant3", ActivityStatus: models.TenantActivityStatusACTIVE}})
+		assert.EqualError(t, err, `tenant "tenant3" not found`)
+
+		_, err = m.UpdateTenants(ctx, nil, "MultiTenant",
+			[]*models.Tenant{{Name: "tenant1"}})
+		assert.EqualError(t, err, `tenant "tenant1": activityStatus must be set`)
+
+		updated, err := m.UpdateTenants(ctx, nil, "MultiTenant", []*models.Tenant{
+			{Name: "tenant1", ActivityStatus: models.TenantActivityStatusINACTIVE},
+		})
+		require.Nil(t, err)
+		require.Len(t, updated, 1)
+
+		tenants, err := m.GetTenants(ctx, nil, "MultiTenant")
+		require.Nil(t, err)
+		assert.Equal(t, []*models.Tenant{
+			{Name: "tenant1", ActivityStatus: models.TenantActivityStatusINACTIVE},
+			{Name: "tenant2", ActivityStatus: models.TenantActivityStatusINACTIVE},
+		}, tenants)
+	})
+
+	t.Run("deleting tenants", func(t *testing.T) {
+		err := m.DeleteTenants(ctx, nil, "MultiTenant", []string{"tenant3"})
+		assert.EqualError(t, err, `tenant "tenant3" not found`)
+
+		err = m.DeleteTenants(ctx, nil, "MultiTenant", []string{"tenant1"})
+		require.Nil(t, err)
+
+		tenants, err := m.GetTenants(ctx, nil, "MultiTenant")
+		require.Nil(t, err)
+		assert.Equal(t, []*models.Tenant{
+			{Name: "tenant2", ActivityStatus: models.TenantActivityStatusINACTIVE},
+		}, tenants)
+	})
+
+	t.Run("deleting the class removes its tenants", func(t *testing.T) {
+		require.Nil(t, m.DeleteClass(ctx, nil, "MultiTenant"))
+		assert.Len(t, m.TenantsSkipAuth("MultiTenant"), 0)
+	})
+}
@@ -21,8 +21,12 @@ import (
 )
 
 func (m *Manager) UpdateClass(ctx context.Context, principal *models.Principal,
-	className string, updated *models.Class) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	className string, updated *models.Class) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", classResource(className), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "update", classResource(className))
 	if err != nil {
 		return err
 	}
@@ -100,6 +104,14 @@ func (m *Manager) validateImmutableFields(initial, updated *models.Class) error
 		return errors.Errorf("module config is immutable")
 	}
 
+	if !reflect.DeepEqual(initial.NamedVectors, updated.NamedVectors) {
+		return errors.Errorf("named vectors are immutable")
+	}
+
+	if schema.MultiTenancyEnabled(initial) != schema.MultiTenancyEnabled(updated) {
+		return errors.Errorf("multi-tenancy config is immutable")
+	}
+
 	return nil
 }
 
@@ -124,8 +136,12 @@ func (m *Manager) validateImmutableTextField(u immutableText,
 
 // UpdateObject which exists
 func (m *Manager) UpdateObject(ctx context.Context, principal *models.Principal,
-	name string, class *models.Class) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	name string, class *models.Class) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", classResource(name), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "update", classResource(name))
 	if err != nil {
 		return err
 	}
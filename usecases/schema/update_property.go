@@ -14,14 +14,19 @@ package schema
 import (
 	"context"
 
+	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 )
 
 // UpdateClassProperty of an existing Object Property
 func (m *Manager) UpdateClassProperty(ctx context.Context, principal *models.Principal,
-	class string, name string, property *models.Property) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	class string, name string, property *models.Property) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", propertyResource(class, name), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "update", classResource(class))
 	if err != nil {
 		return err
 	}
@@ -71,21 +76,108 @@ func (m *Manager) updateClassProperty(ctx context.Context, className string, nam
 		return err
 	}
 
+	if property.Tokenization != "" &&
+		property.Tokenization != schema.PropertyTokenization(prop) {
+		return errors.Errorf("tokenization of property cannot be changed from %q "+
+			"to %q: the inverted index would have to be rebuilt",
+			schema.PropertyTokenization(prop), property.Tokenization)
+	}
+
+	if property.IndexTrigrams && !prop.IndexTrigrams {
+		return errors.Errorf("indexTrigrams of property cannot be enabled after " +
+			"the property has been created: the inverted index would have to be rebuilt")
+	}
+
+	var newDataType []string
+	if len(property.DataType) > 0 && !equalDataTypes(prop.DataType, property.DataType) {
+		if err := validatePropertyDataTypeUpdate(prop.DataType, property.DataType); err != nil {
+			return err
+		}
+		newDataType = property.DataType
+	}
+
 	// Validated! Now apply the changes.
+	previousDataType := prop.DataType
 	prop.Name = propNameAfterUpdate
+	if newDataType != nil {
+		prop.DataType = newDataType
+	}
 
 	err = m.saveSchema(ctx)
 	if err != nil {
 		return nil
 	}
 
-	return m.migrator.UpdateProperty(ctx, className, name, newName)
+	if err := m.migrator.UpdateProperty(ctx, className, name, newName); err != nil {
+		return err
+	}
+
+	if newDataType == nil {
+		return nil
+	}
+
+	// the migrator reads the property from the schema, so the new data type
+	// has to be saved first. If the migration fails, it is rolled back, as the
+	// schema must not claim a data type the indices were not migrated to.
+	err = m.migrator.UpdatePropertyDataType(ctx, className, propNameAfterUpdate,
+		newDataType)
+	if err != nil {
+		prop.DataType = previousDataType
+		if saveErr := m.saveSchema(ctx); saveErr != nil {
+			return errors.Wrapf(err, "roll back data type (%v)", saveErr)
+		}
+		return errors.Wrap(err, "migrate data type")
+	}
+
+	return nil
+}
+
+// widenableDataTypes contains the data type migrations which can be applied
+// to existing data, as every stored value of the old type is also a valid
+// value of the new type. Only the inverted index needs to be rebuilt.
+var widenableDataTypes = map[schema.DataType]schema.DataType{
+	schema.DataTypeInt:    schema.DataTypeNumber,
+	schema.DataTypeString: schema.DataTypeText,
+}
+
+func validatePropertyDataTypeUpdate(previous, next []string) error {
+	if len(previous) != 1 || len(next) != 1 {
+		return errors.Errorf("data type of property cannot be changed from %v to %v: "+
+			"only primitive data types can be migrated", previous, next)
+	}
+
+	target, ok := widenableDataTypes[schema.DataType(previous[0])]
+	if !ok || target != schema.DataType(next[0]) {
+		return errors.Errorf("data type of property cannot be changed from %q to %q: "+
+			"only widening migrations (int to number, string to text) are supported",
+			previous[0], next[0])
+	}
+
+	return nil
+}
+
+func equalDataTypes(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
 }
 
 // UpdatePropertyAddDataType adds another data type to a property. Warning: It does not lock on its own, assumes that it is called from when a schema lock is already held!
 func (m *Manager) UpdatePropertyAddDataType(ctx context.Context, principal *models.Principal,
-	className string, propName string, newDataType string) error {
-	err := m.authorizer.Authorize(principal, "update", "schema/objects")
+	className string, propName string, newDataType string) (err error) {
+	defer func() {
+		m.auditor.Log(ctx, principal, "update", propertyResource(className, propName), err)
+	}()
+
+	err = m.authorizer.Authorize(principal, "update", classResource(className))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,158 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package schema
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/semi-technologies/weaviate/entities/models"
+	"github.com/semi-technologies/weaviate/usecases/config"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPropertyDataTypeUpdates(t *testing.T) {
+	type test struct {
+		name        string
+		initial     string
+		updated     string
+		expectedErr bool
+	}
+
+	tests := []test{
+		{name: "int to number", initial: "int", updated: "number"},
+		{name: "string to text", initial: "string", updated: "text"},
+		{name: "number to int", initial: "number", updated: "int", expectedErr: true},
+		{name: "text to string", initial: "text", updated: "string", expectedErr: true},
+		{name: "int to string", initial: "int", updated: "string", expectedErr: true},
+		{name: "string to date", initial: "string", updated: "date", expectedErr: true},
+		{name: "unchanged", initial: "int", updated: "int"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			m := newSchemaManager()
+
+			require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+				Class:      "MyClass",
+				Vectorizer: "none",
+				Properties: []*models.Property{
+					{Name: "prop", DataType: []string{test.initial}},
+				},
+			}))
+
+			err := m.UpdateClassProperty(ctx, nil, "MyClass", "prop", &models.Property{
+				Name:     "prop",
+				DataType: []string{test.updated},
+			})
+
+			class, getErr := m.GetClass(ctx, nil, "MyClass")
+			require.Nil(t, getErr)
+			if test.expectedErr {
+				assert.NotNil(t, err)
+				assert.Equal(t, []string{test.initial}, class.Properties[0].DataType)
+				return
+			}
+
+			require.Nil(t, err)
+			assert.Equal(t, []string{test.updated}, class.Properties[0].DataType)
+		})
+	}
+}
+
+func TestPropertyDataTypeUpdateFailedMigration(t *testing.T) {
+	ctx := context.Background()
+	logger, _ := test.NewNullLogger()
+	m, err := NewManager(&failingDataTypeMigrator{}, newFakeRepo(), logger,
+		&fakeAuthorizer{}, &fakeAuditor{},
+		config.Config{DefaultVectorizerModule: config.VectorizerModuleNone},
+		dummyParseVectorConfig, &fakeVectorizerValidator{}, &fakeModuleConfig{})
+	require.Nil(t, err)
+
+	require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+		Class:      "MyClass",
+		Vectorizer: "none",
+		Properties: []*models.Property{
+			{Name: "prop", DataType: []string{"int"}},
+		},
+	}))
+
+	err = m.UpdateClassProperty(ctx, nil, "MyClass", "prop", &models.Property{
+		Name:     "prop",
+		DataType: []string{"number"},
+	})
+	assert.EqualError(t, err, "migrate data type: migration failed")
+
+	class, err := m.GetClass(ctx, nil, "MyClass")
+	require.Nil(t, err)
+	assert.Equal(t, []string{"int"}, class.Properties[0].DataType)
+}
+
+type failingDataTypeMigrator struct {
+	NilMigrator
+}
+
+func (f *failingDataTypeMigrator) UpdatePropertyDataType(ctx context.Context,
+	className string, propName string, newDataType []string) error {
+	return errors.New("migration failed")
+}
+
+func TestPropertyTokenizationUpdates(t *testing.T) {
+	type test struct {
+		name        string
+		initial     string
+		updated     string
+		expectedErr bool
+	}
+
+	tests := []test{
+		{name: "unchanged", initial: "field", updated: "field"},
+		{name: "not set in update", initial: "field", updated: ""},
+		{name: "default set explicitly", initial: "", updated: "word"},
+		{name: "changed", initial: "field", updated: "word", expectedErr: true},
+		{name: "changed from default", initial: "", updated: "lowercase", expectedErr: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctx := context.Background()
+			m := newSchemaManager()
+
+			require.Nil(t, m.AddClass(ctx, nil, &models.Class{
+				Class:      "MyClass",
+				Vectorizer: "none",
+				Properties: []*models.Property{
+					{Name: "prop", DataType: []string{"text"}, Tokenization: test.initial},
+				},
+			}))
+
+			err := m.UpdateClassProperty(ctx, nil, "MyClass", "prop", &models.Property{
+				Name:         "prop",
+				Tokenization: test.updated,
+			})
+
+			class, getErr := m.GetClass(ctx, nil, "MyClass")
+			require.Nil(t, getErr)
+			assert.Equal(t, test.initial, class.Properties[0].Tokenization)
+			if test.expectedErr {
+				assert.NotNil(t, err)
+				return
+			}
+
+			require.Nil(t, err)
+		})
+	}
+}
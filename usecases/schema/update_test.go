@@ -65,6 +65,18 @@ func TestClassUpdates(t *testing.T) {
 					"vector index type is immutable: " +
 						"attempted change from \"hnsw\" to \"lsh\""),
 			},
+			{
+				name: "attempting to add a named vector",
+				initial: &models.Class{
+					Class:        "InitialName",
+					NamedVectors: []string{"title_vector"},
+				},
+				update: &models.Class{
+					Class:        "InitialName",
+					NamedVectors: []string{"title_vector", "body_vector"},
+				},
+				expectedError: errors.Errorf("named vectors are immutable"),
+			},
 			{
 				name:    "attempting to add a property",
 				initial: &models.Class{Class: "InitialName"},
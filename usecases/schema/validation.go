@@ -14,6 +14,7 @@ package schema
 import (
 	"context"
 	"fmt"
+	"regexp"
 
 	"github.com/pkg/errors"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -28,6 +29,12 @@ func (m *Manager) validateClassNameUniqueness(className string) error {
 		}
 	}
 
+	for _, alias := range m.state.SchemaFor().Aliases {
+		if className == alias.Alias {
+			return fmt.Errorf("Name '%s' already used as a name for an alias", className)
+		}
+	}
+
 	return nil
 }
 
@@ -47,6 +54,58 @@ func validatePropertyNameUniqueness(propertyName string, class *models.Class) er
 	return nil
 }
 
+// validateNestedProperties checks that properties of type object or object[]
+// describe their nested objects and that all other properties don't
+func validateNestedProperties(property *models.Property) error {
+	if !schema.IsNestedDataType(property.DataType) {
+		if len(property.NestedProperties) > 0 {
+			return fmt.Errorf("nestedProperties are only allowed for dataType '%s' or '%s'",
+				schema.DataTypeObject, schema.DataTypeObjectArray)
+		}
+		return nil
+	}
+
+	return validateNestedPropertyList(property.NestedProperties)
+}
+
+func validateNestedPropertyList(nested []*models.NestedProperty) error {
+	if len(nested) == 0 {
+		return fmt.Errorf("dataType '%s' and '%s' require at least one nested property",
+			schema.DataTypeObject, schema.DataTypeObjectArray)
+	}
+
+	foundNames := map[string]bool{}
+	for _, prop := range nested {
+		if _, err := schema.ValidatePropertyName(prop.Name); err != nil {
+			return err
+		}
+
+		if foundNames[prop.Name] {
+			return fmt.Errorf("nested property '%s' is defined more than once", prop.Name)
+		}
+		foundNames[prop.Name] = true
+
+		if len(prop.DataType) != 1 || !schema.IsValidNestedDataType(prop.DataType[0]) {
+			return fmt.Errorf("nested property '%s': invalid dataType %v, must be one of %v",
+				prop.Name, prop.DataType, schema.NestedPropertyDataTypes)
+		}
+
+		if !schema.IsNestedDataType(prop.DataType) {
+			if len(prop.NestedProperties) > 0 {
+				return fmt.Errorf("nested property '%s': nestedProperties are only allowed "+
+					"for dataType '%s' or '%s'", prop.Name, schema.DataTypeObject, schema.DataTypeObjectArray)
+			}
+			continue
+		}
+
+		if err := validateNestedPropertyList(prop.NestedProperties); err != nil {
+			return fmt.Errorf("nested property '%s': %v", prop.Name, err)
+		}
+	}
+
+	return nil
+}
+
 // Check that the format of the name is correct
 func (m *Manager) validatePropertyName(ctx context.Context, className string,
 	propertyName string, moduleConfig interface{}) error {
@@ -63,6 +122,10 @@ func (m *Manager) validateVectorSettings(ctx context.Context, class *models.Clas
 		return err
 	}
 
+	if err := m.validateNamedVectors(ctx, class); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -87,3 +150,85 @@ func (m *Manager) validateVectorIndex(ctx context.Context, class *models.Class)
 			class.VectorIndexType)
 	}
 }
+
+var validateNamedVectorRegex = regexp.MustCompile(`^[A-Za-z][_0-9A-Za-z]*$`)
+
+func (m *Manager) validateNamedVectors(ctx context.Context, class *models.Class) error {
+	found := map[string]bool{}
+	for _, name := range class.NamedVectors {
+		if !validateNamedVectorRegex.MatchString(name) {
+			return errors.Errorf("named vector %q: name must start with a letter "+
+				"and may only contain letters, digits and underscores", name)
+		}
+
+		if found[name] {
+			return errors.Errorf("named vector %q is defined more than once", name)
+		}
+
+		found[name] = true
+	}
+
+	return nil
+}
+
+// validateInvertedIndexConfig checks the analyzers of the class, analyzers
+// of individual properties can only be configured for string and text
+// properties of the class
+func validateInvertedIndexConfig(class *models.Class) error {
+	cfg := class.InvertedIndexConfig
+	if cfg == nil {
+		return nil
+	}
+
+	if err := validateAnalyzerConfig(cfg.Analyzer); err != nil {
+		return errors.Wrap(err, "invertedIndexConfig.analyzer")
+	}
+
+	for name, analyzer := range cfg.PropertyAnalyzers {
+		analyzer := analyzer
+		if err := validateAnalyzerConfig(&analyzer); err != nil {
+			return errors.Wrapf(err, "invertedIndexConfig.propertyAnalyzers.%s", name)
+		}
+
+		prop, err := schema.GetPropertyByName(class, name)
+		if err != nil {
+			return errors.Errorf("invertedIndexConfig.propertyAnalyzers.%s: class "+
+				"has no property %q", name, name)
+		}
+
+		if len(prop.DataType) != 1 ||
+			schema.DefaultTokenization(schema.DataType(prop.DataType[0])) == "" {
+			return errors.Errorf("invertedIndexConfig.propertyAnalyzers.%s: "+
+				"analyzers are only supported for properties of type string, text, "+
+				"string[] and text[], got %v", name, prop.DataType)
+		}
+	}
+
+	return nil
+}
+
+func validateAnalyzerConfig(cfg *models.AnalyzerConfig) error {
+	if cfg == nil {
+		return nil
+	}
+
+	switch cfg.Stemmer {
+	case "", models.AnalyzerConfigStemmerNone, models.AnalyzerConfigStemmerEnglish,
+		models.AnalyzerConfigStemmerGerman, models.AnalyzerConfigStemmerDutch:
+	default:
+		return errors.Errorf("unsupported stemmer %q", cfg.Stemmer)
+	}
+
+	if cfg.Stopwords == nil {
+		return nil
+	}
+
+	switch cfg.Stopwords.Preset {
+	case "", models.StopwordConfigPresetNone, models.StopwordConfigPresetEnglish,
+		models.StopwordConfigPresetGerman, models.StopwordConfigPresetDutch:
+	default:
+		return errors.Errorf("unsupported stopword preset %q", cfg.Stopwords.Preset)
+	}
+
+	return nil
+}
@@ -406,3 +406,371 @@ func Test_Validation_PropertyNames(t *testing.T) {
 		})
 	})
 }
+
+func Test_Validation_NamedVectors(t *testing.T) {
+	type testCase struct {
+		name         string
+		namedVectors []string
+		expectedErr  string
+	}
+
+	tests := []testCase{
+		{
+			name:         "valid named vectors",
+			namedVectors: []string{"title_vector", "body_vector"},
+		},
+		{
+			name:         "invalid name",
+			namedVectors: []string{"title-vector"},
+			expectedErr: "named vector \"title-vector\": name must start with a letter " +
+				"and may only contain letters, digits and underscores",
+		},
+		{
+			name:         "duplicate name",
+			namedVectors: []string{"title_vector", "title_vector"},
+			expectedErr:  "named vector \"title_vector\" is defined more than once",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := &models.Class{
+				Vectorizer:   "text2vec-contextionary",
+				Class:        "ValidName",
+				NamedVectors: test.namedVectors,
+			}
+
+			m := newSchemaManager()
+			err := m.AddClass(context.Background(), nil, class)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedErr, err.Error())
+			}
+		})
+	}
+}
+
+func Test_Validation_NestedProperties(t *testing.T) {
+	type testCase struct {
+		name        string
+		property    *models.Property
+		expectedErr string
+	}
+
+	tests := []testCase{
+		{
+			name: "valid nested object",
+			property: &models.Property{
+				Name:     "address",
+				DataType: []string{"object"},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: []string{"string"}},
+					{Name: "zipCode", DataType: []string{"int"}},
+				},
+			},
+		},
+		{
+			name: "valid list of nested objects with nested objects",
+			property: &models.Property{
+				Name:     "addresses",
+				DataType: []string{"object[]"},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: []string{"string"}},
+					{
+						Name:     "location",
+						DataType: []string{"object"},
+						NestedProperties: []*models.NestedProperty{
+							{Name: "floor", DataType: []string{"int"}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "object without nested properties",
+			property: &models.Property{
+				Name:     "address",
+				DataType: []string{"object"},
+			},
+			expectedErr: "property 'address': dataType 'object' and 'object[]' " +
+				"require at least one nested property",
+		},
+		{
+			name: "nested properties on a primitive property",
+			property: &models.Property{
+				Name:     "address",
+				DataType: []string{"string"},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: []string{"string"}},
+				},
+			},
+			expectedErr: "property 'address': nestedProperties are only allowed " +
+				"for dataType 'object' or 'object[]'",
+		},
+		{
+			name: "unsupported nested data type",
+			property: &models.Property{
+				Name:     "address",
+				DataType: []string{"object"},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "location", DataType: []string{"geoCoordinates"}},
+				},
+			},
+			expectedErr: "property 'address': nested property 'location': invalid " +
+				"dataType [geoCoordinates], must be one of [string text int number " +
+				"boolean date object object[]]",
+		},
+		{
+			name: "duplicate nested property",
+			property: &models.Property{
+				Name:     "address",
+				DataType: []string{"object"},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "city", DataType: []string{"string"}},
+					{Name: "city", DataType: []string{"text"}},
+				},
+			},
+			expectedErr: "property 'address': nested property 'city' is defined more than once",
+		},
+		{
+			name: "invalid deeply nested property",
+			property: &models.Property{
+				Name:     "address",
+				DataType: []string{"object"},
+				NestedProperties: []*models.NestedProperty{
+					{Name: "location", DataType: []string{"object"}},
+				},
+			},
+			expectedErr: "property 'address': nested property 'location': dataType " +
+				"'object' and 'object[]' require at least one nested property",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := &models.Class{
+				Vectorizer: "text2vec-contextionary",
+				Class:      "ValidName",
+				Properties: []*models.Property{test.property},
+			}
+
+			m := newSchemaManager()
+			err := m.AddClass(context.Background(), nil, class)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedErr, err.Error())
+			}
+		})
+	}
+}
+
+func Test_Validation_Tokenization(t *testing.T) {
+	type testCase struct {
+		name        string
+		property    *models.Property
+		expectedErr string
+	}
+
+	vFalse := false
+
+	tests := []testCase{
+		{
+			name:     "default tokenization",
+			property: &models.Property{Name: "title", DataType: []string{"text"}},
+		},
+		{
+			name: "field tokenization on a text property",
+			property: &models.Property{
+				Name:         "title",
+				DataType:     []string{"text"},
+				Tokenization: "field",
+			},
+		},
+		{
+			name: "lowercase tokenization on a string array property",
+			property: &models.Property{
+				Name:         "tags",
+				DataType:     []string{"string[]"},
+				Tokenization: "lowercase",
+			},
+		},
+		{
+			name: "unsupported tokenization",
+			property: &models.Property{
+				Name:         "title",
+				DataType:     []string{"text"},
+				Tokenization: "ngram",
+			},
+			expectedErr: "property 'title': unsupported tokenization \"ngram\", must " +
+				"be one of [word whitespace field lowercase trigram]",
+		},
+		{
+			name: "tokenization on an int property",
+			property: &models.Property{
+				Name:         "count",
+				DataType:     []string{"int"},
+				Tokenization: "field",
+			},
+			expectedErr: "property 'count': tokenization is only supported for " +
+				"properties of type string, text, string[] and text[], got [int]",
+		},
+		{
+			name: "trigram index on a text property",
+			property: &models.Property{
+				Name:          "title",
+				DataType:      []string{"text"},
+				IndexTrigrams: true,
+			},
+		},
+		{
+			name: "trigram index on an int property",
+			property: &models.Property{
+				Name:          "count",
+				DataType:      []string{"int"},
+				IndexTrigrams: true,
+			},
+			expectedErr: "property 'count': indexTrigrams is only supported for " +
+				"properties of type string, text, string[] and text[], got [int]",
+		},
+		{
+			name: "trigram index without inverted index",
+			property: &models.Property{
+				Name:          "title",
+				DataType:      []string{"string"},
+				IndexInverted: &vFalse,
+				IndexTrigrams: true,
+			},
+			expectedErr: "property 'title': indexTrigrams requires the property to " +
+				"be indexed in the inverted index, but indexInverted is false",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run("add class: "+test.name, func(t *testing.T) {
+			class := &models.Class{
+				Vectorizer: "text2vec-contextionary",
+				Class:      "ValidName",
+				Properties: []*models.Property{test.property},
+			}
+
+			m := newSchemaManager()
+			err := m.AddClass(context.Background(), nil, class)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedErr, err.Error())
+			}
+		})
+
+		t.Run("add property: "+test.name, func(t *testing.T) {
+			class := &models.Class{
+				Vectorizer: "text2vec-contextionary",
+				Class:      "ValidName",
+			}
+
+			m := newSchemaManager()
+			require.Nil(t, m.AddClass(context.Background(), nil, class))
+			err := m.AddClassProperty(context.Background(), nil, "ValidName",
+				test.property)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedErr, err.Error())
+			}
+		})
+	}
+}
+
+func Test_Validation_InvertedIndexConfig(t *testing.T) {
+	type testCase struct {
+		name        string
+		config      *models.InvertedIndexConfig
+		expectedErr string
+	}
+
+	tests := []testCase{
+		{
+			name: "valid analyzers",
+			config: &models.InvertedIndexConfig{
+				Analyzer: &models.AnalyzerConfig{
+					Stemmer:      "english",
+					ASCIIFolding: true,
+					Stopwords: &models.StopwordConfig{
+						Preset:    "english",
+						Additions: []string{"foo"},
+					},
+				},
+				PropertyAnalyzers: map[string]models.AnalyzerConfig{
+					"Title": {Stemmer: "dutch"},
+				},
+			},
+		},
+		{
+			name: "unsupported stemmer",
+			config: &models.InvertedIndexConfig{
+				Analyzer: &models.AnalyzerConfig{Stemmer: "klingon"},
+			},
+			expectedErr: "invertedIndexConfig.analyzer: unsupported stemmer \"klingon\"",
+		},
+		{
+			name: "unsupported stopword preset",
+			config: &models.InvertedIndexConfig{
+				PropertyAnalyzers: map[string]models.AnalyzerConfig{
+					"title": {Stopwords: &models.StopwordConfig{Preset: "klingon"}},
+				},
+			},
+			expectedErr: "invertedIndexConfig.propertyAnalyzers.title: unsupported " +
+				"stopword preset \"klingon\"",
+		},
+		{
+			name: "analyzer for an unknown property",
+			config: &models.InvertedIndexConfig{
+				PropertyAnalyzers: map[string]models.AnalyzerConfig{
+					"author": {Stemmer: "german"},
+				},
+			},
+			expectedErr: "invertedIndexConfig.propertyAnalyzers.author: class has no " +
+				"property \"author\"",
+		},
+		{
+			name: "analyzer for an int property",
+			config: &models.InvertedIndexConfig{
+				PropertyAnalyzers: map[string]models.AnalyzerConfig{
+					"pages": {Stemmer: "german"},
+				},
+			},
+			expectedErr: "invertedIndexConfig.propertyAnalyzers.pages: analyzers are " +
+				"only supported for properties of type string, text, string[] and " +
+				"text[], got [int]",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			class := &models.Class{
+				Vectorizer: "text2vec-contextionary",
+				Class:      "ValidName",
+				Properties: []*models.Property{
+					{Name: "title", DataType: []string{"text"}},
+					{Name: "pages", DataType: []string{"int"}},
+				},
+				InvertedIndexConfig: test.config,
+			}
+
+			m := newSchemaManager()
+			err := m.AddClass(context.Background(), nil, class)
+			if test.expectedErr == "" {
+				assert.Nil(t, err)
+			} else {
+				require.NotNil(t, err)
+				assert.Equal(t, test.expectedErr, err.Error())
+			}
+		})
+	}
+}
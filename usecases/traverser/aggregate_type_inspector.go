@@ -66,7 +66,15 @@ func (i *typeInspector) hasTypeAggregator(aggs []Aggregator) bool {
 }
 
 func (i *typeInspector) extendResWithType(res *aggregation.Result, propName string, dataType []string) error {
-	for groupIndex, group := range res.Groups {
+	return i.extendGroupsWithType(res.Groups, propName, dataType)
+}
+
+func (i *typeInspector) extendGroupsWithType(groups []aggregation.Group, propName string, dataType []string) error {
+	for groupIndex, group := range groups {
+		if err := i.extendGroupsWithType(group.Groups, propName, dataType); err != nil {
+			return err
+		}
+
 		prop, ok := group.Properties[propName]
 		if !ok {
 			prop = aggregation.Property{}
@@ -85,7 +93,7 @@ func (i *typeInspector) extendResWithType(res *aggregation.Result, propName stri
 			prop.ReferenceAggregation.PointingTo = dataType
 		}
 
-		res.Groups[groupIndex].Properties[propName] = prop
+		groups[groupIndex].Properties[propName] = prop
 	}
 
 	return nil
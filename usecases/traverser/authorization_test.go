@@ -39,16 +39,16 @@ func Test_Traverser_Authorization(t *testing.T) {
 	tests := []testCase{
 		testCase{
 			methodName:       "GetClass",
-			additionalArgs:   []interface{}{GetParams{}},
+			additionalArgs:   []interface{}{GetParams{ClassName: "Article"}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "traversal/Article",
 		},
 
 		testCase{
 			methodName:       "Aggregate",
-			additionalArgs:   []interface{}{&AggregateParams{}},
+			additionalArgs:   []interface{}{&AggregateParams{ClassName: "Log"}},
 			expectedVerb:     "get",
-			expectedResource: "traversal/*",
+			expectedResource: "traversal/Log",
 		},
 
 		testCase{
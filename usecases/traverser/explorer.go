@@ -58,7 +58,8 @@ type vectorClassSearch interface {
 	VectorSearch(ctx context.Context, vector []float32, limit int,
 		filters *filters.LocalFilter) ([]search.Result, error)
 	ObjectByID(ctx context.Context, id strfmt.UUID,
-		props SelectProperties, additional AdditionalProperties) (*search.Result, error)
+		props SelectProperties, additional AdditionalProperties,
+		tenant string) (*search.Result, error)
 }
 
 // NewExplorer with search and connector repo
@@ -162,7 +163,7 @@ func (e *Explorer) searchResultsToGetResponse(ctx context.Context,
 		}
 
 		if searchVector != nil {
-			dist, err := e.distancer(res.Vector, searchVector)
+			dist, err := e.distancer(e.vectorOfResult(res, params), searchVector)
 			if err != nil {
 				return nil, errors.Errorf("explorer: calculate distance: %v", err)
 			}
@@ -247,6 +248,16 @@ func (e *Explorer) exctractAdditionalPropertiesFromRef(ref interface{}, refClass
 	}
 }
 
+// vectorOfResult returns the vector of the result which was used in the
+// search, i.e. the named vector if a target vector was set
+func (e *Explorer) vectorOfResult(res search.Result, params GetParams) []float32 {
+	if params.NearVector != nil && params.NearVector.TargetVector != "" {
+		return res.Vectors[params.NearVector.TargetVector]
+	}
+
+	return res.Vector
+}
+
 func (e *Explorer) extractCertaintyFromParams(params GetParams) float64 {
 	if params.NearVector != nil {
 		return params.NearVector.Certainty
@@ -335,23 +346,38 @@ func (e *Explorer) validateExploreParams(params ExploreParams) error {
 
 func (e *Explorer) vectorFromParams(ctx context.Context,
 	params GetParams) ([]float32, error) {
-	err := e.validateNearParams(params.NearVector, params.NearObject, params.ModuleParams)
+	return e.vectorFromNearParams(ctx, params.ClassName, params.NearVector,
+		params.NearObject, params.ModuleParams, params.Tenant)
+}
+
+// AggregateSearchVector calculates the vector of the near<Media> argument of
+// a vector-scoped aggregation
+func (e *Explorer) AggregateSearchVector(ctx context.Context,
+	params AggregateParams) ([]float32, error) {
+	return e.vectorFromNearParams(ctx, params.ClassName.String(), params.NearVector,
+		params.NearObject, params.ModuleParams, params.Tenant)
+}
+
+func (e *Explorer) vectorFromNearParams(ctx context.Context, className string,
+	nearVector *NearVectorParams, nearObject *NearObjectParams,
+	moduleParams map[string]interface{}, tenant string) ([]float32, error) {
+	err := e.validateNearParams(nearVector, nearObject, moduleParams)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(params.ModuleParams) == 1 {
-		for name, value := range params.ModuleParams {
-			return e.vectorFromModules(ctx, params.ClassName, name, value)
+	if len(moduleParams) == 1 {
+		for name, value := range moduleParams {
+			return e.vectorFromModules(ctx, className, name, value, tenant)
 		}
 	}
 
-	if params.NearVector != nil {
-		return params.NearVector.Vector, nil
+	if nearVector != nil {
+		return nearVector.Vector, nil
 	}
 
-	if params.NearObject != nil {
-		vector, err := e.vectorFromNearObjectParams(ctx, params.NearObject)
+	if nearObject != nil {
+		vector, err := e.vectorFromNearObjectParams(ctx, nearObject, tenant)
 		if err != nil {
 			return nil, errors.Errorf("nearObject params: %v", err)
 		}
@@ -382,7 +408,8 @@ func (e *Explorer) vectorFromExploreParams(ctx context.Context,
 	}
 
 	if params.NearObject != nil {
-		vector, err := e.vectorFromNearObjectParams(ctx, params.NearObject)
+		// explorations span all classes and are therefore not scoped to a tenant
+		vector, err := e.vectorFromNearObjectParams(ctx, params.NearObject, "")
 		if err != nil {
 			return nil, errors.Errorf("nearObject params: %v", err)
 		}
@@ -396,10 +423,11 @@ func (e *Explorer) vectorFromExploreParams(ctx context.Context,
 }
 
 func (e *Explorer) vectorFromModules(ctx context.Context,
-	className, paramName string, paramValue interface{}) ([]float32, error) {
+	className, paramName string, paramValue interface{},
+	tenant string) ([]float32, error) {
 	if e.modulesProvider != nil {
 		vector, err := e.modulesProvider.VectorFromSearchParam(ctx,
-			className, paramName, paramValue, e.findVector,
+			className, paramName, paramValue, e.findVectorFn(tenant),
 		)
 		if err != nil {
 			return nil, errors.Errorf("vectorize params: %v", err)
@@ -414,7 +442,7 @@ func (e *Explorer) crossClassVectorFromModules(ctx context.Context,
 	paramName string, paramValue interface{}) ([]float32, error) {
 	if e.modulesProvider != nil {
 		vector, err := e.modulesProvider.CrossClassVectorFromSearchParam(ctx,
-			paramName, paramValue, e.findVector,
+			paramName, paramValue, e.findVectorFn(""),
 		)
 		if err != nil {
 			return nil, errors.Errorf("vectorize params: %v", err)
@@ -468,7 +496,7 @@ func (e *Explorer) validateNearParams(nearVector *NearVectorParams, nearObject *
 }
 
 func (e *Explorer) vectorFromNearObjectParams(ctx context.Context,
-	params *NearObjectParams) ([]float32, error) {
+	params *NearObjectParams, tenant string) ([]float32, error) {
 	if len(params.ID) == 0 && len(params.Beacon) == 0 {
 		return nil, errors.New("empty id and beacon")
 	}
@@ -484,11 +512,13 @@ func (e *Explorer) vectorFromNearObjectParams(ctx context.Context,
 		id = ref.TargetID
 	}
 
-	return e.findVector(ctx, id)
+	return e.findVector(ctx, id, tenant)
 }
 
-func (e *Explorer) findVector(ctx context.Context, id strfmt.UUID) ([]float32, error) {
-	res, err := e.search.ObjectByID(ctx, id, SelectProperties{}, AdditionalProperties{})
+func (e *Explorer) findVector(ctx context.Context, id strfmt.UUID,
+	tenant string) ([]float32, error) {
+	res, err := e.search.ObjectByID(ctx, id, SelectProperties{}, AdditionalProperties{},
+		tenant)
 	if err != nil {
 		return nil, err
 	}
@@ -499,6 +529,14 @@ func (e *Explorer) findVector(ctx context.Context, id strfmt.UUID) ([]float32, e
 	return res.Vector, nil
 }
 
+// findVectorFn binds findVector to a tenant, so modules can look up the
+// vectors of objects within that tenant
+func (e *Explorer) findVectorFn(tenant string) modulecapabilities.FindVectorFn {
+	return func(ctx context.Context, id strfmt.UUID) ([]float32, error) {
+		return e.findVector(ctx, id, tenant)
+	}
+}
+
 func beacon(res search.Result) string {
 	return fmt.Sprintf("weaviate://localhost/%s", res.ID)
 }
@@ -106,7 +106,8 @@ func (f *fakeVectorSearcher) ClassSearch(ctx context.Context,
 }
 
 func (f *fakeVectorSearcher) ObjectByID(ctx context.Context, id strfmt.UUID,
-	props SelectProperties, additional AdditionalProperties) (*search.Result, error) {
+	props SelectProperties, additional AdditionalProperties,
+	tenant string) (*search.Result, error) {
 	args := f.Called(id)
 	return args.Get(0).(*search.Result), args.Error(1)
 }
@@ -154,6 +155,14 @@ func (f *fakeExplorer) Concepts(ctx context.Context, p ExploreParams) ([]search.
 	return nil, nil
 }
 
+func (f *fakeExplorer) AggregateSearchVector(ctx context.Context,
+	p AggregateParams) ([]float32, error) {
+	if p.NearVector != nil {
+		return p.NearVector.Vector, nil
+	}
+	return nil, nil
+}
+
 type fakeSchemaGetter struct {
 	schema schema.Schema
 }
@@ -162,6 +171,10 @@ func (f *fakeSchemaGetter) GetSchemaSkipAuth() schema.Schema {
 	return f.schema
 }
 
+func (f *fakeSchemaGetter) TenantsSkipAuth(className string) []*models.Tenant {
+	return nil
+}
+
 type fakeInterpretation struct{}
 
 func (f *fakeInterpretation) AdditionalPropertyFn(ctx context.Context,
@@ -52,6 +52,7 @@ type VectorSearcher interface {
 type explorer interface {
 	GetClass(ctx context.Context, params GetParams) ([]interface{}, error)
 	Concepts(ctx context.Context, params ExploreParams) ([]search.Result, error)
+	AggregateSearchVector(ctx context.Context, params AggregateParams) ([]float32, error)
 }
 
 // NewTraverser to traverse the knowledge graph
@@ -14,6 +14,7 @@ package traverser
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
@@ -23,7 +24,18 @@ import (
 // Aggregate resolves meta queries
 func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	params *AggregateParams) (interface{}, error) {
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	// an alias is aggregated like a regular class, everything from the
+	// authorization to the actual aggregation is done for the class it points to
+	sch := t.schemaGetter.GetSchemaSkipAuth()
+	params.ClassName = schema.ClassName(sch.ResolveAlias(params.ClassName.String()))
+	resolveFilterAliases(sch, params.Filters)
+	resolvePathAliases(sch, params.GroupBy)
+	for _, path := range params.SubGroupBy {
+		resolvePathAliases(sch, path)
+	}
+
+	err := t.authorizer.Authorize(principal, "get",
+		fmt.Sprintf("traversal/%s", params.ClassName))
 	if err != nil {
 		return nil, err
 	}
@@ -34,6 +46,20 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	}
 	defer unlock()
 
+	if params.DateGrouping != nil {
+		if err := params.DateGrouping.validate(params.GroupBy); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateGrouping(params); err != nil {
+		return nil, err
+	}
+
+	if err := t.setAggregateSearchVector(ctx, params); err != nil {
+		return nil, err
+	}
+
 	inspector := newTypeInspector(t.schemaGetter)
 
 	res, err := t.vectorSearcher.Aggregate(ctx, *params)
@@ -44,6 +70,61 @@ func (t *Traverser) Aggregate(ctx context.Context, principal *models.Principal,
 	return inspector.WithTypes(res, *params)
 }
 
+// validateGrouping makes sure the nested groupBy levels and the group sort
+// order are only set together with a groupBy path
+func validateGrouping(params *AggregateParams) error {
+	if params.GroupBy == nil {
+		if len(params.SubGroupBy) > 0 {
+			return fmt.Errorf("subGroupBy can only be used together with groupBy")
+		}
+		if params.GroupSort != nil {
+			return fmt.Errorf("groupSort can only be used together with groupBy")
+		}
+		return nil
+	}
+
+	if params.GroupSort != nil {
+		if err := params.GroupSort.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setAggregateSearchVector turns the near<Media> argument of a vector-scoped
+// aggregation into the search vector, the connector then only aggregates over
+// the objectLimit objects closest to that vector
+func (t *Traverser) setAggregateSearchVector(ctx context.Context,
+	params *AggregateParams) error {
+	if params.NearVector == nil && params.NearObject == nil &&
+		len(params.ModuleParams) == 0 {
+		if params.ObjectLimit != nil {
+			return fmt.Errorf("objectLimit can only be used together with a " +
+				"nearVector, nearObject or module near<Media> argument")
+		}
+		return nil
+	}
+
+	if params.ObjectLimit == nil {
+		return fmt.Errorf("objectLimit is required when aggregating with a " +
+			"nearVector, nearObject or module near<Media> argument")
+	}
+
+	if *params.ObjectLimit <= 0 {
+		return fmt.Errorf("objectLimit must be a positive number, got %d",
+			*params.ObjectLimit)
+	}
+
+	vector, err := t.explorer.AggregateSearchVector(ctx, *params)
+	if err != nil {
+		return fmt.Errorf("vectorize params: %v", err)
+	}
+
+	params.SearchVector = vector
+	return nil
+}
+
 // AggregateParams to describe the Local->Meta->Kind->Class query. Will be passed to
 // the individual connector methods responsible for resolving the Meta
 // query.
@@ -54,13 +135,118 @@ type AggregateParams struct {
 	GroupBy          *filters.Path
 	IncludeMetaCount bool
 	Limit            *int
+	Tenant           string
+	NearVector       *NearVectorParams
+	NearObject       *NearObjectParams
+	ModuleParams     map[string]interface{}
+	// ObjectLimit is the number of objects closest to the search vector which
+	// are aggregated, required if one of the near params is set
+	ObjectLimit  *int
+	SearchVector []float32
+	DateGrouping *DateGrouping
+	// SubGroupBy groups each group further, one path per nested level. The
+	// Limit and GroupSort apply to every level.
+	SubGroupBy []*filters.Path
+	GroupSort  *GroupSort
+}
+
+// GroupSort is the order in which the groups are returned, which also decides
+// which groups are kept if there are more than the limit. If not set, groups
+// are sorted by count descending, or chronologically for date groupings.
+type GroupSort struct {
+	By    GroupSortBy
+	Order SortOrder
+}
+
+type GroupSortBy string
+
+const (
+	GroupSortByCount GroupSortBy = "count"
+	GroupSortByValue GroupSortBy = "value"
+)
+
+type SortOrder string
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+func (s *GroupSort) validate() error {
+	switch s.By {
+	case GroupSortByCount, GroupSortByValue:
+	default:
+		return fmt.Errorf("groupSort: unsupported field %q, must be one of count "+
+			"or value", s.By)
+	}
+
+	switch s.Order {
+	case SortOrderAsc, SortOrderDesc:
+	default:
+		return fmt.Errorf("groupSort: unsupported order %q, must be one of asc "+
+			"or desc", s.Order)
+	}
+
+	return nil
+}
+
+// DateGrouping buckets the values of a date groupBy prop into calendar
+// intervals, so there is one group per day, week, month or year
+type DateGrouping struct {
+	Interval DateInterval
+	// Timezone is the IANA time zone the intervals start in, e.g.
+	// "Europe/Amsterdam", defaults to UTC
+	Timezone string
+}
+
+type DateInterval string
+
+const (
+	DateIntervalDay   DateInterval = "day"
+	DateIntervalWeek  DateInterval = "week" // weeks start on Monday
+	DateIntervalMonth DateInterval = "month"
+	DateIntervalYear  DateInterval = "year"
+)
+
+func (g *DateGrouping) validate(groupBy *filters.Path) error {
+	if groupBy == nil {
+		return fmt.Errorf("date grouping: a groupBy path is required")
+	}
+
+	switch g.Interval {
+	case DateIntervalDay, DateIntervalWeek, DateIntervalMonth, DateIntervalYear:
+	default:
+		return fmt.Errorf("date grouping: unsupported interval %q, "+
+			"must be one of day, week, month or year", g.Interval)
+	}
+
+	if _, err := time.LoadLocation(g.Timezone); err != nil {
+		return fmt.Errorf("date grouping: invalid timezone %q: %v", g.Timezone, err)
+	}
+
+	return nil
 }
 
 // Aggregator is the desired computation that the database connector
 // should perform on this property
 type Aggregator struct {
-	Type  string
-	Limit *int // used on TopOccurrence Agg
+	Type        string
+	Limit       *int              // used on TopOccurrence Agg
+	Percentiles *PercentileParams // used on Percentile Agg
+	Histogram   *HistogramParams  // used on Histogram Agg
+}
+
+// PercentileParams are the percentiles (between 0 and 100) the Percentile
+// aggregator calculates
+type PercentileParams struct {
+	Values []float64
+}
+
+// HistogramParams set the buckets of the Histogram aggregator, either a fixed
+// number of equally sized buckets or a fixed bucket width
+type HistogramParams struct {
+	Buckets  int
+	Interval float64
 }
 
 func (a Aggregator) String() string {
@@ -83,6 +269,68 @@ var (
 	MinimumAggregator = Aggregator{Type: "minimum"}
 )
 
+// Aggregators used in numerical props which require additional params
+const (
+	StandardDeviationType = "stddev"
+	VarianceType          = "variance"
+	PercentileType        = "percentile"
+	HistogramType         = "histogram"
+)
+
+var (
+	StandardDeviationAggregator = Aggregator{Type: StandardDeviationType}
+	VarianceAggregator          = Aggregator{Type: VarianceType}
+)
+
+// NewPercentileAggregator creates a PercentileAggregator for the given
+// percentiles, each of which has to be between 0 and 100
+func NewPercentileAggregator(values []float64) (Aggregator, error) {
+	if len(values) == 0 {
+		return Aggregator{}, fmt.Errorf("percentile: at least one value is required")
+	}
+
+	for _, value := range values {
+		if value < 0 || value > 100 {
+			return Aggregator{}, fmt.Errorf("percentile: value %v is not between 0 and 100",
+				value)
+		}
+	}
+
+	return Aggregator{
+		Type:        PercentileType,
+		Percentiles: &PercentileParams{Values: values},
+	}, nil
+}
+
+// NewHistogramAggregator creates a HistogramAggregator, exactly one of buckets
+// and interval has to be set
+func NewHistogramAggregator(buckets *int, interval *float64) (Aggregator, error) {
+	if (buckets == nil) == (interval == nil) {
+		return Aggregator{}, fmt.Errorf("histogram: exactly one of buckets and interval is required")
+	}
+
+	params := &HistogramParams{}
+	if buckets != nil {
+		if *buckets <= 0 || *buckets > MaxHistogramBuckets {
+			return Aggregator{}, fmt.Errorf("histogram: buckets must be between 1 and %d, got %d",
+				MaxHistogramBuckets, *buckets)
+		}
+		params.Buckets = *buckets
+	} else {
+		if *interval <= 0 {
+			return Aggregator{}, fmt.Errorf("histogram: interval must be positive, got %v",
+				*interval)
+		}
+		params.Interval = *interval
+	}
+
+	return Aggregator{Type: HistogramType, Histogram: params}, nil
+}
+
+// MaxHistogramBuckets limits the number of buckets of a histogram. If a fixed
+// interval would lead to more buckets, the interval is widened accordingly.
+const MaxHistogramBuckets = 1000
+
 // Aggregators used in boolean props
 var (
 	TotalTrueAggregator       = Aggregator{Type: "totalTrue"}
@@ -99,6 +347,11 @@ func NewTopOccurrencesAggregator(limit *int) Aggregator {
 	return Aggregator{Type: TopOccurrencesType, Limit: limit}
 }
 
+// ApproximateDistinctCountAggregator estimates the number of distinct values
+// of a string/text prop with a HyperLogLog sketch, so that high-cardinality
+// props can be counted without keeping every value in memory
+var ApproximateDistinctCountAggregator = Aggregator{Type: "approximateDistinctCount"}
+
 // Aggregators used in ref props
 var (
 	PointingToAggregator = Aggregator{Type: "pointingTo"}
@@ -132,6 +385,14 @@ func ParseAggregatorProp(name string) (Aggregator, error) {
 		return MinimumAggregator, nil
 	case SumAggregator.String():
 		return SumAggregator, nil
+	case StandardDeviationAggregator.String():
+		return StandardDeviationAggregator, nil
+	case VarianceAggregator.String():
+		return VarianceAggregator, nil
+	case PercentileType:
+		return Aggregator{Type: PercentileType}, nil // params are set by the caller
+	case HistogramType:
+		return Aggregator{Type: HistogramType}, nil // params are set by the caller
 
 	// boolean
 	case TotalTrueAggregator.String():
@@ -146,6 +407,8 @@ func ParseAggregatorProp(name string) (Aggregator, error) {
 	// string/text
 	case TopOccurrencesType:
 		return NewTopOccurrencesAggregator(ptInt(5)), nil // default to limit 5, can be overwritten
+	case ApproximateDistinctCountAggregator.String():
+		return ApproximateDistinctCountAggregator, nil
 
 	// ref
 	case PointingToAggregator.String():
@@ -16,6 +16,7 @@ import (
 	"testing"
 
 	"github.com/semi-technologies/weaviate/entities/aggregation"
+	"github.com/semi-technologies/weaviate/entities/filters"
 	"github.com/semi-technologies/weaviate/entities/models"
 	"github.com/semi-technologies/weaviate/entities/schema"
 	"github.com/semi-technologies/weaviate/usecases/config"
@@ -248,6 +249,317 @@ func Test_Traverser_Aggregate(t *testing.T) {
 		require.Nil(t, err)
 		assert.Equal(t, &expectedResult, res)
 	})
+	t.Run("querying an alias", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		locks := &fakeLocks{}
+		authorizer := &fakeAuthorizer{}
+		vectorRepo := &fakeVectorRepo{}
+		explorer := &fakeExplorer{}
+		schemaGetter := &fakeSchemaGetter{schema.Schema{
+			Objects: &models.Schema{
+				Aliases: []*models.Alias{{Alias: "MyAlias", Class: "MyClass"}},
+				Classes: aggregateTestSchema.Objects.Classes,
+			},
+		}}
+
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorRepo, explorer, schemaGetter)
+
+		params := AggregateParams{
+			ClassName: "MyAlias",
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "MyAlias", Property: "label"},
+				Value:    &filters.Value{Value: "Foo", Type: schema.DataTypeString},
+			}},
+			GroupBy:          &filters.Path{Class: "MyAlias", Property: "label"},
+			IncludeMetaCount: true,
+		}
+
+		expectedParams := AggregateParams{
+			ClassName: "MyClass",
+			Filters: &filters.LocalFilter{Root: &filters.Clause{
+				Operator: filters.OperatorEqual,
+				On:       &filters.Path{Class: "MyClass", Property: "label"},
+				Value:    &filters.Value{Value: "Foo", Type: schema.DataTypeString},
+			}},
+			GroupBy:          &filters.Path{Class: "MyClass", Property: "label"},
+			IncludeMetaCount: true,
+		}
+
+		agg := aggregation.Result{
+			Groups: []aggregation.Group{{Count: 10}},
+		}
+
+		vectorRepo.On("Aggregate", expectedParams).Return(&agg, nil)
+		res, err := traverser.Aggregate(context.Background(), principal, &params)
+		require.Nil(t, err)
+		assert.Equal(t, &agg, res)
+	})
+
+	t.Run("scoped to the objects closest to a vector", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		locks := &fakeLocks{}
+		authorizer := &fakeAuthorizer{}
+		vectorRepo := &fakeVectorRepo{}
+		explorer := &fakeExplorer{}
+		schemaGetter := &fakeSchemaGetter{aggregateTestSchema}
+
+		traverser := NewTraverser(&config.WeaviateConfig{}, locks, logger, authorizer,
+			vectorRepo, explorer, schemaGetter)
+
+		params := AggregateParams{
+			ClassName:        "MyClass",
+			IncludeMetaCount: true,
+			NearVector:       &NearVectorParams{Vector: []float32{0.1, 0.2}},
+			ObjectLimit:      ptInt(500),
+		}
+
+		expectedParams := params
+		expectedParams.SearchVector = []float32{0.1, 0.2}
+
+		agg := aggregation.Result{
+			Groups: []aggregation.Group{{Count: 500}},
+		}
+
+		vectorRepo.On("Aggregate", expectedParams).Return(&agg, nil)
+		res, err := traverser.Aggregate(context.Background(), principal, &params)
+		require.Nil(t, err)
+		assert.Equal(t, &agg, res)
+	})
+
+	t.Run("with a near param, but without an objectLimit", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			&fakeAuthorizer{}, &fakeVectorRepo{}, &fakeExplorer{},
+			&fakeSchemaGetter{aggregateTestSchema})
+
+		params := AggregateParams{
+			ClassName:  "MyClass",
+			NearVector: &NearVectorParams{Vector: []float32{0.1, 0.2}},
+		}
+
+		_, err := traverser.Aggregate(context.Background(), principal, &params)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "objectLimit is required")
+	})
+
+	t.Run("with an objectLimit, but without a near param", func(t *testing.T) {
+		principal := &models.Principal{}
+		logger, _ := test.NewNullLogger()
+		traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			&fakeAuthorizer{}, &fakeVectorRepo{}, &fakeExplorer{},
+			&fakeSchemaGetter{aggregateTestSchema})
+
+		params := AggregateParams{
+			ClassName:   "MyClass",
+			ObjectLimit: ptInt(500),
+		}
+
+		_, err := traverser.Aggregate(context.Background(), principal, &params)
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "objectLimit can only be used")
+	})
+}
+
+func Test_Traverser_StatisticsAggregators(t *testing.T) {
+	t.Run("percentile", func(t *testing.T) {
+		agg, err := NewPercentileAggregator([]float64{0, 50, 99.9, 100})
+		require.Nil(t, err)
+		assert.Equal(t, PercentileType, agg.Type)
+		assert.Equal(t, []float64{0, 50, 99.9, 100}, agg.Percentiles.Values)
+
+		_, err = NewPercentileAggregator(nil)
+		assert.NotNil(t, err)
+
+		_, err = NewPercentileAggregator([]float64{50, 101})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("histogram", func(t *testing.T) {
+		interval := 2.5
+		agg, err := NewHistogramAggregator(nil, &interval)
+		require.Nil(t, err)
+		assert.Equal(t, &HistogramParams{Interval: 2.5}, agg.Histogram)
+
+		agg, err = NewHistogramAggregator(ptInt(10), nil)
+		require.Nil(t, err)
+		assert.Equal(t, &HistogramParams{Buckets: 10}, agg.Histogram)
+
+		_, err = NewHistogramAggregator(nil, nil)
+		assert.NotNil(t, err)
+
+		_, err = NewHistogramAggregator(ptInt(10), &interval)
+		assert.NotNil(t, err)
+
+		_, err = NewHistogramAggregator(ptInt(0), nil)
+		assert.NotNil(t, err)
+
+		negative := -1.0
+		_, err = NewHistogramAggregator(nil, &negative)
+		assert.NotNil(t, err)
+	})
+}
+
+func Test_Traverser_DateGrouping(t *testing.T) {
+	groupByDate := &filters.Path{
+		Class:    schema.ClassName("MyClass"),
+		Property: schema.PropertyName("date"),
+	}
+
+	aggregate := func(params AggregateParams) (interface{}, error) {
+		logger, _ := test.NewNullLogger()
+		vectorRepo := &fakeVectorRepo{}
+		traverser := NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			&fakeAuthorizer{}, vectorRepo, &fakeExplorer{},
+			&fakeSchemaGetter{aggregateTestSchema})
+
+		vectorRepo.On("Aggregate", params).Return(&aggregation.Result{}, nil)
+		return traverser.Aggregate(context.Background(), &models.Principal{}, &params)
+	}
+
+	t.Run("with a valid interval and timezone", func(t *testing.T) {
+		_, err := aggregate(AggregateParams{
+			ClassName: "MyClass",
+			GroupBy:   groupByDate,
+			DateGrouping: &DateGrouping{
+				Interval: DateIntervalWeek,
+				Timezone: "Europe/Amsterdam",
+			},
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("without a timezone", func(t *testing.T) {
+		_, err := aggregate(AggregateParams{
+			ClassName:    "MyClass",
+			GroupBy:      groupByDate,
+			DateGrouping: &DateGrouping{Interval: DateIntervalDay},
+		})
+		assert.Nil(t, err)
+	})
+
+	t.Run("without a groupBy path", func(t *testing.T) {
+		_, err := aggregate(AggregateParams{
+			ClassName:    "MyClass",
+			DateGrouping: &DateGrouping{Interval: DateIntervalDay},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "groupBy path is required")
+	})
+
+	t.Run("with an unsupported interval", func(t *testing.T) {
+		_, err := aggregate(AggregateParams{
+			ClassName:    "MyClass",
+			GroupBy:      groupByDate,
+			DateGrouping: &DateGrouping{Interval: "quarter"},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unsupported interval")
+	})
+
+	t.Run("with an unknown timezone", func(t *testing.T) {
+		_, err := aggregate(AggregateParams{
+			ClassName: "MyClass",
+			GroupBy:   groupByDate,
+			DateGrouping: &DateGrouping{
+				Interval: DateIntervalMonth,
+				Timezone: "Mars/Olympus_Mons",
+			},
+		})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "invalid timezone")
+	})
+}
+
+func Test_Traverser_NestedGroups(t *testing.T) {
+	groupByLabel := &filters.Path{
+		Class:    schema.ClassName("MyClass"),
+		Property: schema.PropertyName("label"),
+	}
+
+	newTraverser := func(vectorRepo *fakeVectorRepo) *Traverser {
+		logger, _ := test.NewNullLogger()
+		return NewTraverser(&config.WeaviateConfig{}, &fakeLocks{}, logger,
+			&fakeAuthorizer{}, vectorRepo, &fakeExplorer{},
+			&fakeSchemaGetter{aggregateTestSchema})
+	}
+
+	t.Run("the nested groups are extended with types", func(t *testing.T) {
+		vectorRepo := &fakeVectorRepo{}
+		params := AggregateParams{
+			ClassName: "MyClass",
+			GroupBy:   groupByLabel,
+			SubGroupBy: []*filters.Path{{
+				Class:    schema.ClassName("MyClass"),
+				Property: schema.PropertyName("date"),
+			}},
+			GroupSort: &GroupSort{By: GroupSortByValue, Order: SortOrderAsc},
+			Properties: []AggregateProperty{{
+				Name:        "int",
+				Aggregators: []Aggregator{TypeAggregator, SumAggregator},
+			}},
+		}
+
+		numerical := func() map[string]aggregation.Property {
+			return map[string]aggregation.Property{
+				"int": {
+					Type:                  aggregation.PropertyTypeNumerical,
+					NumericalAggregations: map[string]float64{"sum": 10},
+				},
+			}
+		}
+		vectorRepo.On("Aggregate", params).Return(&aggregation.Result{
+			Groups: []aggregation.Group{{
+				Properties: numerical(),
+				Groups:     []aggregation.Group{{Properties: numerical()}},
+			}},
+		}, nil)
+
+		res, err := newTraverser(vectorRepo).Aggregate(context.Background(),
+			&models.Principal{}, &params)
+		require.Nil(t, err)
+
+		groups := res.(*aggregation.Result).Groups
+		require.Len(t, groups, 1)
+		assert.Equal(t, "int", groups[0].Properties["int"].SchemaType)
+		require.Len(t, groups[0].Groups, 1)
+		assert.Equal(t, "int", groups[0].Groups[0].Properties["int"].SchemaType)
+	})
+
+	t.Run("with subGroupBy, but without groupBy", func(t *testing.T) {
+		_, err := newTraverser(&fakeVectorRepo{}).Aggregate(context.Background(),
+			&models.Principal{}, &AggregateParams{
+				ClassName:  "MyClass",
+				SubGroupBy: []*filters.Path{groupByLabel},
+			})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "subGroupBy can only be used together with groupBy")
+	})
+
+	t.Run("with groupSort, but without groupBy", func(t *testing.T) {
+		_, err := newTraverser(&fakeVectorRepo{}).Aggregate(context.Background(),
+			&models.Principal{}, &AggregateParams{
+				ClassName: "MyClass",
+				GroupSort: &GroupSort{By: GroupSortByCount, Order: SortOrderDesc},
+			})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "groupSort can only be used together with groupBy")
+	})
+
+	t.Run("with an unsupported groupSort", func(t *testing.T) {
+		_, err := newTraverser(&fakeVectorRepo{}).Aggregate(context.Background(),
+			&models.Principal{}, &AggregateParams{
+				ClassName: "MyClass",
+				GroupBy:   groupByLabel,
+				GroupSort: &GroupSort{By: "mean", Order: SortOrderDesc},
+			})
+		require.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unsupported field")
+	})
 }
 
 var aggregateTestSchema = schema.Schema{
@@ -0,0 +1,44 @@
+//                           _       _
+// __      _____  __ ___   ___  __ _| |_ ___
+// \ \ /\ / / _ \/ _` \ \ / / |/ _` | __/ _ \
+//  \ V  V /  __/ (_| |\ V /| | (_| | ||  __/
+//   \_/\_/ \___|\__,_| \_/ |_|\__,_|\__\___|
+//
+//  Copyright © 2016 - 2021 SeMI Technologies B.V. All rights reserved.
+//
+//  CONTACT: hello@semi.technology
+//
+
+package traverser
+
+import (
+	"github.com/semi-technologies/weaviate/entities/filters"
+	"github.com/semi-technologies/weaviate/entities/schema"
+)
+
+// resolveFilterAliases replaces class aliases in the paths of the filter with
+// the classes they point to. The filter is modified in place.
+func resolveFilterAliases(s schema.Schema, filter *filters.LocalFilter) {
+	if filter == nil {
+		return
+	}
+
+	resolveClauseAliases(s, filter.Root)
+}
+
+func resolveClauseAliases(s schema.Schema, clause *filters.Clause) {
+	if clause == nil {
+		return
+	}
+
+	resolvePathAliases(s, clause.On)
+	for i := range clause.Operands {
+		resolveClauseAliases(s, &clause.Operands[i])
+	}
+}
+
+func resolvePathAliases(s schema.Schema, path *filters.Path) {
+	for ; path != nil; path = path.Child {
+		path.Class = schema.ClassName(s.ResolveAlias(string(path.Class)))
+	}
+}
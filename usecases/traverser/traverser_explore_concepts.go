@@ -36,6 +36,9 @@ func (t *Traverser) Explore(ctx context.Context,
 type NearVectorParams struct {
 	Vector    []float32
 	Certainty float64
+	// TargetVector is the name of a named vector of the class to search on.
+	// If empty, the main vector of the objects is used
+	TargetVector string
 }
 
 type NearObjectParams struct {
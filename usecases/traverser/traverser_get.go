@@ -20,7 +20,14 @@ import (
 
 func (t *Traverser) GetClass(ctx context.Context, principal *models.Principal,
 	params GetParams) (interface{}, error) {
-	err := t.authorizer.Authorize(principal, "get", "traversal/*")
+	// an alias is queried like a regular class, everything from the
+	// authorization to the actual search is done for the class it points to
+	sch := t.schemaGetter.GetSchemaSkipAuth()
+	params.ClassName = sch.ResolveAlias(params.ClassName)
+	resolveFilterAliases(sch, params.Filters)
+
+	err := t.authorizer.Authorize(principal, "get",
+		fmt.Sprintf("traversal/%s", params.ClassName))
 	if err != nil {
 		return nil, err
 	}
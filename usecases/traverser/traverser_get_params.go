@@ -30,6 +30,7 @@ type GetParams struct {
 	Group                *GroupParams
 	ModuleParams         map[string]interface{}
 	AdditionalProperties AdditionalProperties
+	Tenant               string
 }
 
 type SelectProperty struct {
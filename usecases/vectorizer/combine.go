@@ -34,3 +34,34 @@ func CombineVectors(vectors [][]float32) []float32 {
 
 	return combinedVector
 }
+
+// CombineVectorsWithWeights combines all of the vectors into their weighted
+// average. Each vector is weighted by the weight at the same position. If no
+// weights are provided, all vectors are weighted equally, which is identical
+// to CombineVectors.
+func CombineVectorsWithWeights(vectors [][]float32, weights []float32) []float32 {
+	if weights == nil {
+		return CombineVectors(vectors)
+	}
+
+	maxVectorLength := 0
+	for i := range vectors {
+		if len(vectors[i]) > maxVectorLength {
+			maxVectorLength = len(vectors[i])
+		}
+	}
+	sums := make([]float32, maxVectorLength)
+	dividers := make([]float32, maxVectorLength)
+	for v, vector := range vectors {
+		for i := 0; i < len(vector); i++ {
+			sums[i] += vector[i] * weights[v]
+			dividers[i] += weights[v]
+		}
+	}
+	combinedVector := make([]float32, len(sums))
+	for i := 0; i < len(sums); i++ {
+		combinedVector[i] = sums[i] / dividers[i]
+	}
+
+	return combinedVector
+}
@@ -63,3 +63,53 @@ func TestCombineVectors(t *testing.T) {
 		})
 	}
 }
+
+func TestCombineVectorsWithWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		vectors [][]float32
+		weights []float32
+		want    []float32
+	}{
+		{
+			"Combine without weights",
+			[][]float32{
+				{1, 2, 3},
+				{2, 3, 4},
+			},
+			nil,
+			[]float32{1.5, 2.5, 3.5},
+		},
+		{
+			"Combine with equal weights",
+			[][]float32{
+				{1, 2, 3},
+				{2, 3, 4},
+			},
+			[]float32{2, 2},
+			[]float32{1.5, 2.5, 3.5},
+		},
+		{
+			"Combine with the first vector counting double",
+			[][]float32{
+				{3, 0, 6},
+				{0, 3, 0},
+			},
+			[]float32{2, 1},
+			[]float32{2, 1, 4},
+		},
+		{
+			"Combine empty vectors",
+			[][]float32{},
+			[]float32{},
+			[]float32{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CombineVectorsWithWeights(tt.vectors, tt.weights); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CombineVectorsWithWeights() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}